@@ -17,7 +17,7 @@ limitations under the License.
 package v1alpha1
 
 // CloudEventType contains the list of cloudevent types
-// +kubebuilder:validation:Enum=keda.scaledobject.ready.v1;keda.scaledobject.failed.v1;keda.scaledobject.removed.v1;keda.scaledjob.ready.v1;keda.scaledjob.failed.v1;keda.scaledjob.removed.v1;keda.authentication.triggerauthentication.created.v1;keda.authentication.triggerauthentication.updated.v1;keda.authentication.triggerauthentication.removed.v1;keda.authentication.clustertriggerauthentication.created.v1;keda.authentication.clustertriggerauthentication.updated.v1;keda.authentication.clustertriggerauthentication.removed.v1
+// +kubebuilder:validation:Enum=keda.scaledobject.ready.v1;keda.scaledobject.failed.v1;keda.scaledobject.removed.v1;keda.scaledjob.ready.v1;keda.scaledjob.failed.v1;keda.scaledjob.removed.v1;keda.scaledjob.degraded.v1;keda.scaledobject.activated.v1;keda.scaledobject.deactivated.v1;keda.scaledobject.scaledout.v1;keda.scaledobject.scaledin.v1;keda.scaledobject.fallback.entered.v1;keda.scaledobject.fallback.exited.v1;keda.scaledobject.paused.v1;keda.scaledobject.unpaused.v1;keda.scaledobject.scaler.failed.v1;keda.scaledjob.jobs.created.v1;keda.authentication.triggerauthentication.created.v1;keda.authentication.triggerauthentication.updated.v1;keda.authentication.triggerauthentication.removed.v1;keda.authentication.clustertriggerauthentication.created.v1;keda.authentication.clustertriggerauthentication.updated.v1;keda.authentication.clustertriggerauthentication.removed.v1
 
 type CloudEventType string
 
@@ -40,6 +40,39 @@ const (
 	// ScaledJobRemovedType is for event when removed ScaledJob
 	ScaledJobRemovedType CloudEventType = "keda.scaledjob.removed.v1"
 
+	// ScaledJobDegradedType is for event when ScaledJob exhausted its failure budget
+	ScaledJobDegradedType CloudEventType = "keda.scaledjob.degraded.v1"
+
+	// ScaledObjectActivatedType is for event when the scale target of ScaledObject was activated
+	ScaledObjectActivatedType CloudEventType = "keda.scaledobject.activated.v1"
+
+	// ScaledObjectDeactivatedType is for event when the scale target of ScaledObject was deactivated
+	ScaledObjectDeactivatedType CloudEventType = "keda.scaledobject.deactivated.v1"
+
+	// ScaledObjectScaledOutType is for event when the replicas of the scale target of ScaledObject were increased
+	ScaledObjectScaledOutType CloudEventType = "keda.scaledobject.scaledout.v1"
+
+	// ScaledObjectScaledInType is for event when the replicas of the scale target of ScaledObject were decreased
+	ScaledObjectScaledInType CloudEventType = "keda.scaledobject.scaledin.v1"
+
+	// ScaledObjectFallbackEnteredType is for event when at least one trigger of ScaledObject started falling back
+	ScaledObjectFallbackEnteredType CloudEventType = "keda.scaledobject.fallback.entered.v1"
+
+	// ScaledObjectFallbackExitedType is for event when no trigger of ScaledObject is falling back anymore
+	ScaledObjectFallbackExitedType CloudEventType = "keda.scaledobject.fallback.exited.v1"
+
+	// ScaledObjectPausedType is for event when ScaledObject was paused
+	ScaledObjectPausedType CloudEventType = "keda.scaledobject.paused.v1"
+
+	// ScaledObjectUnpausedType is for event when ScaledObject was unpaused
+	ScaledObjectUnpausedType CloudEventType = "keda.scaledobject.unpaused.v1"
+
+	// ScaledObjectScalerFailedType is for event when the triggers of ScaledObject started failing
+	ScaledObjectScalerFailedType CloudEventType = "keda.scaledobject.scaler.failed.v1"
+
+	// ScaledJobJobsCreatedType is for event when jobs for ScaledJob were created
+	ScaledJobJobsCreatedType CloudEventType = "keda.scaledjob.jobs.created.v1"
+
 	// TriggerAuthenticationCreatedType is for event when a new TriggerAuthentication is created
 	TriggerAuthenticationCreatedType CloudEventType = "keda.authentication.triggerauthentication.created.v1"
 
@@ -61,5 +94,8 @@ const (
 
 var AllEventTypes = []CloudEventType{
 	ScaledObjectFailedType, ScaledObjectReadyType, ScaledObjectRemovedType,
-	ScaledJobFailedType, ScaledJobReadyType, ScaledJobRemovedType,
+	ScaledJobFailedType, ScaledJobReadyType, ScaledJobRemovedType, ScaledJobDegradedType,
+	ScaledObjectActivatedType, ScaledObjectDeactivatedType, ScaledObjectScaledOutType, ScaledObjectScaledInType,
+	ScaledObjectFallbackEnteredType, ScaledObjectFallbackExitedType, ScaledObjectPausedType, ScaledObjectUnpausedType,
+	ScaledObjectScalerFailedType, ScaledJobJobsCreatedType,
 }
@@ -88,6 +88,9 @@ type CloudEventSourceSpec struct {
 
 	// +optional
 	EventSubscription EventSubscription `json:"eventSubscription,omitempty"`
+
+	// +optional
+	Delivery *Delivery `json:"delivery,omitempty"`
 }
 
 // CloudEventSourceStatus defines the observed state of CloudEventSource
@@ -95,6 +98,22 @@ type CloudEventSourceSpec struct {
 type CloudEventSourceStatus struct {
 	// +optional
 	Conditions v1alpha1.Conditions `json:"conditions,omitempty"`
+
+	// QueuedEvents is the number of events waiting to be delivered
+	// +optional
+	QueuedEvents int64 `json:"queuedEvents,omitempty"`
+
+	// DeliveredEvents is the number of events delivered to the destination
+	// +optional
+	DeliveredEvents int64 `json:"deliveredEvents,omitempty"`
+
+	// DeadLetteredEvents is the number of events delivered to the dead-letter destination
+	// +optional
+	DeadLetteredEvents int64 `json:"deadLetteredEvents,omitempty"`
+
+	// DroppedEvents is the number of events which couldn't be delivered nor dead-lettered
+	// +optional
+	DroppedEvents int64 `json:"droppedEvents,omitempty"`
 }
 
 // Destination defines the various ways to emit events
@@ -104,6 +123,18 @@ type Destination struct {
 
 	// +optional
 	AzureEventGridTopic *AzureEventGridTopicSpec `json:"azureEventGridTopic"`
+
+	// +optional
+	Kafka *KafkaSpec `json:"kafka"`
+
+	// +optional
+	NATS *NATSSpec `json:"nats"`
+
+	// +optional
+	AwsSns *AwsSnsSpec `json:"awsSns"`
+
+	// +optional
+	AwsSqs *AwsSqsSpec `json:"awsSqs"`
 }
 
 type CloudEventHTTP struct {
@@ -114,6 +145,72 @@ type AzureEventGridTopicSpec struct {
 	Endpoint string `json:"endpoint"`
 }
 
+type KafkaSpec struct {
+	// BootstrapServers is a comma separated list of the Kafka brokers
+	BootstrapServers string `json:"bootstrapServers"`
+
+	Topic string `json:"topic"`
+
+	// Version is the version of the Kafka brokers, the default version of sarama is used if not set
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// AwsRegion is the region of the AWS MSK cluster, required by the aws_msk_iam SASL token provider
+	// +optional
+	AwsRegion string `json:"awsRegion,omitempty"`
+}
+
+type NATSSpec struct {
+	// ServerURL is a comma separated list of the NATS servers
+	ServerURL string `json:"serverURL"`
+
+	Subject string `json:"subject"`
+}
+
+type AwsSnsSpec struct {
+	TopicArn string `json:"topicArn"`
+
+	AwsRegion string `json:"awsRegion"`
+}
+
+type AwsSqsSpec struct {
+	QueueURL string `json:"queueURL"`
+
+	AwsRegion string `json:"awsRegion"`
+}
+
+// Delivery defines how the events are delivered to the destination, the events are delivered in order
+// and the delivery of an event is retried with an exponential backoff before delivering the next one
+type Delivery struct {
+	// MaxRetries is the number of times the delivery of an event is retried before it's dead-lettered, or dropped
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxRetries *int32 `json:"maxRetries,omitempty"`
+
+	// BackoffSeconds is the delay before the first retry, it's doubled on each retry
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	BackoffSeconds *int32 `json:"backoffSeconds,omitempty"`
+
+	// MaxBackoffSeconds is the maximum delay between two retries
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxBackoffSeconds *int32 `json:"maxBackoffSeconds,omitempty"`
+
+	// MaxQueuedEvents is the maximum number of events waiting to be delivered, the new events are dropped once it's reached
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxQueuedEvents *int32 `json:"maxQueuedEvents,omitempty"`
+
+	// Spool persists the events waiting to be delivered to a ConfigMap in the KEDA namespace, for them to be delivered after a restart
+	// +optional
+	Spool bool `json:"spool,omitempty"`
+
+	// DeadLetter is the destination of the events which couldn't be delivered
+	// +optional
+	DeadLetter *Destination `json:"deadLetter,omitempty"`
+}
+
 // EventSubscription defines filters for events
 type EventSubscription struct {
 	// +optional
@@ -157,3 +254,8 @@ func (cces *ClusterCloudEventSource) GenerateIdentifier() string {
 func GetCloudEventSourceInitializedConditions() *v1alpha1.Conditions {
 	return &v1alpha1.Conditions{{Type: v1alpha1.ConditionActive, Status: metav1.ConditionUnknown}}
 }
+
+// IsSpoolEnabled returns whether the events waiting to be delivered are persisted
+func (spec *CloudEventSourceSpec) IsSpoolEnabled() bool {
+	return spec.Delivery != nil && spec.Delivery.Spool
+}
@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"slices"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -191,5 +192,83 @@ func validateSpec(spec *CloudEventSourceSpec) (admission.Warnings, error) {
 			}
 		}
 	}
+
+	if err := validateDestination("destination", &spec.Destination); err != nil {
+		return nil, err
+	}
+
+	if spec.Delivery != nil {
+		if err := validateDelivery(spec.Delivery); err != nil {
+			return nil, err
+		}
+	}
 	return nil, nil
 }
+
+func validateDelivery(delivery *Delivery) error {
+	backoffSeconds, maxBackoffSeconds := int32(1), int32(60)
+	if delivery.BackoffSeconds != nil {
+		backoffSeconds = *delivery.BackoffSeconds
+	}
+	if delivery.MaxBackoffSeconds != nil {
+		maxBackoffSeconds = *delivery.MaxBackoffSeconds
+	}
+	if backoffSeconds > maxBackoffSeconds {
+		return fmt.Errorf("delivery.backoffSeconds: %d in cloudeventsource/clustercloudeventsource spec must not be greater than delivery.maxBackoffSeconds: %d", backoffSeconds, maxBackoffSeconds)
+	}
+
+	if delivery.DeadLetter != nil && *delivery.DeadLetter == (Destination{}) {
+		return fmt.Errorf("delivery.deadLetter in cloudeventsource/clustercloudeventsource spec doesn't define any destination")
+	}
+	if delivery.DeadLetter != nil {
+		return validateDestination("delivery.deadLetter", delivery.DeadLetter)
+	}
+	return nil
+}
+
+// validateDestination checks that the required fields of the destinations defined are set
+func validateDestination(field string, destination *Destination) error {
+	var missing []string
+	if destination.HTTP != nil && destination.HTTP.URI == "" {
+		missing = append(missing, "http.uri")
+	}
+	if destination.AzureEventGridTopic != nil && destination.AzureEventGridTopic.Endpoint == "" {
+		missing = append(missing, "azureEventGridTopic.endpoint")
+	}
+	if destination.Kafka != nil {
+		if destination.Kafka.BootstrapServers == "" {
+			missing = append(missing, "kafka.bootstrapServers")
+		}
+		if destination.Kafka.Topic == "" {
+			missing = append(missing, "kafka.topic")
+		}
+	}
+	if destination.NATS != nil {
+		if destination.NATS.ServerURL == "" {
+			missing = append(missing, "nats.serverURL")
+		}
+		if destination.NATS.Subject == "" {
+			missing = append(missing, "nats.subject")
+		}
+	}
+	if destination.AwsSns != nil {
+		if destination.AwsSns.TopicArn == "" {
+			missing = append(missing, "awsSns.topicArn")
+		}
+		if destination.AwsSns.AwsRegion == "" {
+			missing = append(missing, "awsSns.awsRegion")
+		}
+	}
+	if destination.AwsSqs != nil {
+		if destination.AwsSqs.QueueURL == "" {
+			missing = append(missing, "awsSqs.queueURL")
+		}
+		if destination.AwsSqs.AwsRegion == "" {
+			missing = append(missing, "awsSqs.awsRegion")
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%s in cloudeventsource/clustercloudeventsource spec must set %s", field, strings.Join(missing, ", "))
+	}
+	return nil
+}
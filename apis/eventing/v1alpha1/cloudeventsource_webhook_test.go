@@ -192,6 +192,38 @@ var _ = It("validate invalid cloudeventsource which eventtype in both excludetyp
 	}).Should(HaveOccurred())
 })
 
+var _ = It("validate cloudeventsource delivery", func() {
+	namespaceName := "cloudeventtestnsdelivery"
+	namespace := createNamespace(namespaceName)
+	err := k8sClient.Create(context.Background(), namespace)
+	Expect(err).ToNot(HaveOccurred())
+
+	backoffSeconds, maxBackoffSeconds := int32(30), int32(10)
+	spec := CloudEventSourceSpec{Delivery: &Delivery{BackoffSeconds: &backoffSeconds, MaxBackoffSeconds: &maxBackoffSeconds}}
+	ces := createCloudEventSource("invaliddeliverybackoff", namespaceName, spec)
+	Eventually(func() error {
+		return k8sClient.Create(context.Background(), ces)
+	}).Should(HaveOccurred())
+
+	spec = CloudEventSourceSpec{Delivery: &Delivery{DeadLetter: &Destination{}}}
+	ces = createCloudEventSource("invaliddeliverydeadletter", namespaceName, spec)
+	Eventually(func() error {
+		return k8sClient.Create(context.Background(), ces)
+	}).Should(HaveOccurred())
+
+	spec = CloudEventSourceSpec{Delivery: &Delivery{DeadLetter: &Destination{Kafka: &KafkaSpec{BootstrapServers: "kafka:9092"}}}}
+	ces = createCloudEventSource("invaliddeliverydeadletterfields", namespaceName, spec)
+	Eventually(func() error {
+		return k8sClient.Create(context.Background(), ces)
+	}).Should(HaveOccurred())
+
+	spec = CloudEventSourceSpec{Delivery: &Delivery{MaxBackoffSeconds: &maxBackoffSeconds, Spool: true, DeadLetter: &Destination{HTTP: &CloudEventHTTP{URI: "http://dead.letter"}}}}
+	ces = createCloudEventSource("validdelivery", namespaceName, spec)
+	Eventually(func() error {
+		return k8sClient.Create(context.Background(), ces)
+	}).ShouldNot(HaveOccurred())
+})
+
 // -------------------------------------------------------------------------- //
 // ----------------------------- HELP FUNCTIONS ----------------------------- //
 // -------------------------------------------------------------------------- //
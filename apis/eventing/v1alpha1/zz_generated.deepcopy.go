@@ -25,6 +25,36 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AwsSnsSpec) DeepCopyInto(out *AwsSnsSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsSnsSpec.
+func (in *AwsSnsSpec) DeepCopy() *AwsSnsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AwsSnsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AwsSqsSpec) DeepCopyInto(out *AwsSqsSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsSqsSpec.
+func (in *AwsSqsSpec) DeepCopy() *AwsSqsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AwsSqsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureEventGridTopicSpec) DeepCopyInto(out *AzureEventGridTopicSpec) {
 	*out = *in
@@ -139,6 +169,11 @@ func (in *CloudEventSourceSpec) DeepCopyInto(out *CloudEventSourceSpec) {
 		**out = **in
 	}
 	in.EventSubscription.DeepCopyInto(&out.EventSubscription)
+	if in.Delivery != nil {
+		in, out := &in.Delivery, &out.Delivery
+		*out = new(Delivery)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudEventSourceSpec.
@@ -245,6 +280,46 @@ func (in *ClusterCloudEventSourceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Delivery) DeepCopyInto(out *Delivery) {
+	*out = *in
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BackoffSeconds != nil {
+		in, out := &in.BackoffSeconds, &out.BackoffSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxBackoffSeconds != nil {
+		in, out := &in.MaxBackoffSeconds, &out.MaxBackoffSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxQueuedEvents != nil {
+		in, out := &in.MaxQueuedEvents, &out.MaxQueuedEvents
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DeadLetter != nil {
+		in, out := &in.DeadLetter, &out.DeadLetter
+		*out = new(Destination)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Delivery.
+func (in *Delivery) DeepCopy() *Delivery {
+	if in == nil {
+		return nil
+	}
+	out := new(Delivery)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Destination) DeepCopyInto(out *Destination) {
 	*out = *in
@@ -258,6 +333,26 @@ func (in *Destination) DeepCopyInto(out *Destination) {
 		*out = new(AzureEventGridTopicSpec)
 		**out = **in
 	}
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(KafkaSpec)
+		**out = **in
+	}
+	if in.NATS != nil {
+		in, out := &in.NATS, &out.NATS
+		*out = new(NATSSpec)
+		**out = **in
+	}
+	if in.AwsSns != nil {
+		in, out := &in.AwsSns, &out.AwsSns
+		*out = new(AwsSnsSpec)
+		**out = **in
+	}
+	if in.AwsSqs != nil {
+		in, out := &in.AwsSqs, &out.AwsSqs
+		*out = new(AwsSqsSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Destination.
@@ -294,3 +389,33 @@ func (in *EventSubscription) DeepCopy() *EventSubscription {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSpec) DeepCopyInto(out *KafkaSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSpec.
+func (in *KafkaSpec) DeepCopy() *KafkaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NATSSpec) DeepCopyInto(out *NATSSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATSSpec.
+func (in *NATSSpec) DeepCopy() *NATSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NATSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
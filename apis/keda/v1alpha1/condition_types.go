@@ -34,6 +34,9 @@ const (
 	ConditionFallback ConditionType = "Fallback"
 	// ConditionPaused specifies that the resource is paused.
 	ConditionPaused ConditionType = "Paused"
+	// ConditionDegraded specifies that the ScaledJob exhausted its failure budget.
+	// It is only set on ScaledJobs with a failure budget.
+	ConditionDegraded ConditionType = "Degraded"
 )
 
 const (
@@ -56,6 +59,10 @@ const (
 	ScaledJobConditionPausedMessage = "ScaledJob is paused"
 	// ScaledJobConditionPausedMessage defines the default Message for paused ScaledJob
 	ScaledJobConditionUnpausedMessage = "ScaledJob is unpaused"
+	// ScaledJobConditionDegradedReason defines the default Reason for ScaledJob which exhausted its failure budget
+	ScaledJobConditionDegradedReason = "FailureBudgetExhausted"
+	// ScaledJobConditionRecoveredReason defines the default Reason for ScaledJob resuming the creation of Jobs after the failure budget backoff
+	ScaledJobConditionRecoveredReason = "FailureBudgetBackoffElapsed"
 )
 
 // Condition to store the condition state
@@ -211,6 +218,26 @@ func (c *Conditions) GetPausedCondition() Condition {
 	return c.getCondition(ConditionPaused)
 }
 
+// SetDegradedCondition modifies Degraded Condition according to input parameters, the condition is added if missing
+// as it isn't part of the initialized Conditions
+func (c *Conditions) SetDegradedCondition(status metav1.ConditionStatus, reason string, message string) {
+	if *c == nil {
+		*c = *GetInitializedConditions()
+	}
+	if c.getCondition(ConditionDegraded).Type == "" {
+		*c = append(*c, Condition{Type: ConditionDegraded})
+	}
+	c.setCondition(ConditionDegraded, status, reason, message)
+}
+
+// GetDegradedCondition returns Condition of type Degraded
+func (c *Conditions) GetDegradedCondition() Condition {
+	if *c == nil {
+		c = GetInitializedConditions()
+	}
+	return c.getCondition(ConditionDegraded)
+}
+
 func (c Conditions) getCondition(conditionType ConditionType) Condition {
 	for i := range c {
 		if c[i].Type == conditionType {
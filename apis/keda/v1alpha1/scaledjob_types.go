@@ -17,13 +17,29 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
 	batchv1 "k8s.io/api/batch/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/kedacore/keda/v2/pkg/util/cronschedule"
 )
 
 const (
 	defaultScaledJobMaxReplicaCount = 100
 	defaultScaledJobMinReplicaCount = 0
+
+	defaultFailureBudgetWindow         = 10 * time.Minute
+	defaultFailureBudgetInitialBackoff = time.Minute
+	defaultFailureBudgetMaxBackoff     = 30 * time.Minute
+
+	defaultRolloutCanaryPercentage      = 10
+	defaultRolloutCanaryMinFinishedJobs = 5
+	defaultRolloutCanarySuccessRatio    = 90
 )
 
 // +genclient
@@ -50,9 +66,48 @@ type ScaledJob struct {
 
 const ScaledJobExcludedLabelsAnnotation = "scaledjob.keda.sh/job-excluded-labels"
 
+const (
+	// ScaledJobMessageAnnotation is the annotation of a Job containing the bound message, when injected as annotation
+	ScaledJobMessageAnnotation = "scaledjob.keda.sh/message"
+	// ScaledJobMessageIDAnnotation is the annotation of a Job containing the ID of the bound message
+	ScaledJobMessageIDAnnotation = "scaledjob.keda.sh/message-id"
+	// ScaledJobMessageHandleAnnotation is the annotation of a Job containing the handle of the bound message,
+	// when injected as annotation
+	ScaledJobMessageHandleAnnotation = "scaledjob.keda.sh/message-handle"
+	// ScaledJobPollCycleAnnotation is the annotation of a Job containing the poll cycle it was created in,
+	// as the unix time in milliseconds the cycle created the Jobs at
+	ScaledJobPollCycleAnnotation = "scaledjob.keda.sh/poll-cycle"
+)
+
+const (
+	RolloutStrategyDefault = "default"
+	RolloutStrategyGradual = "gradual"
+	RolloutStrategyCanary  = "canary"
+
+	// RolloutPhaseStable is the phase of a canary rollout when the Jobs are created from the stable generation
+	RolloutPhaseStable = "Stable"
+	// RolloutPhaseProgressing is the phase of a canary rollout when a percentage of the Jobs is created from the canary generation
+	RolloutPhaseProgressing = "Progressing"
+	// RolloutPhaseRolledBack is the phase of a canary rollout when the canary generation failed,
+	// the Jobs are created from the stable generation until the ScaledJob is changed
+	RolloutPhaseRolledBack = "RolledBack"
+)
+
+const (
+	MessageBindingInjectAsEnv        = "env"
+	MessageBindingInjectAsAnnotation = "annotation"
+
+	defaultMessageBindingEnvName = "KEDA_MESSAGE"
+)
+
 // ScaledJobSpec defines the desired state of ScaledJob
 type ScaledJobSpec struct {
-	JobTargetRef *batchv1.JobSpec `json:"jobTargetRef"`
+	// JobTargetRef is the spec of the Jobs created by the ScaledJob, either JobTargetRef or WorkloadTemplate must be set
+	// +optional
+	JobTargetRef *batchv1.JobSpec `json:"jobTargetRef,omitempty"`
+	// WorkloadTemplate is the template of the workloads created by the ScaledJob instead of Jobs
+	// +optional
+	WorkloadTemplate *WorkloadTemplate `json:"workloadTemplate,omitempty"`
 	// +optional
 	PollingInterval *int32 `json:"pollingInterval,omitempty"`
 	// +optional
@@ -72,6 +127,155 @@ type ScaledJobSpec struct {
 	// +optional
 	ScalingStrategy ScalingStrategy `json:"scalingStrategy,omitempty"`
 	Triggers        []ScaleTriggers `json:"triggers"`
+	// +optional
+	Fallback *ScaledJobFallback `json:"fallback,omitempty"`
+	// +optional
+	MessageBinding *ScaledJobMessageBinding `json:"messageBinding,omitempty"`
+	// +optional
+	FailureBudget *ScaledJobFailureBudget `json:"failureBudget,omitempty"`
+	// Group makes the ScaledJob member of a ScaledJobGroup capping the running Jobs of its members
+	// +optional
+	Group *ScaledJobGroupReference `json:"group,omitempty"`
+	// ActiveWindows are the time windows Jobs can be created in, Jobs can be created at any time if not set
+	// +optional
+	ActiveWindows []ScaledJobTimeWindow `json:"activeWindows,omitempty"`
+	// BlackoutWindows are the time windows no Job is created in, they take precedence over ActiveWindows
+	// +optional
+	BlackoutWindows []ScaledJobTimeWindow `json:"blackoutWindows,omitempty"`
+	// ScalingModifiers computes the queue length of the ScaledJob from the values of its named triggers with a formula,
+	// the number of Jobs requested is the result of the formula divided by the target
+	// +optional
+	ScalingModifiers *ScalingModifiers `json:"scalingModifiers,omitempty"`
+}
+
+// ScaledJobFallback is the spec for the fallback options of a ScaledJob
+type ScaledJobFallback struct {
+	FailureThreshold int32 `json:"failureThreshold"`
+	// Replicas is the number of jobs requested by a failing trigger with the static behavior
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+	// Behavior is either static, or currentReplicas to keep running the number of jobs
+	// that were running when the trigger started failing
+	// +optional
+	// +kubebuilder:default=static
+	// +kubebuilder:validation:Enum=static;currentReplicas
+	Behavior string `json:"behavior,omitempty"`
+}
+
+// ScaledJobMessageBinding binds each Job created by the ScaledJob to a single message claimed from the queue of a trigger,
+// the claimed messages aren't delivered to the other consumers of the queue
+type ScaledJobMessageBinding struct {
+	// TriggerName is the name of the trigger the messages are claimed from,
+	// the first trigger able to claim messages (aws-sqs-queue or rabbitmq with the amqp protocol) is used if not set
+	// +optional
+	TriggerName string `json:"triggerName,omitempty"`
+	// PayloadPath selects the field of a JSON message payload bound to the Job, e.g. order.id.
+	// The message ID is bound if not set, or the whole payload if the queue doesn't identify its messages
+	// +optional
+	PayloadPath string `json:"payloadPath,omitempty"`
+	// InjectAs is either env, to inject the message in the environment of the containers of the Job,
+	// or annotation to inject the message in the annotations of the Job
+	// +optional
+	// +kubebuilder:default=env
+	// +kubebuilder:validation:Enum=env;annotation
+	InjectAs string `json:"injectAs,omitempty"`
+	// EnvName is the name of the environment variable containing the message, the message ID and handle
+	// are in the variables suffixed with _ID and _HANDLE
+	// +optional
+	// +kubebuilder:default=KEDA_MESSAGE
+	EnvName string `json:"envName,omitempty"`
+}
+
+// GetEnvName returns the name of the environment variable containing the bound message
+func (b *ScaledJobMessageBinding) GetEnvName() string {
+	if b.EnvName == "" {
+		return defaultMessageBindingEnvName
+	}
+	return b.EnvName
+}
+
+// ScaledJobFailureBudget pauses the creation of Jobs when MaxFailures Jobs failed within Window,
+// the creation resumes after a backoff which doubles each time the budget is exhausted again
+type ScaledJobFailureBudget struct {
+	// +kubebuilder:validation:Minimum=1
+	MaxFailures int32 `json:"maxFailures"`
+	// +optional
+	// +kubebuilder:default="10m"
+	Window metav1.Duration `json:"window,omitempty"`
+	// +optional
+	// +kubebuilder:default="1m"
+	InitialBackoff metav1.Duration `json:"initialBackoff,omitempty"`
+	// +optional
+	// +kubebuilder:default="30m"
+	MaxBackoff metav1.Duration `json:"maxBackoff,omitempty"`
+}
+
+// GetWindow returns the window the failed Jobs are counted in
+func (b *ScaledJobFailureBudget) GetWindow() time.Duration {
+	if b.Window.Duration == 0 {
+		return defaultFailureBudgetWindow
+	}
+	return b.Window.Duration
+}
+
+// GetBackoff returns the duration the creation of Jobs is paused for after the budget was exhausted
+// the given number of consecutive times
+func (b *ScaledJobFailureBudget) GetBackoff(exhaustions int32) time.Duration {
+	backoff, maxBackoff := b.InitialBackoff.Duration, b.MaxBackoff.Duration
+	if backoff == 0 {
+		backoff = defaultFailureBudgetInitialBackoff
+	}
+	if maxBackoff == 0 {
+		maxBackoff = defaultFailureBudgetMaxBackoff
+	}
+	for i := int32(1); i < exhaustions && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, maxBackoff)
+}
+
+// ScaledJobTimeWindow is a recurring time window opening on a cron schedule, as in the cron scaler, and lasting Duration
+type ScaledJobTimeWindow struct {
+	Schedule string          `json:"schedule"`
+	Duration metav1.Duration `json:"duration"`
+	// Timezone is the IANA name of the timezone of Schedule
+	// +optional
+	// +kubebuilder:default=UTC
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// Contains returns true when the time is within an occurrence of the window
+func (w *ScaledJobTimeWindow) Contains(now time.Time) (bool, error) {
+	schedule, err := cronschedule.Parse(w.Schedule)
+	if err != nil {
+		return false, err
+	}
+	location, err := time.LoadLocation(w.Timezone)
+	if err != nil {
+		return false, err
+	}
+	// the latest occurrence started less than Duration ago if the next one after now-Duration isn't after now
+	return !schedule.Next(now.In(location).Add(-w.Duration.Duration)).After(now), nil
+}
+
+// ScaledJobTimeWindowsStatus reports whether the creation of Jobs is withheld by the time windows of a ScaledJob
+type ScaledJobTimeWindowsStatus struct {
+	// Withheld is true when the creation of Jobs is withheld, either outside the active windows or in a blackout window
+	Withheld bool `json:"withheld"`
+	// Backlog is the number of Jobs requested by the triggers when the creation was last withheld
+	// +optional
+	Backlog int64 `json:"backlog,omitempty"`
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// ScaledJobFailureBudgetStatus is the state of the failure budget of a ScaledJob
+type ScaledJobFailureBudgetStatus struct {
+	// Exhaustions is the number of consecutive times the budget was exhausted
+	Exhaustions int32 `json:"exhaustions"`
+	// PausedUntil is the time the creation of Jobs resumes at
+	// +optional
+	PausedUntil *metav1.Time `json:"pausedUntil,omitempty"`
 }
 
 // ScaledJobStatus defines the observed state of ScaledJob
@@ -87,6 +291,57 @@ type ScaledJobStatus struct {
 	TriggersTypes *string `json:"triggersTypes,omitempty"`
 	// +optional
 	AuthenticationsTypes *string `json:"authenticationsTypes,omitempty"`
+	// +optional
+	Health map[string]HealthStatus `json:"health,omitempty"`
+	// +optional
+	FailureBudget *ScaledJobFailureBudgetStatus `json:"failureBudget,omitempty"`
+	// +optional
+	TimeWindows *ScaledJobTimeWindowsStatus `json:"timeWindows,omitempty"`
+	// InFlightReservations are the Jobs created in the earlier poll cycles without a running or completed pod yet,
+	// they are subtracted from the Jobs requested by the triggers
+	// +optional
+	InFlightReservations []ScaledJobReservation `json:"inFlightReservations,omitempty"`
+	// +optional
+	Statistics *ScaledJobStatistics `json:"statistics,omitempty"`
+	// +optional
+	Rollout *ScaledJobRolloutStatus `json:"rollout,omitempty"`
+}
+
+// ScaledJobStatistics are the runtime statistics of a ScaledJob, they're updated at most every 30s
+// unless KEDA_SCALEDJOB_STATISTICS_UPDATE_INTERVAL is set, the metrics are recorded on every poll cycle
+type ScaledJobStatistics struct {
+	RunningJobs int64 `json:"runningJobs"`
+	PendingJobs int64 `json:"pendingJobs"`
+	// SucceededJobs and FailedJobs are the finished Jobs kept in the history of the ScaledJob
+	SucceededJobs int64 `json:"succeededJobs"`
+	FailedJobs    int64 `json:"failedJobs"`
+	// AverageJobDuration is the average duration of the succeeded Jobs kept in the history
+	// +optional
+	AverageJobDuration *metav1.Duration `json:"averageJobDuration,omitempty"`
+	// +optional
+	LastScaleDecision *ScaledJobScaleDecision `json:"lastScaleDecision,omitempty"`
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// ScaledJobScaleDecision is a scale decision taken by the ScalingStrategy of a ScaledJob
+type ScaledJobScaleDecision struct {
+	// QueueLength is the number of Jobs requested by the triggers
+	QueueLength int64 `json:"queueLength"`
+	// MaxValue is the number of Jobs requested by the triggers, capped by the max replica count
+	MaxValue int64 `json:"maxValue"`
+	// EffectiveMaxScale is the number of Jobs the ScalingStrategy allowed to create
+	EffectiveMaxScale int64 `json:"effectiveMaxScale"`
+	// +optional
+	ScalingStrategy string `json:"scalingStrategy,omitempty"`
+}
+
+// ScaledJobReservation is the number of in-flight Jobs created in a poll cycle from a generation of the ScaledJob
+type ScaledJobReservation struct {
+	Generation int64 `json:"generation"`
+	// Cycle is the unix time in milliseconds the poll cycle created the Jobs at
+	Cycle int64 `json:"cycle"`
+	Jobs  int64 `json:"jobs"`
 }
 
 // ScaledJobList contains a list of ScaledJob
@@ -115,10 +370,82 @@ type ScalingStrategy struct {
 // Rollout defines the strategy for job rollouts
 // +optional
 type Rollout struct {
+	// Strategy is either default, deleting the running Jobs of the previous generations, gradual, leaving them,
+	// or canary, creating a percentage of the new Jobs from the new generation until it's promoted or rolled back
 	// +optional
 	Strategy string `json:"strategy,omitempty"`
 	// +optional
 	PropagationPolicy string `json:"propagationPolicy,omitempty"`
+	// +optional
+	Canary *RolloutCanary `json:"canary,omitempty"`
+}
+
+// RolloutCanary defines the canary rollout of the new generations of a ScaledJob,
+// which are promoted or rolled back according to the success ratio of their Jobs
+type RolloutCanary struct {
+	// Percentage is the percentage of the new Jobs created from the canary generation
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Percentage int32 `json:"percentage,omitempty"`
+	// MinFinishedJobs is the number of finished canary Jobs required to promote or roll back the canary generation
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinFinishedJobs int32 `json:"minFinishedJobs,omitempty"`
+	// SuccessRatio is the minimum percentage of succeeded canary Jobs to promote the canary generation
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	SuccessRatio *int32 `json:"successRatio,omitempty"`
+}
+
+// GetPercentage returns the percentage of the new Jobs created from the canary generation
+func (c *RolloutCanary) GetPercentage() int64 {
+	if c == nil || c.Percentage == 0 {
+		return defaultRolloutCanaryPercentage
+	}
+	return int64(c.Percentage)
+}
+
+// GetMinFinishedJobs returns the number of finished canary Jobs required to take a decision
+func (c *RolloutCanary) GetMinFinishedJobs() int64 {
+	if c == nil || c.MinFinishedJobs == 0 {
+		return defaultRolloutCanaryMinFinishedJobs
+	}
+	return int64(c.MinFinishedJobs)
+}
+
+// GetSuccessRatio returns the minimum percentage of succeeded canary Jobs to promote the canary generation
+func (c *RolloutCanary) GetSuccessRatio() int64 {
+	if c == nil || c.SuccessRatio == nil {
+		return defaultRolloutCanarySuccessRatio
+	}
+	return int64(*c.SuccessRatio)
+}
+
+// ScaledJobRolloutStatus is the state of the canary rollout of a ScaledJob
+type ScaledJobRolloutStatus struct {
+	Phase string `json:"phase"`
+	// StableGeneration is the generation of the ScaledJob the stable Jobs are created from
+	StableGeneration int64 `json:"stableGeneration"`
+	// StableJobTargetRef is the jobTargetRef of the stable generation
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +optional
+	StableJobTargetRef *batchv1.JobSpec `json:"stableJobTargetRef,omitempty"`
+	// CanaryGeneration is the generation of the ScaledJob being rolled out, or rolled back
+	// +optional
+	CanaryGeneration int64 `json:"canaryGeneration,omitempty"`
+	// CanaryJobs and StableJobs are the numbers of Jobs created from each generation since the canary started
+	// +optional
+	CanaryJobs int64 `json:"canaryJobs,omitempty"`
+	// +optional
+	StableJobs int64 `json:"stableJobs,omitempty"`
+	// +optional
+	CanarySucceededJobs int64 `json:"canarySucceededJobs,omitempty"`
+	// +optional
+	CanaryFailedJobs int64 `json:"canaryFailedJobs,omitempty"`
 }
 
 func init() {
@@ -152,3 +479,159 @@ func (s ScaledJob) MinReplicaCount() int64 {
 func (s *ScaledJob) GenerateIdentifier() string {
 	return GenerateIdentifier("ScaledJob", s.Namespace, s.Name)
 }
+
+// GetRolloutStrategy returns the rollout strategy of the ScaledJob, the deprecated RolloutStrategy takes precedence
+func (s *ScaledJob) GetRolloutStrategy() string {
+	if len(s.Spec.RolloutStrategy) > 0 {
+		return s.Spec.RolloutStrategy
+	}
+	return s.Spec.Rollout.Strategy
+}
+
+// IsUsingModifiers determines whether scalingModifiers are defined or not
+func (s *ScaledJob) IsUsingModifiers() bool {
+	return s.Spec.ScalingModifiers != nil
+}
+
+// CheckScaledJobFallbackValid checks that the fallback parameters of the ScaledJob are correct
+func CheckScaledJobFallbackValid(scaledJob *ScaledJob) error {
+	if scaledJob.Spec.Fallback == nil {
+		return nil
+	}
+
+	if scaledJob.Spec.Fallback.FailureThreshold < 0 || scaledJob.Spec.Fallback.Replicas < 0 {
+		return fmt.Errorf("FailureThreshold=%d & Replicas=%d must both be greater than or equal to 0",
+			scaledJob.Spec.Fallback.FailureThreshold, scaledJob.Spec.Fallback.Replicas)
+	}
+
+	switch scaledJob.Spec.Fallback.Behavior {
+	case "", FallbackBehaviorStatic, FallbackBehaviorCurrentReplicas:
+		return nil
+	default:
+		return fmt.Errorf("fallback behavior %q is not supported for ScaledJob, use %s or %s",
+			scaledJob.Spec.Fallback.Behavior, FallbackBehaviorStatic, FallbackBehaviorCurrentReplicas)
+	}
+}
+
+// CheckScaledJobMessageBindingValid checks that the message binding parameters of the ScaledJob are correct
+func CheckScaledJobMessageBindingValid(scaledJob *ScaledJob) error {
+	binding := scaledJob.Spec.MessageBinding
+	if binding == nil {
+		return nil
+	}
+
+	switch binding.InjectAs {
+	case "", MessageBindingInjectAsEnv, MessageBindingInjectAsAnnotation:
+	default:
+		return fmt.Errorf("messageBinding injectAs %q is not supported, use %s or %s",
+			binding.InjectAs, MessageBindingInjectAsEnv, MessageBindingInjectAsAnnotation)
+	}
+
+	if errs := validation.IsEnvVarName(binding.GetEnvName()); len(errs) > 0 {
+		return fmt.Errorf("messageBinding envName %q is not a valid environment variable name: %s",
+			binding.EnvName, strings.Join(errs, ", "))
+	}
+
+	if binding.TriggerName == "" {
+		if !slices.ContainsFunc(scaledJob.Spec.Triggers, canClaimMessages) {
+			return fmt.Errorf("messageBinding requires an aws-sqs-queue or rabbitmq trigger using the amqp protocol")
+		}
+		return nil
+	}
+
+	i := slices.IndexFunc(scaledJob.Spec.Triggers, func(trigger ScaleTriggers) bool {
+		return trigger.Name == binding.TriggerName
+	})
+	if i < 0 {
+		return fmt.Errorf("messageBinding triggerName %q doesn't match any trigger", binding.TriggerName)
+	}
+	if !canClaimMessages(scaledJob.Spec.Triggers[i]) {
+		return fmt.Errorf("messageBinding triggerName %q must be an aws-sqs-queue or rabbitmq trigger using the amqp protocol, messages can't be claimed from %s triggers without removing them",
+			binding.TriggerName, scaledJob.Spec.Triggers[i].Type)
+	}
+	return nil
+}
+
+// canClaimMessages returns whether messages can be claimed from the trigger and released back to its queue
+func canClaimMessages(trigger ScaleTriggers) bool {
+	switch trigger.Type {
+	case "aws-sqs-queue":
+		return true
+	case "rabbitmq":
+		switch trigger.Metadata["protocol"] {
+		case "http":
+			return false
+		case "", "auto":
+			return !strings.HasPrefix(trigger.Metadata["host"], "http")
+		}
+		return true
+	}
+	return false
+}
+
+// CheckScaledJobFailureBudgetValid checks that the failure budget parameters of the ScaledJob are correct
+func CheckScaledJobFailureBudgetValid(scaledJob *ScaledJob) error {
+	budget := scaledJob.Spec.FailureBudget
+	if budget == nil {
+		return nil
+	}
+
+	if budget.MaxFailures < 1 {
+		return fmt.Errorf("failureBudget maxFailures=%d must be greater than 0", budget.MaxFailures)
+	}
+	if budget.Window.Duration < 0 || budget.InitialBackoff.Duration < 0 || budget.MaxBackoff.Duration < 0 {
+		return fmt.Errorf("failureBudget window, initialBackoff and maxBackoff must not be negative")
+	}
+	if budget.MaxBackoff.Duration != 0 && budget.InitialBackoff.Duration > budget.MaxBackoff.Duration {
+		return fmt.Errorf("failureBudget initialBackoff=%s must not be greater than maxBackoff=%s",
+			budget.InitialBackoff.Duration, budget.MaxBackoff.Duration)
+	}
+	return nil
+}
+
+// CheckScaledJobRolloutValid checks that the canary rollout of the ScaledJob is correct
+func CheckScaledJobRolloutValid(scaledJob *ScaledJob) error {
+	if scaledJob.GetRolloutStrategy() != RolloutStrategyCanary {
+		if scaledJob.Spec.Rollout.Canary != nil {
+			return fmt.Errorf("rollout canary requires the %s rollout strategy", RolloutStrategyCanary)
+		}
+		return nil
+	}
+	if scaledJob.Spec.WorkloadTemplate != nil {
+		return fmt.Errorf("the %s rollout strategy isn't supported with workloadTemplate", RolloutStrategyCanary)
+	}
+	return nil
+}
+
+// CheckScaledJobActivationValid checks that no trigger of the ScaledJob sets an activation hysteresis,
+// which is only supported by ScaledObjects
+func CheckScaledJobActivationValid(scaledJob *ScaledJob) error {
+	for i, trigger := range scaledJob.Spec.Triggers {
+		if trigger.ActivationHysteresis != nil {
+			return fmt.Errorf("triggers[%d] activationHysteresis is not supported for ScaledJobs, it's only supported for ScaledObjects", i)
+		}
+	}
+	return nil
+}
+
+// CheckScaledJobTimeWindowsValid checks that the active and blackout windows of the ScaledJob are correct
+func CheckScaledJobTimeWindowsValid(scaledJob *ScaledJob) error {
+	for _, named := range []struct {
+		name    string
+		windows []ScaledJobTimeWindow
+	}{{"activeWindows", scaledJob.Spec.ActiveWindows}, {"blackoutWindows", scaledJob.Spec.BlackoutWindows}} {
+		name := named.name
+		for i, window := range named.windows {
+			if _, err := cronschedule.Parse(window.Schedule); err != nil {
+				return fmt.Errorf("%s[%d] schedule %q is invalid: %w", name, i, window.Schedule, err)
+			}
+			if window.Duration.Duration <= 0 {
+				return fmt.Errorf("%s[%d] duration must be greater than 0", name, i)
+			}
+			if _, err := time.LoadLocation(window.Timezone); err != nil {
+				return fmt.Errorf("%s[%d] timezone %q is invalid: %w", name, i, window.Timezone, err)
+			}
+		}
+	}
+	return nil
+}
@@ -77,6 +77,33 @@ var _ webhook.CustomValidator = &ScaledJobCustomValidator{}
 func (s *ScaledJob) ValidateCreate(dryRun *bool) (admission.Warnings, error) {
 	val, _ := json.MarshalIndent(s, "", "  ")
 	scaledjoblog.Info(fmt.Sprintf("validating scaledjob creation for %s", string(val)))
+	if err := verifyScaledJobFallback(s, "create", *dryRun); err != nil {
+		return nil, err
+	}
+	if err := verifyScaledJobMessageBinding(s, "create", *dryRun); err != nil {
+		return nil, err
+	}
+	if err := verifyScaledJobWorkloadTemplate(s, "create", *dryRun); err != nil {
+		return nil, err
+	}
+	if err := verifyScaledJobFailureBudget(s, "create", *dryRun); err != nil {
+		return nil, err
+	}
+	if err := verifyScaledJobGroup(s, "create", *dryRun); err != nil {
+		return nil, err
+	}
+	if err := verifyScaledJobTimeWindows(s, "create", *dryRun); err != nil {
+		return nil, err
+	}
+	if err := verifyScaledJobScalingModifiers(s, "create", *dryRun); err != nil {
+		return nil, err
+	}
+	if err := verifyScaledJobRollout(s, "create", *dryRun); err != nil {
+		return nil, err
+	}
+	if err := verifyScaledJobActivation(s, "create", *dryRun); err != nil {
+		return nil, err
+	}
 	return nil, verifyTriggers(s, "create", *dryRun)
 }
 
@@ -89,6 +116,33 @@ func (s *ScaledJob) ValidateUpdate(old runtime.Object, dryRun *bool) (admission.
 		scaledjoblog.V(1).Info("finalizer removal, skipping validation")
 		return nil, nil
 	}
+	if err := verifyScaledJobFallback(s, "update", *dryRun); err != nil {
+		return nil, err
+	}
+	if err := verifyScaledJobMessageBinding(s, "update", *dryRun); err != nil {
+		return nil, err
+	}
+	if err := verifyScaledJobWorkloadTemplate(s, "update", *dryRun); err != nil {
+		return nil, err
+	}
+	if err := verifyScaledJobFailureBudget(s, "update", *dryRun); err != nil {
+		return nil, err
+	}
+	if err := verifyScaledJobGroup(s, "update", *dryRun); err != nil {
+		return nil, err
+	}
+	if err := verifyScaledJobTimeWindows(s, "update", *dryRun); err != nil {
+		return nil, err
+	}
+	if err := verifyScaledJobScalingModifiers(s, "update", *dryRun); err != nil {
+		return nil, err
+	}
+	if err := verifyScaledJobRollout(s, "update", *dryRun); err != nil {
+		return nil, err
+	}
+	if err := verifyScaledJobActivation(s, "update", *dryRun); err != nil {
+		return nil, err
+	}
 	return nil, verifyTriggers(s, "update", *dryRun)
 }
 
@@ -96,6 +150,81 @@ func (s *ScaledJob) ValidateDelete(_ *bool) (admission.Warnings, error) {
 	return nil, nil
 }
 
+func verifyScaledJobFallback(incomingSj *ScaledJob, action string, _ bool) error {
+	err := CheckScaledJobFallbackValid(incomingSj)
+	if err != nil {
+		scaledjoblog.WithValues("name", incomingSj.Name, "action", action).Error(err, "validation error")
+	}
+	return err
+}
+
+func verifyScaledJobMessageBinding(incomingSj *ScaledJob, action string, _ bool) error {
+	err := CheckScaledJobMessageBindingValid(incomingSj)
+	if err != nil {
+		scaledjoblog.WithValues("name", incomingSj.Name, "action", action).Error(err, "validation error")
+	}
+	return err
+}
+
+func verifyScaledJobWorkloadTemplate(incomingSj *ScaledJob, action string, _ bool) error {
+	err := CheckScaledJobWorkloadTemplateValid(incomingSj)
+	if err != nil {
+		scaledjoblog.WithValues("name", incomingSj.Name, "action", action).Error(err, "validation error")
+	}
+	return err
+}
+
+func verifyScaledJobFailureBudget(incomingSj *ScaledJob, action string, _ bool) error {
+	err := CheckScaledJobFailureBudgetValid(incomingSj)
+	if err != nil {
+		scaledjoblog.WithValues("name", incomingSj.Name, "action", action).Error(err, "validation error")
+	}
+	return err
+}
+
+func verifyScaledJobGroup(incomingSj *ScaledJob, action string, _ bool) error {
+	err := CheckScaledJobGroupValid(incomingSj)
+	if err != nil {
+		scaledjoblog.WithValues("name", incomingSj.Name, "action", action).Error(err, "validation error")
+	}
+	return err
+}
+
+func verifyScaledJobTimeWindows(incomingSj *ScaledJob, action string, _ bool) error {
+	err := CheckScaledJobTimeWindowsValid(incomingSj)
+	if err != nil {
+		scaledjoblog.WithValues("name", incomingSj.Name, "action", action).Error(err, "validation error")
+	}
+	return err
+}
+
+func verifyScaledJobRollout(incomingSj *ScaledJob, action string, _ bool) error {
+	err := CheckScaledJobRolloutValid(incomingSj)
+	if err != nil {
+		scaledjoblog.WithValues("name", incomingSj.Name, "action", action).Error(err, "validation error")
+	}
+	return err
+}
+
+func verifyScaledJobActivation(incomingSj *ScaledJob, action string, _ bool) error {
+	err := CheckScaledJobActivationValid(incomingSj)
+	if err != nil {
+		scaledjoblog.WithValues("name", incomingSj.Name, "action", action).Error(err, "validation error")
+	}
+	return err
+}
+
+func verifyScaledJobScalingModifiers(incomingSj *ScaledJob, action string, _ bool) error {
+	if !incomingSj.IsUsingModifiers() {
+		return nil
+	}
+	_, err := ValidateAndCompileScaledJobScalingModifiers(incomingSj)
+	if err != nil {
+		scaledjoblog.WithValues("name", incomingSj.Name, "action", action).Error(err, "error validating ScalingModifiers")
+	}
+	return err
+}
+
 func isScaledJobRemovingFinalizer(om metav1.ObjectMeta, oldOm metav1.ObjectMeta, spec ScaledJobSpec, oldSpec ScaledJobSpec) bool {
 	taSpec, _ := json.MarshalIndent(spec, "", "  ")
 	oldTaSpec, _ := json.MarshalIndent(oldSpec, "", "  ")
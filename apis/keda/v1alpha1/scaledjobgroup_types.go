@@ -0,0 +1,96 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=scaledjobgroups,scope=Namespaced,shortName=sjg
+// +kubebuilder:printcolumn:name="Max Running Jobs",type="integer",JSONPath=".spec.maxRunningJobs"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ScaledJobGroup is the Schema for the scaledjobgroups API, it caps the running Jobs of the ScaledJobs
+// referencing it in the same namespace and shares the capacity between them by priority and weight
+type ScaledJobGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ScaledJobGroupSpec `json:"spec"`
+}
+
+// ScaledJobGroupSpec defines the capacity of a ScaledJobGroup
+type ScaledJobGroupSpec struct {
+	// MaxRunningJobs is the maximum number of running Jobs of all the member ScaledJobs
+	// +kubebuilder:validation:Minimum=0
+	MaxRunningJobs int32 `json:"maxRunningJobs"`
+}
+
+// ScaledJobGroupReference makes a ScaledJob member of a ScaledJobGroup.
+// The capacity of the group is granted to the active members from the highest priority to the lowest one,
+// the members with the same priority share it by weight. The inactive members keep their running Jobs.
+type ScaledJobGroupReference struct {
+	Name string `json:"name"`
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	Weight int32 `json:"weight,omitempty"`
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+}
+
+// GetWeight returns the weight of the ScaledJob in its group
+func (r *ScaledJobGroupReference) GetWeight() int32 {
+	if r.Weight == 0 {
+		return 1
+	}
+	return r.Weight
+}
+
+// +kubebuilder:object:root=true
+
+// ScaledJobGroupList contains a list of ScaledJobGroup
+type ScaledJobGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []ScaledJobGroup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ScaledJobGroup{}, &ScaledJobGroupList{})
+}
+
+// CheckScaledJobGroupValid checks that the group reference of the ScaledJob is correct
+func CheckScaledJobGroupValid(scaledJob *ScaledJob) error {
+	group := scaledJob.Spec.Group
+	if group == nil {
+		return nil
+	}
+
+	if errs := validation.IsDNS1123Subdomain(group.Name); len(errs) > 0 {
+		return fmt.Errorf("group name %q is invalid: %s", group.Name, strings.Join(errs, ", "))
+	}
+	if group.Weight < 0 {
+		return fmt.Errorf("group weight=%d must be greater than 0", group.Weight)
+	}
+	return nil
+}
@@ -20,8 +20,10 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"time"
 
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -63,7 +65,10 @@ const FallbackBehaviorStatic = "static"
 const FallbackBehaviorCurrentReplicas = "currentReplicas"
 const FallbackBehaviorCurrentReplicasIfHigher = "currentReplicasIfHigher"
 const FallbackBehaviorCurrentReplicasIfLower = "currentReplicasIfLower"
+const FallbackBehaviorLastKnownValue = "lastKnownValue"
+const FallbackBehaviorDecay = "decay"
 const ForceActivationAnnotation = "autoscaling.keda.sh/force-activation"
+const DryRunAnnotation = "autoscaling.keda.sh/dry-run"
 
 // HealthStatus is the status for a ScaledObject's health
 type HealthStatus struct {
@@ -71,6 +76,13 @@ type HealthStatus struct {
 	NumberOfFailures *int32 `json:"numberOfFailures,omitempty"`
 	// +optional
 	Status HealthStatusType `json:"status,omitempty"`
+	// LastKnownValue is the last value successfully observed for the metric, it's tracked only for
+	// the lastKnownValue and decay fallback behaviors. For a ScaledJob with the currentReplicas fallback
+	// behavior, it's the number of jobs that were running when the fallback started.
+	// +optional
+	LastKnownValue *resource.Quantity `json:"lastKnownValue,omitempty"`
+	// +optional
+	LastKnownValueTime *metav1.Time `json:"lastKnownValueTime,omitempty"`
 }
 
 // HealthStatusType is an indication of whether the health status is happy or failing
@@ -86,8 +98,19 @@ const (
 	// CompositeMetricName is used for scalingModifiers composite metric
 	CompositeMetricName string = "composite-metric"
 
+	// PredictionMetricName is used for the metric holding the forecast of predictive scaling
+	PredictionMetricName string = "prediction-metric"
+
 	defaultHPAMinReplicas int32 = 1
 	defaultHPAMaxReplicas int32 = 100
+
+	defaultFallbackHoldPeriod  int32 = 300
+	defaultFallbackDecayPeriod int32 = 300
+
+	defaultPredictionSeasonalPeriod int32 = 86400
+	defaultPredictionAlpha                = 0.3
+	defaultPredictionBeta                 = 0.1
+	defaultPredictionGamma                = 0.1
 )
 
 // ScaledObjectSpec is the spec for a ScaledObject resource
@@ -119,8 +142,37 @@ type Fallback struct {
 	Replicas         int32 `json:"replicas"`
 	// +optional
 	// +kubebuilder:default=static
-	// +kubebuilder:validation:Enum=static;currentReplicas;currentReplicasIfHigher;currentReplicasIfLower
+	// +kubebuilder:validation:Enum=static;currentReplicas;currentReplicasIfHigher;currentReplicasIfLower;lastKnownValue;decay
 	Behavior string `json:"behavior,omitempty"`
+	// HoldPeriod is the time, in seconds, during which the last known value of a failing metric
+	// is used by the lastKnownValue and decay behaviors, before moving to Replicas
+	// +optional
+	HoldPeriod *int32 `json:"holdPeriod,omitempty"`
+	// DecayPeriod is the time, in seconds, during which the decay behavior linearly moves
+	// from the last known value to Replicas, once HoldPeriod is over
+	// +optional
+	DecayPeriod *int32 `json:"decayPeriod,omitempty"`
+}
+
+// UsesLastKnownValue returns true if the fallback behavior relies on the last known value of the metrics
+func (f *Fallback) UsesLastKnownValue() bool {
+	return f.Behavior == FallbackBehaviorLastKnownValue || f.Behavior == FallbackBehaviorDecay
+}
+
+// GetHoldPeriod returns the hold period of the lastKnownValue and decay behaviors
+func (f *Fallback) GetHoldPeriod() time.Duration {
+	if f.HoldPeriod != nil {
+		return time.Second * time.Duration(*f.HoldPeriod)
+	}
+	return time.Second * time.Duration(defaultFallbackHoldPeriod)
+}
+
+// GetDecayPeriod returns the decay period of the decay behavior
+func (f *Fallback) GetDecayPeriod() time.Duration {
+	if f.DecayPeriod != nil {
+		return time.Second * time.Duration(*f.DecayPeriod)
+	}
+	return time.Second * time.Duration(defaultFallbackDecayPeriod)
 }
 
 // AdvancedConfig specifies advance scaling options
@@ -131,6 +183,17 @@ type AdvancedConfig struct {
 	RestoreToOriginalReplicaCount bool `json:"restoreToOriginalReplicaCount,omitempty"`
 	// +optional
 	ScalingModifiers ScalingModifiers `json:"scalingModifiers,omitempty"`
+	// +optional
+	Prediction *PredictionConfig `json:"prediction,omitempty"`
+	// DryRun evaluates the triggers without touching the scale target, no HPA is created and KEDA
+	// doesn't scale the workload, the replicas it would request are published in the status instead
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+	// ActivationStabilizationWindow is the time, in seconds, the triggers must stay active, or inactive,
+	// before the ScaledObject is activated, or deactivated, it damps flappy metrics near the activation thresholds
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ActivationStabilizationWindow *int32 `json:"activationStabilizationWindow,omitempty"`
 }
 
 // ScalingModifiers describes advanced scaling logic options like formula
@@ -144,6 +207,26 @@ type ScalingModifiers struct {
 	MetricType autoscalingv2.MetricTargetType `json:"metricType,omitempty"`
 }
 
+// PredictionConfig describes the options for predictive scaling, the forecasted
+// value of the metrics is exposed to the HPA as an additional metric
+type PredictionConfig struct {
+	// Horizon is how far ahead, in seconds, the metrics are forecasted
+	// +kubebuilder:validation:Minimum=1
+	Horizon int32 `json:"horizon"`
+	// SeasonalPeriod is the length, in seconds, of the seasonal cycle of the metrics
+	// +optional
+	SeasonalPeriod *int32 `json:"seasonalPeriod,omitempty"`
+	// Alpha is the smoothing factor for the level
+	// +optional
+	Alpha string `json:"alpha,omitempty"`
+	// Beta is the smoothing factor for the trend
+	// +optional
+	Beta string `json:"beta,omitempty"`
+	// Gamma is the smoothing factor for the seasonal component
+	// +optional
+	Gamma string `json:"gamma,omitempty"`
+}
+
 // HorizontalPodAutoscalerConfig specifies horizontal scale config
 type HorizontalPodAutoscalerConfig struct {
 	// +optional
@@ -194,6 +277,58 @@ type ScaledObjectStatus struct {
 	TriggersTypes *string `json:"triggersTypes,omitempty"`
 	// +optional
 	AuthenticationsTypes *string `json:"authenticationsTypes,omitempty"`
+	// +optional
+	DryRun *DryRunStatus `json:"dryRun,omitempty"`
+	// +optional
+	ScalingDecision *ScalingDecision `json:"scalingDecision,omitempty"`
+}
+
+// ScalingDecision explains the last scaling decision of a ScaledObject
+type ScalingDecision struct {
+	// +optional
+	Triggers []TriggerDecision `json:"triggers,omitempty"`
+	// FormulaResult is the value of the composite metric computed by the scalingModifiers formula
+	// +optional
+	FormulaResult string `json:"formulaResult,omitempty"`
+	// DominatingMetric is the metric that requires the highest number of replicas
+	// +optional
+	DominatingMetric string `json:"dominatingMetric,omitempty"`
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// TriggerDecision describes the state of a metric of a trigger when the scaling decision was made
+type TriggerDecision struct {
+	// +optional
+	Name string `json:"name,omitempty"`
+	Type string `json:"type"`
+	// MetricName is the name of the external metric, or the resource for cpu and memory triggers
+	MetricName string `json:"metricName"`
+	// Value is the last observed value of the metric, it's empty for the metrics collected by the HPA
+	// +optional
+	Value string `json:"value,omitempty"`
+	// +optional
+	Target string `json:"target,omitempty"`
+	// +optional
+	TargetType autoscalingv2.MetricTargetType `json:"targetType,omitempty"`
+	// +optional
+	Active bool `json:"active,omitempty"`
+	// Dominating is whether this metric requires the highest number of replicas
+	// +optional
+	Dominating bool `json:"dominating,omitempty"`
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// DryRunStatus describes the scaling decisions of a ScaledObject in dry-run mode
+type DryRunStatus struct {
+	// DesiredReplicas is the number of replicas KEDA and the HPA would have requested
+	DesiredReplicas int32 `json:"desiredReplicas"`
+	// CurrentReplicas is the number of replicas of the scale target when the decision was made
+	CurrentReplicas int32 `json:"currentReplicas"`
+	// LastTransitionTime is the last time the desired or the current replicas changed
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -264,6 +399,61 @@ func (so *ScaledObject) IsUsingModifiers() bool {
 	return so.Spec.Advanced != nil && !reflect.DeepEqual(so.Spec.Advanced.ScalingModifiers, ScalingModifiers{})
 }
 
+// IsUsingPrediction determines whether predictive scaling is enabled or not
+func (so *ScaledObject) IsUsingPrediction() bool {
+	return so.Spec.Advanced != nil && so.Spec.Advanced.Prediction != nil
+}
+
+// GetActivationStabilizationWindow returns the activation stabilization window, zero if it isn't set
+func (so *ScaledObject) GetActivationStabilizationWindow() time.Duration {
+	if so.Spec.Advanced == nil || so.Spec.Advanced.ActivationStabilizationWindow == nil {
+		return 0
+	}
+	return time.Second * time.Duration(*so.Spec.Advanced.ActivationStabilizationWindow)
+}
+
+// IsDryRun determines whether the ScaledObject is in dry-run mode, either through spec.advanced.dryRun or DryRunAnnotation
+func (so *ScaledObject) IsDryRun() bool {
+	return (so.Spec.Advanced != nil && so.Spec.Advanced.DryRun) || getBoolAnnotation(so, DryRunAnnotation)
+}
+
+// GetSeasonalPeriod returns the seasonal period in seconds based on definition in PredictionConfig or default value if not defined
+func (pc *PredictionConfig) GetSeasonalPeriod() int32 {
+	if pc.SeasonalPeriod != nil {
+		return *pc.SeasonalPeriod
+	}
+	return defaultPredictionSeasonalPeriod
+}
+
+// GetSmoothingFactors returns alpha, beta and gamma defined in PredictionConfig, or their default values if not defined
+func (pc *PredictionConfig) GetSmoothingFactors() (float64, float64, float64, error) {
+	factors := []struct {
+		name         string
+		value        string
+		defaultValue float64
+	}{
+		{"alpha", pc.Alpha, defaultPredictionAlpha},
+		{"beta", pc.Beta, defaultPredictionBeta},
+		{"gamma", pc.Gamma, defaultPredictionGamma},
+	}
+	parsed := make([]float64, len(factors))
+	for i, factor := range factors {
+		if factor.value == "" {
+			parsed[i] = factor.defaultValue
+			continue
+		}
+		value, err := strconv.ParseFloat(factor.value, 64)
+		if err != nil {
+			return 0, 0, 0, fmt.Errorf("error parsing %s: %w", factor.name, err)
+		}
+		if value < 0 || value > 1 {
+			return 0, 0, 0, fmt.Errorf("%s=%s must be between 0 and 1", factor.name, factor.value)
+		}
+		parsed[i] = value
+	}
+	return parsed[0], parsed[1], parsed[2], nil
+}
+
 // GetHPAMinReplicas returns MinReplicas based on definition in ScaledObject or default value if not defined
 func (so *ScaledObject) GetHPAMinReplicas() *int32 {
 	if so.Spec.MinReplicaCount != nil && *so.Spec.MinReplicaCount > 0 {
@@ -313,6 +503,10 @@ func CheckFallbackValid(scaledObject *ScaledObject) error {
 			scaledObject.Spec.Fallback.FailureThreshold, scaledObject.Spec.Fallback.Replicas)
 	}
 
+	if err := checkFallbackLastKnownValueValid(scaledObject); err != nil {
+		return err
+	}
+
 	if !scaledObject.IsUsingModifiers() {
 		fallbackValid := false
 		for _, trigger := range scaledObject.Spec.Triggers {
@@ -329,3 +523,89 @@ func CheckFallbackValid(scaledObject *ScaledObject) error {
 	}
 	return nil
 }
+
+// checkFallbackLastKnownValueValid checks the parameters of the lastKnownValue and decay behaviors.
+// The last known values are tracked per trigger, so they can't be used with the composite metric of scalingModifiers.
+func checkFallbackLastKnownValueValid(scaledObject *ScaledObject) error {
+	fallback := scaledObject.Spec.Fallback
+	if !fallback.UsesLastKnownValue() {
+		if fallback.HoldPeriod != nil || fallback.DecayPeriod != nil {
+			return fmt.Errorf("HoldPeriod and DecayPeriod can only be used with the %s and %s behaviors", FallbackBehaviorLastKnownValue, FallbackBehaviorDecay)
+		}
+		return nil
+	}
+
+	if scaledObject.IsUsingModifiers() {
+		return fmt.Errorf("the %s fallback behavior is not supported with scalingModifiers", fallback.Behavior)
+	}
+	if fallback.HoldPeriod != nil && *fallback.HoldPeriod < 0 {
+		return fmt.Errorf("HoldPeriod=%d must be greater than or equal to 0", *fallback.HoldPeriod)
+	}
+	if fallback.DecayPeriod != nil {
+		if fallback.Behavior != FallbackBehaviorDecay {
+			return fmt.Errorf("DecayPeriod can only be used with the %s behavior", FallbackBehaviorDecay)
+		}
+		if *fallback.DecayPeriod <= 0 {
+			return fmt.Errorf("DecayPeriod=%d must be greater than 0", *fallback.DecayPeriod)
+		}
+	}
+	return nil
+}
+
+// CheckActivationValid checks that the activation stabilization window and the activation hysteresis
+// of the triggers are correct, the hysteresis replaces the activity of the triggers, which isn't used with the formula
+func CheckActivationValid(scaledObject *ScaledObject) error {
+	if scaledObject.Spec.Advanced != nil && scaledObject.Spec.Advanced.ActivationStabilizationWindow != nil &&
+		*scaledObject.Spec.Advanced.ActivationStabilizationWindow < 0 {
+		return fmt.Errorf("ActivationStabilizationWindow=%d must be greater than or equal to 0", *scaledObject.Spec.Advanced.ActivationStabilizationWindow)
+	}
+
+	for _, trigger := range scaledObject.Spec.Triggers {
+		if trigger.ActivationHysteresis == nil {
+			continue
+		}
+		if trigger.Type == cpuString || trigger.Type == memoryString {
+			return fmt.Errorf("activationHysteresis is not supported for %q trigger", trigger.Type)
+		}
+		if scaledObject.IsUsingModifiers() {
+			return fmt.Errorf("activationHysteresis can't be used together with scalingModifiers")
+		}
+	}
+	return nil
+}
+
+// CheckPredictionValid checks that the prediction parameters are correct and that there is a metric with
+// an AverageValue target to forecast, either a trigger (that is not cpu or memory) or the scalingModifiers formula.
+func CheckPredictionValid(scaledObject *ScaledObject) error {
+	if !scaledObject.IsUsingPrediction() {
+		return nil
+	}
+	prediction := scaledObject.Spec.Advanced.Prediction
+
+	if prediction.Horizon <= 0 {
+		return fmt.Errorf("Horizon=%d must be greater than 0", prediction.Horizon)
+	}
+	if prediction.GetSeasonalPeriod() <= 0 {
+		return fmt.Errorf("SeasonalPeriod=%d must be greater than 0", prediction.GetSeasonalPeriod())
+	}
+	if _, _, _, err := prediction.GetSmoothingFactors(); err != nil {
+		return err
+	}
+
+	if scaledObject.IsUsingModifiers() {
+		if scaledObject.Spec.Advanced.ScalingModifiers.MetricType == autoscalingv2.ValueMetricType {
+			return fmt.Errorf("prediction requires the scalingModifiers metricType to be `AverageValue`")
+		}
+		return nil
+	}
+
+	for _, trigger := range scaledObject.Spec.Triggers {
+		if trigger.Type == cpuString || trigger.Type == memoryString {
+			continue
+		}
+		if trigger.MetricType == "" || trigger.MetricType == autoscalingv2.AverageValueMetricType {
+			return nil
+		}
+	}
+	return fmt.Errorf("at least one trigger (that is not cpu or memory) has to have the `AverageValue` type for the prediction to be enabled")
+}
@@ -22,6 +22,7 @@ import (
 
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 func TestCheckFallbackValid(t *testing.T) {
@@ -265,6 +266,110 @@ func TestCheckFallbackValid(t *testing.T) {
 			},
 			expectedError: false,
 		},
+		{
+			name: "Decay behavior with periods - valid",
+			scaledObject: &ScaledObject{
+				Spec: ScaledObjectSpec{
+					Fallback: &Fallback{
+						FailureThreshold: 3,
+						Replicas:         1,
+						Behavior:         FallbackBehaviorDecay,
+						HoldPeriod:       ptr.To[int32](600),
+						DecayPeriod:      ptr.To[int32](300),
+					},
+					Triggers: []ScaleTriggers{
+						{
+							Type: "kafka",
+						},
+					},
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "LastKnownValue behavior with negative HoldPeriod - invalid",
+			scaledObject: &ScaledObject{
+				Spec: ScaledObjectSpec{
+					Fallback: &Fallback{
+						FailureThreshold: 3,
+						Replicas:         1,
+						Behavior:         FallbackBehaviorLastKnownValue,
+						HoldPeriod:       ptr.To[int32](-1),
+					},
+					Triggers: []ScaleTriggers{
+						{
+							Type: "kafka",
+						},
+					},
+				},
+			},
+			expectedError: true,
+			errorContains: "HoldPeriod=-1 must be greater than or equal to 0",
+		},
+		{
+			name: "LastKnownValue behavior with DecayPeriod - invalid",
+			scaledObject: &ScaledObject{
+				Spec: ScaledObjectSpec{
+					Fallback: &Fallback{
+						FailureThreshold: 3,
+						Replicas:         1,
+						Behavior:         FallbackBehaviorLastKnownValue,
+						DecayPeriod:      ptr.To[int32](300),
+					},
+					Triggers: []ScaleTriggers{
+						{
+							Type: "kafka",
+						},
+					},
+				},
+			},
+			expectedError: true,
+			errorContains: "DecayPeriod can only be used with the decay behavior",
+		},
+		{
+			name: "Static behavior with HoldPeriod - invalid",
+			scaledObject: &ScaledObject{
+				Spec: ScaledObjectSpec{
+					Fallback: &Fallback{
+						FailureThreshold: 3,
+						Replicas:         1,
+						Behavior:         FallbackBehaviorStatic,
+						HoldPeriod:       ptr.To[int32](300),
+					},
+					Triggers: []ScaleTriggers{
+						{
+							Type: "kafka",
+						},
+					},
+				},
+			},
+			expectedError: true,
+			errorContains: "HoldPeriod and DecayPeriod can only be used with the lastKnownValue and decay behaviors",
+		},
+		{
+			name: "Decay behavior with ScalingModifiers - invalid",
+			scaledObject: &ScaledObject{
+				Spec: ScaledObjectSpec{
+					Fallback: &Fallback{
+						FailureThreshold: 3,
+						Replicas:         1,
+						Behavior:         FallbackBehaviorDecay,
+					},
+					Advanced: &AdvancedConfig{
+						ScalingModifiers: ScalingModifiers{
+							Formula: "x * 2",
+						},
+					},
+					Triggers: []ScaleTriggers{
+						{
+							Type: "kafka",
+						},
+					},
+				},
+			},
+			expectedError: true,
+			errorContains: "the decay fallback behavior is not supported with scalingModifiers",
+		},
 	}
 
 	for _, test := range tests {
@@ -477,6 +582,56 @@ func TestIsUsingModifiers(t *testing.T) {
 	}
 }
 
+func TestIsDryRun(t *testing.T) {
+	tests := []struct {
+		name         string
+		scaledObject *ScaledObject
+		expectResult bool
+	}{
+		{
+			name:         "No Advanced config nor annotation",
+			scaledObject: &ScaledObject{},
+			expectResult: false,
+		},
+		{
+			name: "DryRun enabled in Advanced config",
+			scaledObject: &ScaledObject{
+				Spec: ScaledObjectSpec{
+					Advanced: &AdvancedConfig{DryRun: true},
+				},
+			},
+			expectResult: true,
+		},
+		{
+			name: "DryRun annotation",
+			scaledObject: &ScaledObject{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{DryRunAnnotation: "true"},
+				},
+			},
+			expectResult: true,
+		},
+		{
+			name: "DryRun annotation disabled",
+			scaledObject: &ScaledObject{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{DryRunAnnotation: "false"},
+				},
+			},
+			expectResult: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := test.scaledObject.IsDryRun()
+			if result != test.expectResult {
+				t.Errorf("Expected IsDryRun to return %v, got %v", test.expectResult, result)
+			}
+		})
+	}
+}
+
 func TestCheckReplicaCountBoundsAreValid(t *testing.T) {
 	min1 := int32(1)
 	min2 := int32(2)
@@ -647,3 +802,184 @@ func TestGetHPAReplicas(t *testing.T) {
 func contains(s, substr string) bool {
 	return strings.Contains(s, substr)
 }
+
+func TestCheckPredictionValid(t *testing.T) {
+	negativePeriod := int32(-1)
+
+	tests := []struct {
+		name          string
+		prediction    *PredictionConfig
+		modifiers     ScalingModifiers
+		triggers      []ScaleTriggers
+		expectedError bool
+		errorContains string
+	}{
+		{
+			name:          "No prediction configured",
+			prediction:    nil,
+			triggers:      []ScaleTriggers{{Type: "cpu"}},
+			expectedError: false,
+		},
+		{
+			name:          "Prediction with AverageValue trigger",
+			prediction:    &PredictionConfig{Horizon: 300},
+			triggers:      []ScaleTriggers{{Type: "cpu"}, {Type: "rabbitmq"}},
+			expectedError: false,
+		},
+		{
+			name:          "Prediction with invalid horizon",
+			prediction:    &PredictionConfig{Horizon: 0},
+			triggers:      []ScaleTriggers{{Type: "rabbitmq"}},
+			expectedError: true,
+			errorContains: "Horizon=0 must be greater than 0",
+		},
+		{
+			name:          "Prediction with invalid seasonal period",
+			prediction:    &PredictionConfig{Horizon: 300, SeasonalPeriod: &negativePeriod},
+			triggers:      []ScaleTriggers{{Type: "rabbitmq"}},
+			expectedError: true,
+			errorContains: "SeasonalPeriod=-1 must be greater than 0",
+		},
+		{
+			name:          "Prediction with out of range smoothing factor",
+			prediction:    &PredictionConfig{Horizon: 300, Gamma: "1.5"},
+			triggers:      []ScaleTriggers{{Type: "rabbitmq"}},
+			expectedError: true,
+			errorContains: "gamma=1.5 must be between 0 and 1",
+		},
+		{
+			name:          "Prediction with unparsable smoothing factor",
+			prediction:    &PredictionConfig{Horizon: 300, Alpha: "high"},
+			triggers:      []ScaleTriggers{{Type: "rabbitmq"}},
+			expectedError: true,
+			errorContains: "error parsing alpha",
+		},
+		{
+			name:          "Prediction with only cpu/memory and Value triggers",
+			prediction:    &PredictionConfig{Horizon: 300},
+			triggers:      []ScaleTriggers{{Type: "memory"}, {Type: "rabbitmq", MetricType: autoscalingv2.ValueMetricType}},
+			expectedError: true,
+			errorContains: "at least one trigger (that is not cpu or memory) has to have the `AverageValue` type",
+		},
+		{
+			name:          "Prediction with scalingModifiers",
+			prediction:    &PredictionConfig{Horizon: 300},
+			modifiers:     ScalingModifiers{Formula: "a + b", Target: "2"},
+			triggers:      []ScaleTriggers{{Type: "rabbitmq", Name: "a", MetricType: autoscalingv2.ValueMetricType}},
+			expectedError: false,
+		},
+		{
+			name:          "Prediction with Value scalingModifiers",
+			prediction:    &PredictionConfig{Horizon: 300},
+			modifiers:     ScalingModifiers{Formula: "a + b", Target: "2", MetricType: autoscalingv2.ValueMetricType},
+			triggers:      []ScaleTriggers{{Type: "rabbitmq", Name: "a"}},
+			expectedError: true,
+			errorContains: "prediction requires the scalingModifiers metricType to be `AverageValue`",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			so := &ScaledObject{
+				Spec: ScaledObjectSpec{
+					Advanced: &AdvancedConfig{
+						ScalingModifiers: test.modifiers,
+						Prediction:       test.prediction,
+					},
+					Triggers: test.triggers,
+				},
+			}
+			err := CheckPredictionValid(so)
+
+			if test.expectedError && err == nil {
+				t.Error("Expected error but got nil")
+			}
+
+			if !test.expectedError && err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+
+			if test.expectedError && err != nil && test.errorContains != "" {
+				if !strings.Contains(err.Error(), test.errorContains) {
+					t.Errorf("Error message does not contain expected text.\nExpected to contain: %s\nActual: %s",
+						test.errorContains, err.Error())
+				}
+			}
+		})
+	}
+}
+
+func TestCheckActivationValid(t *testing.T) {
+	hysteresis := &ActivationHysteresis{ActivateThreshold: "10", DeactivateThreshold: "5"}
+
+	tests := []struct {
+		name          string
+		window        *int32
+		modifiers     ScalingModifiers
+		triggers      []ScaleTriggers
+		expectedError bool
+		errorContains string
+	}{
+		{
+			name:          "No activation configured",
+			triggers:      []ScaleTriggers{{Type: "rabbitmq"}},
+			expectedError: false,
+		},
+		{
+			name:          "Stabilization window and hysteresis",
+			window:        ptr.To[int32](60),
+			triggers:      []ScaleTriggers{{Type: "cpu"}, {Type: "rabbitmq", ActivationHysteresis: hysteresis}},
+			expectedError: false,
+		},
+		{
+			name:          "Negative stabilization window",
+			window:        ptr.To[int32](-1),
+			triggers:      []ScaleTriggers{{Type: "rabbitmq"}},
+			expectedError: true,
+			errorContains: "ActivationStabilizationWindow=-1 must be greater than or equal to 0",
+		},
+		{
+			name:          "Hysteresis on cpu trigger",
+			triggers:      []ScaleTriggers{{Type: "cpu", ActivationHysteresis: hysteresis}},
+			expectedError: true,
+			errorContains: "activationHysteresis is not supported for \"cpu\" trigger",
+		},
+		{
+			name:          "Hysteresis with scalingModifiers",
+			modifiers:     ScalingModifiers{Formula: "a", Target: "2"},
+			triggers:      []ScaleTriggers{{Type: "rabbitmq", Name: "a", ActivationHysteresis: hysteresis}},
+			expectedError: true,
+			errorContains: "activationHysteresis can't be used together with scalingModifiers",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			so := &ScaledObject{
+				Spec: ScaledObjectSpec{
+					Advanced: &AdvancedConfig{
+						ScalingModifiers:              test.modifiers,
+						ActivationStabilizationWindow: test.window,
+					},
+					Triggers: test.triggers,
+				},
+			}
+			err := CheckActivationValid(so)
+
+			if test.expectedError && err == nil {
+				t.Error("Expected error but got nil")
+			}
+
+			if !test.expectedError && err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+
+			if test.expectedError && err != nil && test.errorContains != "" {
+				if !strings.Contains(err.Error(), test.errorContains) {
+					t.Errorf("Error message does not contain expected text.\nExpected to contain: %s\nActual: %s",
+						test.errorContains, err.Error())
+				}
+			}
+		})
+	}
+}
@@ -164,6 +164,8 @@ func validateWorkload(so *ScaledObject, action string, dryRun bool) (admission.W
 		"verifyHpas":             verifyHpas,
 		"verifyReplicaCount":     verifyReplicaCount,
 		"verifyFallback":         verifyFallback,
+		"verifyPrediction":       verifyPrediction,
+		"verifyActivation":       verifyActivation,
 	}
 
 	for functionName, function := range verifyFunctions {
@@ -209,6 +211,24 @@ func verifyFallback(incomingSo *ScaledObject, action string, _ bool) error {
 	return err
 }
 
+func verifyPrediction(incomingSo *ScaledObject, action string, _ bool) error {
+	err := CheckPredictionValid(incomingSo)
+	if err != nil {
+		scaledobjectlog.WithValues("name", incomingSo.Name).Error(err, "validation error")
+		metricscollector.RecordScaledObjectValidatingErrors(incomingSo.Namespace, action, "incorrect-prediction")
+	}
+	return err
+}
+
+func verifyActivation(incomingSo *ScaledObject, action string, _ bool) error {
+	err := CheckActivationValid(incomingSo)
+	if err != nil {
+		scaledobjectlog.WithValues("name", incomingSo.Name).Error(err, "validation error")
+		metricscollector.RecordScaledObjectValidatingErrors(incomingSo.Namespace, action, "incorrect-activation")
+	}
+	return err
+}
+
 func verifyTriggers(incomingObject interface{}, action string, _ bool) error {
 	var triggers []ScaleTriggers
 	var name string
@@ -235,6 +255,11 @@ func verifyTriggers(incomingObject interface{}, action string, _ bool) error {
 }
 
 func verifyHpas(incomingSo *ScaledObject, action string, _ bool) error {
+	// a ScaledObject in dry-run mode doesn't manage any HPA, it can shadow a workload scaled by another autoscaler
+	if incomingSo.IsDryRun() {
+		return nil
+	}
+
 	hpaList := &autoscalingv2.HorizontalPodAutoscalerList{}
 	opt := &client.ListOptions{
 		Namespace: incomingSo.Namespace,
@@ -313,6 +338,10 @@ func verifyScaledObjects(incomingSo *ScaledObject, action string, _ bool) error
 		if so.Name == incomingSo.Name {
 			continue
 		}
+		// ScaledObjects in dry-run mode neither scale the workload nor create the HPA, so they don't conflict
+		if so.IsDryRun() || incomingSo.IsDryRun() {
+			continue
+		}
 		val, _ := json.MarshalIndent(so, "", "  ")
 		scaledobjectlog.V(1).Info(fmt.Sprintf("checking scaledobject %s: %v", so.Name, string(val)))
 
@@ -447,24 +476,45 @@ func verifyCPUMemoryScalers(incomingSo *ScaledObject, action string, dryRun bool
 // (with dummy values that determine whether all necessary triggers are defined)
 // and returns it to be stored in cache and reused.
 func ValidateAndCompileScalingModifiers(so *ScaledObject) (*vm.Program, error) {
-	sm := so.Spec.Advanced.ScalingModifiers
+	return validateAndCompileScalingModifiers(&so.Spec.Advanced.ScalingModifiers, so.Spec.Triggers)
+}
 
+// ValidateAndCompileScaledJobScalingModifiers validates the scalingModifiers of a ScaledJob
+// and returns the compiled formula to be stored in cache and reused. The metricType is
+// meaningless for a ScaledJob and the formula replaces the multipleScalersCalculation.
+func ValidateAndCompileScaledJobScalingModifiers(sj *ScaledJob) (*vm.Program, error) {
+	sm := sj.Spec.ScalingModifiers
+	if sm.MetricType != "" {
+		return nil, fmt.Errorf("error ScalingModifiers.MetricType isn't supported by ScaledJob")
+	}
+	if sj.Spec.ScalingStrategy.MultipleScalersCalculation != "" {
+		return nil, fmt.Errorf("error ScalingModifiers.Formula and ScalingStrategy.MultipleScalersCalculation can't be used together")
+	}
+	if sm.ActivationTarget != "" {
+		if _, err := strconv.ParseFloat(sm.ActivationTarget, 64); err != nil {
+			return nil, fmt.Errorf("error converting activationTarget for scalingModifiers (string->float): %w", err)
+		}
+	}
+	return validateAndCompileScalingModifiers(sm, sj.Spec.Triggers)
+}
+
+func validateAndCompileScalingModifiers(sm *ScalingModifiers, triggers []ScaleTriggers) (*vm.Program, error) {
 	if sm.Formula == "" {
 		return nil, fmt.Errorf("error ScalingModifiers.Formula is mandatory")
 	}
 
 	// cast return value of formula to float if necessary to avoid wrong value return
 	// type (ternary operator doesnt return float)
-	so.Spec.Advanced.ScalingModifiers.Formula = castToFloatIfNecessary(sm.Formula)
+	sm.Formula = castToFloatIfNecessary(sm.Formula)
 
 	// validate formula if not empty
-	compiledFormula, err := validateScalingModifiersFormula(so)
+	compiledFormula, err := validateScalingModifiersFormula(sm, triggers)
 	if err != nil {
 		err := errors.Join(fmt.Errorf("error validating formula in ScalingModifiers"), err)
 		return nil, err
 	}
 	// validate target if not empty
-	err = validateScalingModifiersTarget(so)
+	err = validateScalingModifiersTarget(sm)
 	if err != nil {
 		err := errors.Join(fmt.Errorf("error validating target in ScalingModifiers"), err)
 		return nil, err
@@ -474,9 +524,7 @@ func ValidateAndCompileScalingModifiers(so *ScaledObject) (*vm.Program, error) {
 
 // validateScalingModifiersFormula helps validate the ScalingModifiers struct,
 // specifically the formula.
-func validateScalingModifiersFormula(so *ScaledObject) (*vm.Program, error) {
-	sm := so.Spec.Advanced.ScalingModifiers
-
+func validateScalingModifiersFormula(sm *ScalingModifiers, triggers []ScaleTriggers) (*vm.Program, error) {
 	// if formula is empty, nothing to validate
 	if sm.Formula == "" {
 		return nil, nil
@@ -492,7 +540,7 @@ func validateScalingModifiersFormula(so *ScaledObject) (*vm.Program, error) {
 	// Compile & Run with dummy values to determine if all triggers in formula are
 	// defined (have names)
 	triggersMap := make(map[string]float64)
-	for _, trig := range so.Spec.Triggers {
+	for _, trig := range triggers {
 		// if resource metrics are given, skip
 		if trig.Type == cpuString || trig.Type == memoryString {
 			continue
@@ -512,9 +560,7 @@ func validateScalingModifiersFormula(so *ScaledObject) (*vm.Program, error) {
 	return compiled, nil
 }
 
-func validateScalingModifiersTarget(so *ScaledObject) error {
-	sm := so.Spec.Advanced.ScalingModifiers
-
+func validateScalingModifiersTarget(sm *ScalingModifiers) error {
 	if sm.Target == "" {
 		return nil
 	}
@@ -525,7 +571,7 @@ func validateScalingModifiersTarget(so *ScaledObject) error {
 		return fmt.Errorf("error converting target for scalingModifiers (string->float) to valid target: %w", err)
 	}
 
-	if so.Spec.Advanced.ScalingModifiers.MetricType == autoscalingv2.UtilizationMetricType {
+	if sm.MetricType == autoscalingv2.UtilizationMetricType {
 		err := fmt.Errorf("error trigger type is Utilization, but it needs to be AverageValue or Value for external metrics")
 		return err
 	}
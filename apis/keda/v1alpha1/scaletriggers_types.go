@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	"fmt"
 	"slices"
+	"strconv"
 	"strings"
 
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
@@ -31,6 +32,20 @@ type ScaleTriggers struct {
 	Name string `json:"name,omitempty"`
 
 	UseCachedMetrics bool `json:"useCachedMetrics,omitempty"`
+	// MaxMetricAge is the maximum age, in seconds, of a cached metric (useCachedMetrics),
+	// older metrics are treated as errors
+	// +optional
+	MaxMetricAge *int32 `json:"maxMetricAge,omitempty"`
+	// PollingInterval is the interval, in seconds, at which the trigger is polled by the scale loop,
+	// it defaults to the pollingInterval of the ScaledObject/ScaledJob
+	// +optional
+	PollingInterval *int32 `json:"pollingInterval,omitempty"`
+	// +optional
+	AdaptivePolling *AdaptivePolling `json:"adaptivePolling,omitempty"`
+	// ActivationHysteresis replaces the activation threshold of the scaler with separate thresholds
+	// to activate and deactivate the trigger, it's only supported by ScaledObjects
+	// +optional
+	ActivationHysteresis *ActivationHysteresis `json:"activationHysteresis,omitempty"`
 
 	Metadata map[string]string `json:"metadata"`
 	// +optional
@@ -39,6 +54,58 @@ type ScaleTriggers struct {
 	MetricType autoscalingv2.MetricTargetType `json:"metricType,omitempty"`
 }
 
+// AdaptivePolling backs off the polling of a trigger while its metrics are unchanged, up to MaxPollingInterval,
+// and goes back to the trigger pollingInterval as soon as they change
+type AdaptivePolling struct {
+	// MaxPollingInterval is the maximum interval, in seconds, the polling can back off to
+	// +kubebuilder:validation:Minimum=1
+	MaxPollingInterval int32 `json:"maxPollingInterval"`
+	// BackoffFactor multiplies the polling interval each time the metrics are unchanged, defaults to 2
+	// +optional
+	BackoffFactor string `json:"backoffFactor,omitempty"`
+}
+
+const defaultAdaptivePollingBackoffFactor = 2
+
+// GetBackoffFactor returns the parsed BackoffFactor, it must be greater than 1
+func (ap *AdaptivePolling) GetBackoffFactor() (float64, error) {
+	if ap.BackoffFactor == "" {
+		return defaultAdaptivePollingBackoffFactor, nil
+	}
+	factor, err := strconv.ParseFloat(ap.BackoffFactor, 64)
+	if err != nil {
+		return 0, fmt.Errorf("error parsing backoffFactor %q: %w", ap.BackoffFactor, err)
+	}
+	if factor <= 1 {
+		return 0, fmt.Errorf("backoffFactor must be greater than 1, got %s", ap.BackoffFactor)
+	}
+	return factor, nil
+}
+
+// ActivationHysteresis activates the trigger once its metric value is above ActivateThreshold, the high watermark,
+// and deactivates it once the value is at or below DeactivateThreshold, the low watermark
+type ActivationHysteresis struct {
+	ActivateThreshold   string `json:"activateThreshold"`
+	DeactivateThreshold string `json:"deactivateThreshold"`
+}
+
+// GetThresholds returns the parsed activate and deactivate thresholds,
+// the deactivate threshold must be lower than or equal to the activate one
+func (ah *ActivationHysteresis) GetThresholds() (float64, float64, error) {
+	activate, err := strconv.ParseFloat(ah.ActivateThreshold, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("error parsing activateThreshold %q: %w", ah.ActivateThreshold, err)
+	}
+	deactivate, err := strconv.ParseFloat(ah.DeactivateThreshold, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("error parsing deactivateThreshold %q: %w", ah.DeactivateThreshold, err)
+	}
+	if deactivate > activate {
+		return 0, 0, fmt.Errorf("deactivateThreshold %s must be lower than or equal to activateThreshold %s", ah.DeactivateThreshold, ah.ActivateThreshold)
+	}
+	return activate, deactivate, nil
+}
+
 // AuthenticationRef points to the TriggerAuthentication or ClusterTriggerAuthentication object that
 // is used to authenticate the scaler with the environment
 type AuthenticationRef struct {
@@ -51,6 +118,9 @@ type AuthenticationRef struct {
 // ValidateTriggers checks that general trigger metadata are valid, it checks:
 // - triggerNames in ScaledObject are unique
 // - useCachedMetrics is defined only for a supported triggers
+// - maxMetricAge is positive and defined only together with useCachedMetrics
+// - pollingInterval and adaptivePolling are valid
+// - activationHysteresis thresholds are valid
 func ValidateTriggers(triggers []ScaleTriggers) error {
 	triggersCount := len(triggers)
 
@@ -69,6 +139,25 @@ func ValidateTriggers(triggers []ScaleTriggers) error {
 				}
 			}
 
+			if trigger.MaxMetricAge != nil {
+				if !trigger.UseCachedMetrics {
+					return fmt.Errorf("property \"maxMetricAge\" requires \"useCachedMetrics\" to be enabled")
+				}
+				if *trigger.MaxMetricAge <= 0 {
+					return fmt.Errorf("property \"maxMetricAge\" must be greater than 0, got %d", *trigger.MaxMetricAge)
+				}
+			}
+
+			if err := validateTriggerPolling(trigger); err != nil {
+				return err
+			}
+
+			if trigger.ActivationHysteresis != nil {
+				if _, _, err := trigger.ActivationHysteresis.GetThresholds(); err != nil {
+					return fmt.Errorf("property \"activationHysteresis\" is invalid: %w", err)
+				}
+			}
+
 			name := trigger.Name
 			if name != "" {
 				if _, found := triggerNames[name]; found {
@@ -83,6 +172,25 @@ func ValidateTriggers(triggers []ScaleTriggers) error {
 	return nil
 }
 
+func validateTriggerPolling(trigger ScaleTriggers) error {
+	if trigger.PollingInterval != nil && *trigger.PollingInterval <= 0 {
+		return fmt.Errorf("property \"pollingInterval\" must be greater than 0, got %d", *trigger.PollingInterval)
+	}
+	if trigger.AdaptivePolling == nil {
+		return nil
+	}
+	if trigger.AdaptivePolling.MaxPollingInterval <= 0 {
+		return fmt.Errorf("property \"adaptivePolling.maxPollingInterval\" must be greater than 0, got %d", trigger.AdaptivePolling.MaxPollingInterval)
+	}
+	if trigger.PollingInterval != nil && trigger.AdaptivePolling.MaxPollingInterval < *trigger.PollingInterval {
+		return fmt.Errorf("property \"adaptivePolling.maxPollingInterval\" must be greater than or equal to \"pollingInterval\"")
+	}
+	if _, err := trigger.AdaptivePolling.GetBackoffFactor(); err != nil {
+		return fmt.Errorf("property \"adaptivePolling.backoffFactor\" is invalid: %w", err)
+	}
+	return nil
+}
+
 // CombinedTriggersAndAuthenticationsTypes returns a comma separated string of all trigger types and authentication types
 func CombinedTriggersAndAuthenticationsTypes(triggers []ScaleTriggers) (string, string) {
 	var triggersTypes []string
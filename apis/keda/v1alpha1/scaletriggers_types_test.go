@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
 )
 
 func TestValidateTriggers(t *testing.T) {
@@ -84,6 +85,128 @@ func TestValidateTriggers(t *testing.T) {
 			},
 			expectedErrMsg: "",
 		},
+		{
+			name: "maxMetricAge property with useCachedMetrics",
+			triggers: []ScaleTriggers{
+				{
+					Name:             "trigger5",
+					Type:             "kafka",
+					UseCachedMetrics: true,
+					MaxMetricAge:     ptr.To[int32](60),
+				},
+			},
+			expectedErrMsg: "",
+		},
+		{
+			name: "maxMetricAge property without useCachedMetrics",
+			triggers: []ScaleTriggers{
+				{
+					Name:         "trigger6",
+					Type:         "kafka",
+					MaxMetricAge: ptr.To[int32](60),
+				},
+			},
+			expectedErrMsg: "property \"maxMetricAge\" requires \"useCachedMetrics\" to be enabled",
+		},
+		{
+			name: "non positive maxMetricAge property",
+			triggers: []ScaleTriggers{
+				{
+					Name:             "trigger7",
+					Type:             "kafka",
+					UseCachedMetrics: true,
+					MaxMetricAge:     ptr.To[int32](0),
+				},
+			},
+			expectedErrMsg: "property \"maxMetricAge\" must be greater than 0, got 0",
+		},
+		{
+			name: "pollingInterval and adaptivePolling properties",
+			triggers: []ScaleTriggers{
+				{
+					Name:            "trigger8",
+					Type:            "kafka",
+					PollingInterval: ptr.To[int32](10),
+					AdaptivePolling: &AdaptivePolling{
+						MaxPollingInterval: 120,
+						BackoffFactor:      "1.5",
+					},
+				},
+			},
+			expectedErrMsg: "",
+		},
+		{
+			name: "non positive pollingInterval property",
+			triggers: []ScaleTriggers{
+				{
+					Name:            "trigger9",
+					Type:            "kafka",
+					PollingInterval: ptr.To[int32](0),
+				},
+			},
+			expectedErrMsg: "property \"pollingInterval\" must be greater than 0, got 0",
+		},
+		{
+			name: "maxPollingInterval lower than pollingInterval",
+			triggers: []ScaleTriggers{
+				{
+					Name:            "trigger10",
+					Type:            "kafka",
+					PollingInterval: ptr.To[int32](60),
+					AdaptivePolling: &AdaptivePolling{
+						MaxPollingInterval: 30,
+					},
+				},
+			},
+			expectedErrMsg: "property \"adaptivePolling.maxPollingInterval\" must be greater than or equal to \"pollingInterval\"",
+		},
+		{
+			name: "adaptivePolling backoffFactor not greater than 1",
+			triggers: []ScaleTriggers{
+				{
+					Name: "trigger11",
+					Type: "kafka",
+					AdaptivePolling: &AdaptivePolling{
+						MaxPollingInterval: 300,
+						BackoffFactor:      "1",
+					},
+				},
+			},
+			expectedErrMsg: "property \"adaptivePolling.backoffFactor\" is invalid: backoffFactor must be greater than 1, got 1",
+		},
+		{
+			name: "valid activationHysteresis",
+			triggers: []ScaleTriggers{
+				{
+					Name:                 "trigger12",
+					Type:                 "kafka",
+					ActivationHysteresis: &ActivationHysteresis{ActivateThreshold: "10", DeactivateThreshold: "2.5"},
+				},
+			},
+			expectedErrMsg: "",
+		},
+		{
+			name: "activationHysteresis deactivateThreshold above activateThreshold",
+			triggers: []ScaleTriggers{
+				{
+					Name:                 "trigger13",
+					Type:                 "kafka",
+					ActivationHysteresis: &ActivationHysteresis{ActivateThreshold: "5", DeactivateThreshold: "10"},
+				},
+			},
+			expectedErrMsg: "property \"activationHysteresis\" is invalid: deactivateThreshold 10 must be lower than or equal to activateThreshold 5",
+		},
+		{
+			name: "activationHysteresis unparsable threshold",
+			triggers: []ScaleTriggers{
+				{
+					Name:                 "trigger14",
+					Type:                 "kafka",
+					ActivationHysteresis: &ActivationHysteresis{ActivateThreshold: "high", DeactivateThreshold: "1"},
+				},
+			},
+			expectedErrMsg: "property \"activationHysteresis\" is invalid: error parsing activateThreshold \"high\": strconv.ParseFloat: parsing \"high\": invalid syntax",
+		},
 		{
 			name:           "empty triggers array should be blocked",
 			triggers:       []ScaleTriggers{},
@@ -0,0 +1,92 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=scalinghistories,scope=Namespaced,shortName=sh
+// +kubebuilder:printcolumn:name="Kind",type="string",JSONPath=".spec.scalableObjectRef.kind"
+// +kubebuilder:printcolumn:name="Name",type="string",JSONPath=".spec.scalableObjectRef.name"
+// +kubebuilder:printcolumn:name="Last Transition",type="date",JSONPath=".status.lastTransitionTime"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ScalingHistory is the Schema for the scalinghistories API, it mirrors the latest scale transitions
+// of a ScaledObject or a ScaledJob recorded by KEDA
+type ScalingHistory struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ScalingHistorySpec   `json:"spec"`
+	Status ScalingHistoryStatus `json:"status,omitempty"`
+}
+
+// ScalingHistorySpec references the object whose scale transitions are recorded
+type ScalingHistorySpec struct {
+	ScalableObjectRef ScalableObjectReference `json:"scalableObjectRef"`
+}
+
+// ScalableObjectReference references a ScaledObject or a ScaledJob in the same namespace
+type ScalableObjectReference struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
+
+// ScalingHistoryStatus contains the scale transitions, from the oldest to the latest
+type ScalingHistoryStatus struct {
+	// +optional
+	Transitions []ScalingTransition `json:"transitions,omitempty"`
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// ScalingTransition describes a change of the replicas of a ScaledObject scale target or the creation of Jobs by a ScaledJob
+type ScalingTransition struct {
+	Time metav1.Time `json:"time"`
+	// FromReplicas is the number of replicas, or running Jobs, before the transition
+	FromReplicas int32 `json:"fromReplicas"`
+	// ToReplicas is the number of replicas, or running Jobs, after the transition
+	ToReplicas int32  `json:"toReplicas"`
+	Reason     string `json:"reason"`
+	// +optional
+	Message string `json:"message,omitempty"`
+	// +optional
+	ActiveTriggers []string `json:"activeTriggers,omitempty"`
+	// MetricValues are the values of the metrics when the transition happened, indexed by metric name
+	// +optional
+	MetricValues map[string]string `json:"metricValues,omitempty"`
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+	// +optional
+	Fallback bool `json:"fallback,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ScalingHistoryList contains a list of ScalingHistory
+type ScalingHistoryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []ScalingHistory `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ScalingHistory{}, &ScalingHistoryList{})
+}
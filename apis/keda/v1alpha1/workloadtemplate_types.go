@@ -0,0 +1,167 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// WorkloadTemplate is the template of the workloads created by a ScaledJob instead of Jobs, e.g. Pods,
+// Argo Workflows or Tekton PipelineRuns. KEDA must be granted the permissions to manage the workloads.
+type WorkloadTemplate struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	// Template is the workload object, its apiVersion, kind, name and namespace are set by KEDA
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	Template runtime.RawExtension `json:"template"`
+	// Status defines how the state of the workloads is evaluated, it can be omitted for Pods,
+	// Argo Workflows and Tekton PipelineRuns and TaskRuns
+	// +optional
+	Status *WorkloadStatusRules `json:"status,omitempty"`
+}
+
+// WorkloadStatusRules match the state of the workloads, the workloads neither finished nor pending are running
+type WorkloadStatusRules struct {
+	// Succeeded matches the workloads which finished successfully
+	Succeeded WorkloadStatusRule `json:"succeeded"`
+	// Failed matches the workloads which finished with a failure
+	Failed WorkloadStatusRule `json:"failed"`
+	// Pending matches the workloads which haven't started yet, no workload is pending if not set
+	// +optional
+	Pending *WorkloadStatusRule `json:"pending,omitempty"`
+}
+
+// WorkloadStatusRule matches a workload either by a status condition or by an expression
+type WorkloadStatusRule struct {
+	// +optional
+	Condition *WorkloadStatusCondition `json:"condition,omitempty"`
+	// Expression is a boolean expression evaluated against the workload object, e.g. status?.phase == "Succeeded"
+	// +optional
+	Expression string `json:"expression,omitempty"`
+}
+
+// WorkloadStatusCondition matches the workloads having the condition of Type with Status in status.conditions
+type WorkloadStatusCondition struct {
+	Type string `json:"type"`
+	// +optional
+	// +kubebuilder:default=True
+	Status string `json:"status,omitempty"`
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// defaultWorkloadStatusRules are the rules of the workloads whose status is known by KEDA
+var defaultWorkloadStatusRules = map[schema.GroupKind]*WorkloadStatusRules{
+	{Group: "", Kind: "Pod"}: {
+		Succeeded: WorkloadStatusRule{Expression: `status?.phase == "Succeeded"`},
+		Failed:    WorkloadStatusRule{Expression: `status?.phase == "Failed"`},
+		Pending:   &WorkloadStatusRule{Expression: `status?.phase in [nil, "Pending"]`},
+	},
+	{Group: "argoproj.io", Kind: "Workflow"}: {
+		Succeeded: WorkloadStatusRule{Expression: `status?.phase == "Succeeded"`},
+		Failed:    WorkloadStatusRule{Expression: `status?.phase in ["Failed", "Error"]`},
+		Pending:   &WorkloadStatusRule{Expression: `status?.phase in [nil, "", "Pending"]`},
+	},
+	{Group: "tekton.dev", Kind: "PipelineRun"}: {
+		Succeeded: WorkloadStatusRule{Condition: &WorkloadStatusCondition{Type: "Succeeded", Status: "True"}},
+		Failed:    WorkloadStatusRule{Condition: &WorkloadStatusCondition{Type: "Succeeded", Status: "False"}},
+		Pending:   &WorkloadStatusRule{Expression: `status?.startTime == nil`},
+	},
+	{Group: "tekton.dev", Kind: "TaskRun"}: {
+		Succeeded: WorkloadStatusRule{Condition: &WorkloadStatusCondition{Type: "Succeeded", Status: "True"}},
+		Failed:    WorkloadStatusRule{Condition: &WorkloadStatusCondition{Type: "Succeeded", Status: "False"}},
+		Pending:   &WorkloadStatusRule{Expression: `status?.startTime == nil`},
+	},
+}
+
+// GroupVersionKind returns the GroupVersionKind of the workloads
+func (t *WorkloadTemplate) GroupVersionKind() schema.GroupVersionKind {
+	return schema.FromAPIVersionAndKind(t.APIVersion, t.Kind)
+}
+
+// GetStatusRules returns the status rules of the workloads, or the default rules of their kind if not set
+func (t *WorkloadTemplate) GetStatusRules() *WorkloadStatusRules {
+	if t.Status != nil {
+		return t.Status
+	}
+	return defaultWorkloadStatusRules[t.GroupVersionKind().GroupKind()]
+}
+
+// CompileWorkloadStatusExpression compiles the expression of a WorkloadStatusRule
+func CompileWorkloadStatusExpression(expression string) (*vm.Program, error) {
+	return expr.Compile(expression, expr.AsBool(), expr.AllowUndefinedVariables())
+}
+
+// CheckScaledJobWorkloadTemplateValid checks that the ScaledJob has either a jobTargetRef or a valid workloadTemplate
+func CheckScaledJobWorkloadTemplateValid(scaledJob *ScaledJob) error {
+	template := scaledJob.Spec.WorkloadTemplate
+	if template == nil {
+		return nil
+	}
+	if scaledJob.Spec.JobTargetRef != nil {
+		return fmt.Errorf("jobTargetRef and workloadTemplate can't be used together")
+	}
+
+	if template.APIVersion == "" || template.Kind == "" {
+		return fmt.Errorf("workloadTemplate apiVersion and kind must be set")
+	}
+	if _, err := schema.ParseGroupVersion(template.APIVersion); err != nil {
+		return fmt.Errorf("workloadTemplate apiVersion is invalid: %w", err)
+	}
+	object := map[string]any{}
+	if err := json.Unmarshal(template.Template.Raw, &object); err != nil {
+		return fmt.Errorf("workloadTemplate template must be an object: %w", err)
+	}
+
+	if binding := scaledJob.Spec.MessageBinding; binding != nil && binding.InjectAs != MessageBindingInjectAsAnnotation {
+		return fmt.Errorf("messageBinding must be injected as annotation in the workloads of a workloadTemplate")
+	}
+
+	rules := template.GetStatusRules()
+	if rules == nil {
+		return fmt.Errorf("workloadTemplate status must be set for kind %s", template.Kind)
+	}
+	for _, named := range []struct {
+		name string
+		rule *WorkloadStatusRule
+	}{{"succeeded", &rules.Succeeded}, {"failed", &rules.Failed}, {"pending", rules.Pending}} {
+		name, rule := named.name, named.rule
+		if rule == nil {
+			continue
+		}
+		if (rule.Condition == nil) == (rule.Expression == "") {
+			return fmt.Errorf("workloadTemplate status %s must define either a condition or an expression", name)
+		}
+		if rule.Condition != nil && rule.Condition.Type == "" {
+			return fmt.Errorf("workloadTemplate status %s condition type must be set", name)
+		}
+		if rule.Expression != "" {
+			if _, err := CompileWorkloadStatusExpression(rule.Expression); err != nil {
+				return fmt.Errorf("workloadTemplate status %s expression is invalid: %w", name, err)
+			}
+		}
+	}
+	return nil
+}
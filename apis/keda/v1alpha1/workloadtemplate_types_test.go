@@ -0,0 +1,99 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"strings"
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestCheckScaledJobWorkloadTemplateValid(t *testing.T) {
+	template := runtime.RawExtension{Raw: []byte(`{"spec":{}}`)}
+	tests := []struct {
+		name        string
+		spec        ScaledJobSpec
+		expectedErr string
+	}{
+		{
+			name: "Jobs",
+			spec: ScaledJobSpec{JobTargetRef: &batchv1.JobSpec{}},
+		},
+		{
+			name: "Tekton PipelineRuns with the default status rules",
+			spec: ScaledJobSpec{WorkloadTemplate: &WorkloadTemplate{APIVersion: "tekton.dev/v1", Kind: "PipelineRun", Template: template}},
+		},
+		{
+			name: "Custom workloads with expressions",
+			spec: ScaledJobSpec{WorkloadTemplate: &WorkloadTemplate{APIVersion: "example.com/v1", Kind: "Task", Template: template, Status: &WorkloadStatusRules{
+				Succeeded: WorkloadStatusRule{Expression: `status?.state == "done"`},
+				Failed:    WorkloadStatusRule{Condition: &WorkloadStatusCondition{Type: "Failed"}},
+			}}},
+		},
+		{
+			name:        "Both jobTargetRef and workloadTemplate",
+			spec:        ScaledJobSpec{JobTargetRef: &batchv1.JobSpec{}, WorkloadTemplate: &WorkloadTemplate{APIVersion: "v1", Kind: "Pod", Template: template}},
+			expectedErr: "jobTargetRef and workloadTemplate can't be used together",
+		},
+		{
+			name:        "Missing template",
+			spec:        ScaledJobSpec{WorkloadTemplate: &WorkloadTemplate{APIVersion: "v1", Kind: "Pod"}},
+			expectedErr: "workloadTemplate template must be an object",
+		},
+		{
+			name:        "Unknown kind without status rules",
+			spec:        ScaledJobSpec{WorkloadTemplate: &WorkloadTemplate{APIVersion: "example.com/v1", Kind: "Task", Template: template}},
+			expectedErr: "workloadTemplate status must be set for kind Task",
+		},
+		{
+			name: "Invalid expression",
+			spec: ScaledJobSpec{WorkloadTemplate: &WorkloadTemplate{APIVersion: "example.com/v1", Kind: "Task", Template: template, Status: &WorkloadStatusRules{
+				Succeeded: WorkloadStatusRule{Expression: `status?.state ==`},
+				Failed:    WorkloadStatusRule{Expression: `false`},
+			}}},
+			expectedErr: "workloadTemplate status succeeded expression is invalid",
+		},
+		{
+			name: "Rule with both a condition and an expression",
+			spec: ScaledJobSpec{WorkloadTemplate: &WorkloadTemplate{APIVersion: "example.com/v1", Kind: "Task", Template: template, Status: &WorkloadStatusRules{
+				Succeeded: WorkloadStatusRule{Expression: `true`},
+				Failed:    WorkloadStatusRule{Expression: `false`, Condition: &WorkloadStatusCondition{Type: "Failed"}},
+			}}},
+			expectedErr: "workloadTemplate status failed must define either a condition or an expression",
+		},
+		{
+			name:        "Message injected as env",
+			spec:        ScaledJobSpec{WorkloadTemplate: &WorkloadTemplate{APIVersion: "v1", Kind: "Pod", Template: template}, MessageBinding: &ScaledJobMessageBinding{}},
+			expectedErr: "messageBinding must be injected as annotation in the workloads of a workloadTemplate",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := CheckScaledJobWorkloadTemplateValid(&ScaledJob{Spec: test.spec})
+			if test.expectedErr == "" {
+				if err != nil {
+					t.Errorf("Expected no error but got: %v", err)
+				}
+			} else if err == nil || !strings.HasPrefix(err.Error(), test.expectedErr) {
+				t.Errorf("Expected error %q but got: %v", test.expectedErr, err)
+			}
+		})
+	}
+}
@@ -23,9 +23,40 @@ package v1alpha1
 import (
 	"k8s.io/api/autoscaling/v2"
 	"k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActivationHysteresis) DeepCopyInto(out *ActivationHysteresis) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActivationHysteresis.
+func (in *ActivationHysteresis) DeepCopy() *ActivationHysteresis {
+	if in == nil {
+		return nil
+	}
+	out := new(ActivationHysteresis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdaptivePolling) DeepCopyInto(out *AdaptivePolling) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdaptivePolling.
+func (in *AdaptivePolling) DeepCopy() *AdaptivePolling {
+	if in == nil {
+		return nil
+	}
+	out := new(AdaptivePolling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AdvancedConfig) DeepCopyInto(out *AdvancedConfig) {
 	*out = *in
@@ -35,6 +66,16 @@ func (in *AdvancedConfig) DeepCopyInto(out *AdvancedConfig) {
 		(*in).DeepCopyInto(*out)
 	}
 	out.ScalingModifiers = in.ScalingModifiers
+	if in.Prediction != nil {
+		in, out := &in.Prediction, &out.Prediction
+		*out = new(PredictionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ActivationStabilizationWindow != nil {
+		in, out := &in.ActivationStabilizationWindow, &out.ActivationStabilizationWindow
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdvancedConfig.
@@ -492,9 +533,38 @@ func (in *Credential) DeepCopy() *Credential {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DryRunStatus) DeepCopyInto(out *DryRunStatus) {
+	*out = *in
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DryRunStatus.
+func (in *DryRunStatus) DeepCopy() *DryRunStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DryRunStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Fallback) DeepCopyInto(out *Fallback) {
 	*out = *in
+	if in.HoldPeriod != nil {
+		in, out := &in.HoldPeriod, &out.HoldPeriod
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DecayPeriod != nil {
+		in, out := &in.DecayPeriod, &out.DecayPeriod
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Fallback.
@@ -632,6 +702,15 @@ func (in *HealthStatus) DeepCopyInto(out *HealthStatus) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.LastKnownValue != nil {
+		in, out := &in.LastKnownValue, &out.LastKnownValue
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.LastKnownValueTime != nil {
+		in, out := &in.LastKnownValueTime, &out.LastKnownValueTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthStatus.
@@ -664,9 +743,34 @@ func (in *HorizontalPodAutoscalerConfig) DeepCopy() *HorizontalPodAutoscalerConf
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PredictionConfig) DeepCopyInto(out *PredictionConfig) {
+	*out = *in
+	if in.SeasonalPeriod != nil {
+		in, out := &in.SeasonalPeriod, &out.SeasonalPeriod
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PredictionConfig.
+func (in *PredictionConfig) DeepCopy() *PredictionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PredictionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Rollout) DeepCopyInto(out *Rollout) {
 	*out = *in
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(RolloutCanary)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Rollout.
@@ -679,6 +783,41 @@ func (in *Rollout) DeepCopy() *Rollout {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutCanary) DeepCopyInto(out *RolloutCanary) {
+	*out = *in
+	if in.SuccessRatio != nil {
+		in, out := &in.SuccessRatio, &out.SuccessRatio
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutCanary.
+func (in *RolloutCanary) DeepCopy() *RolloutCanary {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutCanary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalableObjectReference) DeepCopyInto(out *ScalableObjectReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalableObjectReference.
+func (in *ScalableObjectReference) DeepCopy() *ScalableObjectReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalableObjectReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScaleTarget) DeepCopyInto(out *ScaleTarget) {
 	*out = *in
@@ -697,6 +836,26 @@ func (in *ScaleTarget) DeepCopy() *ScaleTarget {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScaleTriggers) DeepCopyInto(out *ScaleTriggers) {
 	*out = *in
+	if in.MaxMetricAge != nil {
+		in, out := &in.MaxMetricAge, &out.MaxMetricAge
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PollingInterval != nil {
+		in, out := &in.PollingInterval, &out.PollingInterval
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AdaptivePolling != nil {
+		in, out := &in.AdaptivePolling, &out.AdaptivePolling
+		*out = new(AdaptivePolling)
+		**out = **in
+	}
+	if in.ActivationHysteresis != nil {
+		in, out := &in.ActivationHysteresis, &out.ActivationHysteresis
+		*out = new(ActivationHysteresis)
+		**out = **in
+	}
 	if in.Metadata != nil {
 		in, out := &in.Metadata, &out.Metadata
 		*out = make(map[string]string, len(*in))
@@ -763,6 +922,146 @@ func (in *ScaledJobCustomValidator) DeepCopy() *ScaledJobCustomValidator {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaledJobFailureBudget) DeepCopyInto(out *ScaledJobFailureBudget) {
+	*out = *in
+	out.Window = in.Window
+	out.InitialBackoff = in.InitialBackoff
+	out.MaxBackoff = in.MaxBackoff
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaledJobFailureBudget.
+func (in *ScaledJobFailureBudget) DeepCopy() *ScaledJobFailureBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaledJobFailureBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaledJobFailureBudgetStatus) DeepCopyInto(out *ScaledJobFailureBudgetStatus) {
+	*out = *in
+	if in.PausedUntil != nil {
+		in, out := &in.PausedUntil, &out.PausedUntil
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaledJobFailureBudgetStatus.
+func (in *ScaledJobFailureBudgetStatus) DeepCopy() *ScaledJobFailureBudgetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaledJobFailureBudgetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaledJobFallback) DeepCopyInto(out *ScaledJobFallback) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaledJobFallback.
+func (in *ScaledJobFallback) DeepCopy() *ScaledJobFallback {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaledJobFallback)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaledJobGroup) DeepCopyInto(out *ScaledJobGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaledJobGroup.
+func (in *ScaledJobGroup) DeepCopy() *ScaledJobGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaledJobGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScaledJobGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaledJobGroupList) DeepCopyInto(out *ScaledJobGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ScaledJobGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaledJobGroupList.
+func (in *ScaledJobGroupList) DeepCopy() *ScaledJobGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaledJobGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScaledJobGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaledJobGroupReference) DeepCopyInto(out *ScaledJobGroupReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaledJobGroupReference.
+func (in *ScaledJobGroupReference) DeepCopy() *ScaledJobGroupReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaledJobGroupReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaledJobGroupSpec) DeepCopyInto(out *ScaledJobGroupSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaledJobGroupSpec.
+func (in *ScaledJobGroupSpec) DeepCopy() *ScaledJobGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaledJobGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScaledJobList) DeepCopyInto(out *ScaledJobList) {
 	*out = *in
@@ -795,6 +1094,71 @@ func (in *ScaledJobList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaledJobMessageBinding) DeepCopyInto(out *ScaledJobMessageBinding) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaledJobMessageBinding.
+func (in *ScaledJobMessageBinding) DeepCopy() *ScaledJobMessageBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaledJobMessageBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaledJobReservation) DeepCopyInto(out *ScaledJobReservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaledJobReservation.
+func (in *ScaledJobReservation) DeepCopy() *ScaledJobReservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaledJobReservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaledJobRolloutStatus) DeepCopyInto(out *ScaledJobRolloutStatus) {
+	*out = *in
+	if in.StableJobTargetRef != nil {
+		in, out := &in.StableJobTargetRef, &out.StableJobTargetRef
+		*out = new(v1.JobSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaledJobRolloutStatus.
+func (in *ScaledJobRolloutStatus) DeepCopy() *ScaledJobRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaledJobRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaledJobScaleDecision) DeepCopyInto(out *ScaledJobScaleDecision) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaledJobScaleDecision.
+func (in *ScaledJobScaleDecision) DeepCopy() *ScaledJobScaleDecision {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaledJobScaleDecision)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScaledJobSpec) DeepCopyInto(out *ScaledJobSpec) {
 	*out = *in
@@ -803,6 +1167,11 @@ func (in *ScaledJobSpec) DeepCopyInto(out *ScaledJobSpec) {
 		*out = new(v1.JobSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.WorkloadTemplate != nil {
+		in, out := &in.WorkloadTemplate, &out.WorkloadTemplate
+		*out = new(WorkloadTemplate)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.PollingInterval != nil {
 		in, out := &in.PollingInterval, &out.PollingInterval
 		*out = new(int32)
@@ -818,7 +1187,7 @@ func (in *ScaledJobSpec) DeepCopyInto(out *ScaledJobSpec) {
 		*out = new(int32)
 		**out = **in
 	}
-	out.Rollout = in.Rollout
+	in.Rollout.DeepCopyInto(&out.Rollout)
 	if in.MinReplicaCount != nil {
 		in, out := &in.MinReplicaCount, &out.MinReplicaCount
 		*out = new(int32)
@@ -837,6 +1206,41 @@ func (in *ScaledJobSpec) DeepCopyInto(out *ScaledJobSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Fallback != nil {
+		in, out := &in.Fallback, &out.Fallback
+		*out = new(ScaledJobFallback)
+		**out = **in
+	}
+	if in.MessageBinding != nil {
+		in, out := &in.MessageBinding, &out.MessageBinding
+		*out = new(ScaledJobMessageBinding)
+		**out = **in
+	}
+	if in.FailureBudget != nil {
+		in, out := &in.FailureBudget, &out.FailureBudget
+		*out = new(ScaledJobFailureBudget)
+		**out = **in
+	}
+	if in.Group != nil {
+		in, out := &in.Group, &out.Group
+		*out = new(ScaledJobGroupReference)
+		**out = **in
+	}
+	if in.ActiveWindows != nil {
+		in, out := &in.ActiveWindows, &out.ActiveWindows
+		*out = make([]ScaledJobTimeWindow, len(*in))
+		copy(*out, *in)
+	}
+	if in.BlackoutWindows != nil {
+		in, out := &in.BlackoutWindows, &out.BlackoutWindows
+		*out = make([]ScaledJobTimeWindow, len(*in))
+		copy(*out, *in)
+	}
+	if in.ScalingModifiers != nil {
+		in, out := &in.ScalingModifiers, &out.ScalingModifiers
+		*out = new(ScalingModifiers)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaledJobSpec.
@@ -849,6 +1253,35 @@ func (in *ScaledJobSpec) DeepCopy() *ScaledJobSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaledJobStatistics) DeepCopyInto(out *ScaledJobStatistics) {
+	*out = *in
+	if in.AverageJobDuration != nil {
+		in, out := &in.AverageJobDuration, &out.AverageJobDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.LastScaleDecision != nil {
+		in, out := &in.LastScaleDecision, &out.LastScaleDecision
+		*out = new(ScaledJobScaleDecision)
+		**out = **in
+	}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaledJobStatistics.
+func (in *ScaledJobStatistics) DeepCopy() *ScaledJobStatistics {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaledJobStatistics)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScaledJobStatus) DeepCopyInto(out *ScaledJobStatus) {
 	*out = *in
@@ -871,6 +1304,38 @@ func (in *ScaledJobStatus) DeepCopyInto(out *ScaledJobStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Health != nil {
+		in, out := &in.Health, &out.Health
+		*out = make(map[string]HealthStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.FailureBudget != nil {
+		in, out := &in.FailureBudget, &out.FailureBudget
+		*out = new(ScaledJobFailureBudgetStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TimeWindows != nil {
+		in, out := &in.TimeWindows, &out.TimeWindows
+		*out = new(ScaledJobTimeWindowsStatus)
+		**out = **in
+	}
+	if in.InFlightReservations != nil {
+		in, out := &in.InFlightReservations, &out.InFlightReservations
+		*out = make([]ScaledJobReservation, len(*in))
+		copy(*out, *in)
+	}
+	if in.Statistics != nil {
+		in, out := &in.Statistics, &out.Statistics
+		*out = new(ScaledJobStatistics)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(ScaledJobRolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaledJobStatus.
@@ -883,6 +1348,37 @@ func (in *ScaledJobStatus) DeepCopy() *ScaledJobStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaledJobTimeWindow) DeepCopyInto(out *ScaledJobTimeWindow) {
+	*out = *in
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaledJobTimeWindow.
+func (in *ScaledJobTimeWindow) DeepCopy() *ScaledJobTimeWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaledJobTimeWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaledJobTimeWindowsStatus) DeepCopyInto(out *ScaledJobTimeWindowsStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaledJobTimeWindowsStatus.
+func (in *ScaledJobTimeWindowsStatus) DeepCopy() *ScaledJobTimeWindowsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaledJobTimeWindowsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScaledObject) DeepCopyInto(out *ScaledObject) {
 	*out = *in
@@ -1010,7 +1506,7 @@ func (in *ScaledObjectSpec) DeepCopyInto(out *ScaledObjectSpec) {
 	if in.Fallback != nil {
 		in, out := &in.Fallback, &out.Fallback
 		*out = new(Fallback)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -1078,6 +1574,16 @@ func (in *ScaledObjectStatus) DeepCopyInto(out *ScaledObjectStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(DryRunStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScalingDecision != nil {
+		in, out := &in.ScalingDecision, &out.ScalingDecision
+		*out = new(ScalingDecision)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaledObjectStatus.
@@ -1090,6 +1596,131 @@ func (in *ScaledObjectStatus) DeepCopy() *ScaledObjectStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalingDecision) DeepCopyInto(out *ScalingDecision) {
+	*out = *in
+	if in.Triggers != nil {
+		in, out := &in.Triggers, &out.Triggers
+		*out = make([]TriggerDecision, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingDecision.
+func (in *ScalingDecision) DeepCopy() *ScalingDecision {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalingDecision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalingHistory) DeepCopyInto(out *ScalingHistory) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingHistory.
+func (in *ScalingHistory) DeepCopy() *ScalingHistory {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalingHistory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScalingHistory) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalingHistoryList) DeepCopyInto(out *ScalingHistoryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ScalingHistory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingHistoryList.
+func (in *ScalingHistoryList) DeepCopy() *ScalingHistoryList {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalingHistoryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScalingHistoryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalingHistorySpec) DeepCopyInto(out *ScalingHistorySpec) {
+	*out = *in
+	out.ScalableObjectRef = in.ScalableObjectRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingHistorySpec.
+func (in *ScalingHistorySpec) DeepCopy() *ScalingHistorySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalingHistorySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalingHistoryStatus) DeepCopyInto(out *ScalingHistoryStatus) {
+	*out = *in
+	if in.Transitions != nil {
+		in, out := &in.Transitions, &out.Transitions
+		*out = make([]ScalingTransition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingHistoryStatus.
+func (in *ScalingHistoryStatus) DeepCopy() *ScalingHistoryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalingHistoryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScalingModifiers) DeepCopyInto(out *ScalingModifiers) {
 	*out = *in
@@ -1130,6 +1761,34 @@ func (in *ScalingStrategy) DeepCopy() *ScalingStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalingTransition) DeepCopyInto(out *ScalingTransition) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	if in.ActiveTriggers != nil {
+		in, out := &in.ActiveTriggers, &out.ActiveTriggers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MetricValues != nil {
+		in, out := &in.MetricValues, &out.MetricValues
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingTransition.
+func (in *ScalingTransition) DeepCopy() *ScalingTransition {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalingTransition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretKeyRef) DeepCopyInto(out *SecretKeyRef) {
 	*out = *in
@@ -1294,6 +1953,21 @@ func (in *TriggerAuthenticationStatus) DeepCopy() *TriggerAuthenticationStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TriggerDecision) DeepCopyInto(out *TriggerDecision) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TriggerDecision.
+func (in *TriggerDecision) DeepCopy() *TriggerDecision {
+	if in == nil {
+		return nil
+	}
+	out := new(TriggerDecision)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ValueFromSecret) DeepCopyInto(out *ValueFromSecret) {
 	*out = *in
@@ -1425,3 +2099,81 @@ func (in *WithTriggersSpec) DeepCopy() *WithTriggersSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadStatusCondition) DeepCopyInto(out *WorkloadStatusCondition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadStatusCondition.
+func (in *WorkloadStatusCondition) DeepCopy() *WorkloadStatusCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadStatusCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadStatusRule) DeepCopyInto(out *WorkloadStatusRule) {
+	*out = *in
+	if in.Condition != nil {
+		in, out := &in.Condition, &out.Condition
+		*out = new(WorkloadStatusCondition)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadStatusRule.
+func (in *WorkloadStatusRule) DeepCopy() *WorkloadStatusRule {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadStatusRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadStatusRules) DeepCopyInto(out *WorkloadStatusRules) {
+	*out = *in
+	in.Succeeded.DeepCopyInto(&out.Succeeded)
+	in.Failed.DeepCopyInto(&out.Failed)
+	if in.Pending != nil {
+		in, out := &in.Pending, &out.Pending
+		*out = new(WorkloadStatusRule)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadStatusRules.
+func (in *WorkloadStatusRules) DeepCopy() *WorkloadStatusRules {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadStatusRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadTemplate) DeepCopyInto(out *WorkloadTemplate) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(WorkloadStatusRules)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadTemplate.
+func (in *WorkloadTemplate) DeepCopy() *WorkloadTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
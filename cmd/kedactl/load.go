@@ -0,0 +1,249 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/kubernetes/fake"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+	"github.com/kedacore/keda/v2/pkg/scalers/authentication"
+)
+
+// manifests are the objects loaded from the files passed to kedactl
+type manifests struct {
+	scalableObjects []client.Object
+	objects         []client.Object
+}
+
+// loadManifests reads the YAML or JSON documents of the files, "-" reads the standard input.
+// The namespaced objects without namespace are put in namespace.
+func loadManifests(files []string, namespace string) (*manifests, error) {
+	result := &manifests{}
+	for _, file := range files {
+		var err error
+		if file == "-" {
+			err = result.read(os.Stdin, namespace)
+		} else {
+			err = result.readFile(file, namespace)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error loading %s: %w", file, err)
+		}
+	}
+	return result, nil
+}
+
+func (m *manifests) readFile(file string, namespace string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return m.read(f, namespace)
+}
+
+func (m *manifests) read(reader io.Reader, namespace string) error {
+	decoder := serializer.NewCodecFactory(scheme).UniversalDeserializer()
+	yamlReader := utilyaml.NewYAMLReader(bufio.NewReader(reader))
+	for {
+		document, err := yamlReader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if len(bytes.TrimSpace(document)) == 0 {
+			continue
+		}
+
+		obj, err := decodeObject(decoder, document)
+		if err != nil {
+			return err
+		}
+		// the API server merges the stringData of the Secrets into their data
+		if secret, ok := obj.(*corev1.Secret); ok && len(secret.StringData) > 0 {
+			if secret.Data == nil {
+				secret.Data = map[string][]byte{}
+			}
+			for k, v := range secret.StringData {
+				secret.Data[k] = []byte(v)
+			}
+		}
+		if _, isCluster := obj.(*kedav1alpha1.ClusterTriggerAuthentication); !isCluster && obj.GetNamespace() == "" {
+			obj.SetNamespace(namespace)
+		}
+		switch obj.(type) {
+		case *kedav1alpha1.ScaledObject, *kedav1alpha1.ScaledJob:
+			m.scalableObjects = append(m.scalableObjects, obj)
+		default:
+			m.objects = append(m.objects, obj)
+		}
+	}
+}
+
+// decodeObject decodes the document into a typed object if its kind is known, otherwise into an unstructured object
+func decodeObject(decoder runtime.Decoder, document []byte) (client.Object, error) {
+	obj, _, err := decoder.Decode(document, nil, nil)
+	if err == nil {
+		clientObj, ok := obj.(client.Object)
+		if !ok {
+			return nil, fmt.Errorf("unsupported object %T", obj)
+		}
+		return clientObj, nil
+	}
+	if !runtime.IsNotRegisteredError(err) {
+		return nil, err
+	}
+
+	jsonDocument, err := yaml.YAMLToJSON(document)
+	if err != nil {
+		return nil, err
+	}
+	unstruct := &unstructured.Unstructured{}
+	if err := unstruct.UnmarshalJSON(jsonDocument); err != nil {
+		return nil, err
+	}
+	return unstruct, nil
+}
+
+// selectScalableObject returns the ScaledObject or ScaledJob named name, name can be omitted if there is only one
+func (m *manifests) selectScalableObject(name string) (client.Object, error) {
+	var candidates []client.Object
+	for _, obj := range m.scalableObjects {
+		if name == "" || obj.GetName() == name {
+			candidates = append(candidates, obj)
+		}
+	}
+	switch {
+	case len(candidates) == 1:
+		return candidates[0], nil
+	case len(candidates) == 0 && name != "":
+		return nil, fmt.Errorf("no ScaledObject or ScaledJob named %s found", name)
+	case len(candidates) == 0:
+		return nil, fmt.Errorf("no ScaledObject or ScaledJob found")
+	default:
+		names := make([]string, 0, len(candidates))
+		for _, obj := range candidates {
+			names = append(names, obj.GetName())
+		}
+		return nil, fmt.Errorf("several ScaledObjects or ScaledJobs found (%s), select one with --name", strings.Join(names, ", "))
+	}
+}
+
+// prepareScaledObject fills the status of the ScaledObject the operator would have set, and returns the replicas
+// of its scale target. A Deployment or a StatefulSet without containers is used when the scale target isn't provided.
+func (m *manifests) prepareScaledObject(scaledObject *kedav1alpha1.ScaledObject) (int32, error) {
+	apiVersion := scaledObject.Spec.ScaleTargetRef.APIVersion
+	if apiVersion == "" {
+		apiVersion = "apps/v1"
+	}
+	kind := scaledObject.Spec.ScaleTargetRef.Kind
+	if kind == "" {
+		kind = "Deployment"
+	}
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return 0, err
+	}
+	scaledObject.Status.ScaleTargetKind = kedav1alpha1.GroupVersionKindResource{Group: gv.Group, Version: gv.Version, Kind: kind}.GVKString()
+	scaledObject.Status.ScaleTargetGVKR = &kedav1alpha1.GroupVersionKindResource{
+		Group:    gv.Group,
+		Version:  gv.Version,
+		Kind:     kind,
+		Resource: strings.ToLower(kind) + "s",
+	}
+
+	for _, obj := range m.objects {
+		if obj.GetName() != scaledObject.Spec.ScaleTargetRef.Name || obj.GetNamespace() != scaledObject.Namespace ||
+			obj.GetObjectKind().GroupVersionKind() != gv.WithKind(kind) {
+			continue
+		}
+		switch target := obj.(type) {
+		case *appsv1.Deployment:
+			return replicasOrDefault(target.Spec.Replicas), nil
+		case *appsv1.StatefulSet:
+			return replicasOrDefault(target.Spec.Replicas), nil
+		case *unstructured.Unstructured:
+			replicas, _, _ := unstructured.NestedInt64(target.Object, "spec", "replicas")
+			return int32(replicas), nil
+		}
+	}
+
+	stub := metav1.ObjectMeta{Name: scaledObject.Spec.ScaleTargetRef.Name, Namespace: scaledObject.Namespace}
+	switch {
+	case gv.Group == "apps" && kind == "Deployment":
+		m.objects = append(m.objects, &appsv1.Deployment{ObjectMeta: stub})
+	case gv.Group == "apps" && kind == "StatefulSet":
+		m.objects = append(m.objects, &appsv1.StatefulSet{ObjectMeta: stub})
+	default:
+		return 0, fmt.Errorf("scale target %s %s/%s not found, add it to the files", kind, scaledObject.Namespace, scaledObject.Spec.ScaleTargetRef.Name)
+	}
+	return 0, nil
+}
+
+// clients returns the clients resolving the objects loaded from the files
+func (m *manifests) clients() (client.Client, *authentication.AuthClientSet, error) {
+	secretIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	var coreObjects []runtime.Object
+	for _, obj := range m.objects {
+		switch obj.(type) {
+		case *corev1.Secret:
+			if err := secretIndexer.Add(obj); err != nil {
+				return nil, nil, err
+			}
+			coreObjects = append(coreObjects, obj)
+		case *corev1.ConfigMap, *corev1.ServiceAccount:
+			coreObjects = append(coreObjects, obj)
+		}
+	}
+
+	kubeClient := fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(m.objects...).Build()
+	authClientSet := &authentication.AuthClientSet{
+		CoreV1Interface: fake.NewSimpleClientset(coreObjects...).CoreV1(),
+		SecretLister:    corev1listers.NewSecretLister(secretIndexer),
+	}
+	return kubeClient, authClientSet, nil
+}
+
+func replicasOrDefault(replicas *int32) int32 {
+	if replicas == nil {
+		return 1
+	}
+	return *replicas
+}
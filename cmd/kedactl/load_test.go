@@ -0,0 +1,218 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+)
+
+const (
+	scaledObjectManifest = `apiVersion: keda.sh/v1alpha1
+kind: ScaledObject
+metadata:
+  name: orders
+spec:
+  scaleTargetRef:
+    name: orders-consumer
+  triggers:
+  - type: cron
+    metadata:
+      timezone: UTC
+      start: 0 8 * * *
+      end: 0 18 * * *
+      desiredReplicas: "3"
+`
+	scaledJobManifest = `apiVersion: keda.sh/v1alpha1
+kind: ScaledJob
+metadata:
+  name: reports
+  namespace: jobs
+spec:
+  jobTargetRef:
+    template:
+      spec:
+        containers:
+        - name: report
+          image: report
+  triggers:
+  - type: cron
+    metadata:
+      timezone: UTC
+      start: 0 8 * * *
+      end: 0 18 * * *
+      desiredReplicas: "1"
+`
+	secretManifest = `apiVersion: v1
+kind: Secret
+metadata:
+  name: credentials
+stringData:
+  password: secret
+`
+	unknownKindManifest = `apiVersion: example.com/v1
+kind: Queue
+metadata:
+  name: orders
+spec:
+  replicas: 2
+`
+)
+
+// loadedObject identifies an object loaded from the manifests
+type loadedObject struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+func toLoadedObjects(objects []client.Object) []loadedObject {
+	var result []loadedObject
+	for _, obj := range objects {
+		kind := fmt.Sprintf("%T", obj)
+		if _, ok := obj.(*unstructured.Unstructured); ok {
+			kind = obj.GetObjectKind().GroupVersionKind().Kind
+		}
+		result = append(result, loadedObject{kind: kind, namespace: obj.GetNamespace(), name: obj.GetName()})
+	}
+	return result
+}
+
+func TestManifestsRead(t *testing.T) {
+	tests := []struct {
+		name                    string
+		documents               string
+		expectedScalableObjects []loadedObject
+		expectedObjects         []loadedObject
+		expectedErr             string
+	}{
+		{
+			name:      "multi-document YAML",
+			documents: "---\n" + scaledObjectManifest + "---\n---\n" + secretManifest + "---\n" + scaledJobManifest,
+			expectedScalableObjects: []loadedObject{
+				{kind: "*v1alpha1.ScaledObject", namespace: "default", name: "orders"},
+				{kind: "*v1alpha1.ScaledJob", namespace: "jobs", name: "reports"},
+			},
+			expectedObjects: []loadedObject{{kind: "*v1.Secret", namespace: "default", name: "credentials"}},
+		},
+		{
+			name:            "unknown kind",
+			documents:       unknownKindManifest,
+			expectedObjects: []loadedObject{{kind: "Queue", namespace: "default", name: "orders"}},
+		},
+		{
+			name:      "JSON document",
+			documents: `{"apiVersion": "keda.sh/v1alpha1", "kind": "ClusterTriggerAuthentication", "metadata": {"name": "shared"}}`,
+			// the cluster scoped objects are kept without namespace
+			expectedObjects: []loadedObject{{kind: "*v1alpha1.ClusterTriggerAuthentication", name: "shared"}},
+		},
+		{
+			name:        "document without kind",
+			documents:   "apiVersion: v1\nmetadata:\n  name: orders\n",
+			expectedErr: "Object 'Kind' is missing",
+		},
+		{
+			name:        "invalid YAML",
+			documents:   "apiVersion: v1\nkind: [Secret\n",
+			expectedErr: "yaml",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := &manifests{}
+			err := m.read(strings.NewReader(test.documents), "default")
+			if test.expectedErr != "" {
+				assert.ErrorContains(t, err, test.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedScalableObjects, toLoadedObjects(m.scalableObjects))
+			assert.Equal(t, test.expectedObjects, toLoadedObjects(m.objects))
+		})
+	}
+}
+
+func TestManifestsReadSecretStringData(t *testing.T) {
+	m := &manifests{}
+	assert.NoError(t, m.read(strings.NewReader(secretManifest), "default"))
+	assert.Len(t, m.objects, 1)
+	assert.Equal(t, []byte("secret"), m.objects[0].(*corev1.Secret).Data["password"])
+}
+
+func TestManifestsSelectScalableObject(t *testing.T) {
+	scaledObject := &kedav1alpha1.ScaledObject{}
+	scaledObject.Name = "orders"
+	scaledJob := &kedav1alpha1.ScaledJob{}
+	scaledJob.Name = "reports"
+
+	tests := []struct {
+		name            string
+		scalableObjects []client.Object
+		selected        string
+		expected        client.Object
+		expectedErr     string
+	}{
+		{
+			name:            "single object without name",
+			scalableObjects: []client.Object{scaledJob},
+			expected:        scaledJob,
+		},
+		{
+			name:            "object selected by name",
+			scalableObjects: []client.Object{scaledObject, scaledJob},
+			selected:        "reports",
+			expected:        scaledJob,
+		},
+		{
+			name:            "several objects without name",
+			scalableObjects: []client.Object{scaledObject, scaledJob},
+			expectedErr:     "several ScaledObjects or ScaledJobs found (orders, reports), select one with --name",
+		},
+		{
+			name:            "missing name",
+			scalableObjects: []client.Object{scaledObject, scaledJob},
+			selected:        "payments",
+			expectedErr:     "no ScaledObject or ScaledJob named payments found",
+		},
+		{
+			name:        "no object",
+			expectedErr: "no ScaledObject or ScaledJob found",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := &manifests{scalableObjects: test.scalableObjects}
+			obj, err := m.selectScalableObject(test.selected)
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Same(t, test.expected, obj)
+		})
+	}
+}
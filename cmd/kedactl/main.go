@@ -0,0 +1,222 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// kedactl evaluates the triggers of a ScaledObject or a ScaledJob outside of the cluster. The scalers are built
+// from the manifests passed with --filename, like the operator does, and kedactl prints their metric specs, current
+// values and activity, along with the replicas (or jobs) KEDA and the HPA would request.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/pflag"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+	"github.com/kedacore/keda/v2/pkg/scaling"
+)
+
+var scheme = apimachineryruntime.NewScheme()
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(kedav1alpha1.AddToScheme(scheme))
+}
+
+type options struct {
+	files                  []string
+	name                   string
+	namespace              string
+	clusterObjectNamespace string
+	currentReplicas        int32
+	output                 string
+	watch                  bool
+	interval               time.Duration
+	timeout                time.Duration
+}
+
+func main() {
+	opts := options{}
+	pflag.StringArrayVarP(&opts.files, "filename", "f", nil, "Files containing the ScaledObject or ScaledJob and the objects it references (TriggerAuthentications, Secrets, scale target...), - reads the standard input")
+	pflag.StringVar(&opts.name, "name", "", "Name of the ScaledObject or ScaledJob to evaluate, required when the files contain several of them")
+	pflag.StringVarP(&opts.namespace, "namespace", "n", "default", "Namespace of the objects without namespace")
+	pflag.StringVar(&opts.clusterObjectNamespace, "cluster-object-namespace", "keda", "Namespace of the Secrets referenced by ClusterTriggerAuthentications")
+	pflag.Int32Var(&opts.currentReplicas, "current-replicas", -1, "Current replicas of the scale target, or running jobs of the ScaledJob. Defaults to the replicas of the scale target in the files")
+	pflag.StringVarP(&opts.output, "output", "o", "text", "Output format, text or json")
+	pflag.BoolVarP(&opts.watch, "watch", "w", false, "Evaluate the triggers every interval until interrupted")
+	pflag.DurationVar(&opts.interval, "interval", 0, "Interval between evaluations with --watch, defaults to the pollingInterval of the ScaledObject or ScaledJob")
+	pflag.DurationVar(&opts.timeout, "timeout", 3*time.Second, "HTTP timeout of the scalers")
+
+	zapOpts := zap.Options{}
+	zapOpts.BindFlags(flag.CommandLine)
+	pflag.CommandLine.AddGoFlagSet(flag.CommandLine)
+	pflag.Parse()
+
+	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&zapOpts), zap.WriteTo(os.Stderr)))
+
+	if err := run(ctrl.SetupSignalHandler(), opts, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, opts options, out io.Writer) error {
+	if len(opts.files) == 0 {
+		return fmt.Errorf("at least one file is required, use --filename")
+	}
+	if opts.output != "text" && opts.output != "json" {
+		return fmt.Errorf("unknown output format %q, use text or json", opts.output)
+	}
+	if os.Getenv("KEDA_CLUSTER_OBJECT_NAMESPACE") == "" {
+		if err := os.Setenv("KEDA_CLUSTER_OBJECT_NAMESPACE", opts.clusterObjectNamespace); err != nil {
+			return err
+		}
+	}
+
+	manifests, err := loadManifests(opts.files, opts.namespace)
+	if err != nil {
+		return err
+	}
+	scalableObject, err := manifests.selectScalableObject(opts.name)
+	if err != nil {
+		return err
+	}
+
+	currentReplicas := int32(0)
+	if scaledObject, ok := scalableObject.(*kedav1alpha1.ScaledObject); ok {
+		currentReplicas, err = manifests.prepareScaledObject(scaledObject)
+		if err != nil {
+			return err
+		}
+	}
+	if opts.currentReplicas >= 0 {
+		currentReplicas = opts.currentReplicas
+	}
+
+	kubeClient, authClientSet, err := manifests.clients()
+	if err != nil {
+		return err
+	}
+	evaluator := scaling.NewEvaluator(kubeClient, authClientSet, opts.timeout)
+	defer evaluator.Close(context.Background())
+
+	interval := opts.interval
+	if interval <= 0 {
+		withTriggers, err := kedav1alpha1.AsDuckWithTriggers(scalableObject)
+		if err != nil {
+			return err
+		}
+		interval = withTriggers.GetPollingInterval()
+	}
+
+	for {
+		evaluation, err := evaluator.Evaluate(ctx, scalableObject, currentReplicas)
+		if err != nil {
+			return err
+		}
+		if err := printEvaluation(out, evaluation, opts.output); err != nil {
+			return err
+		}
+		if !opts.watch {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+func printEvaluation(out io.Writer, evaluation *scaling.Evaluation, output string) error {
+	if output == "json" {
+		// one object per line, so the output of --watch can be streamed to jq
+		return json.NewEncoder(out).Encode(evaluation)
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "%s %s/%s evaluated at %s\n\n", evaluation.Kind, evaluation.Namespace, evaluation.Name, evaluation.EvaluatedAt.Format(time.RFC3339))
+	fmt.Fprintln(w, "TRIGGER\tTYPE\tMETRIC\tTARGET\tVALUE\tACTIVE\tERROR")
+	for _, trigger := range evaluation.Triggers {
+		name := trigger.Name
+		if name == "" {
+			name = fmt.Sprintf("#%d", trigger.Index)
+		}
+		if len(trigger.MetricSpecs) == 0 {
+			fmt.Fprintf(w, "%s\t%s\t-\t-\t-\t%t\t%s\n", name, trigger.Type, trigger.IsActive, trigger.Error)
+		}
+		for _, spec := range trigger.MetricSpecs {
+			metricName, target, value := describeMetric(trigger, spec)
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%t\t%s\n", name, trigger.Type, metricName, target, value, trigger.IsActive, trigger.Error)
+		}
+	}
+	fmt.Fprintln(w)
+	replicasLabel := "replicas"
+	if evaluation.Kind == "ScaledJob" {
+		replicasLabel = "jobs"
+	}
+	fmt.Fprintf(w, "Active:\t%t\n", evaluation.IsActive)
+	fmt.Fprintf(w, "Error:\t%t\n", evaluation.IsError)
+	fmt.Fprintf(w, "Current %s:\t%d\n", replicasLabel, evaluation.CurrentReplicas)
+	fmt.Fprintf(w, "Desired %s:\t%d\n", replicasLabel, evaluation.DesiredReplicas)
+	fmt.Fprintln(w, strings.Repeat("-", 40))
+	return w.Flush()
+}
+
+// describeMetric returns the name, the target and the current value of the metric
+func describeMetric(trigger scaling.TriggerEvaluation, spec autoscalingv2.MetricSpec) (string, string, string) {
+	switch {
+	case spec.External != nil:
+		target := "-"
+		switch {
+		case spec.External.Target.AverageValue != nil:
+			target = fmt.Sprintf("%s (AverageValue)", spec.External.Target.AverageValue.String())
+		case spec.External.Target.Value != nil:
+			target = fmt.Sprintf("%s (Value)", spec.External.Target.Value.String())
+		}
+		value := "-"
+		for _, metric := range trigger.Metrics {
+			if metric.MetricName == spec.External.Metric.Name {
+				value = metric.Value.String()
+			}
+		}
+		return spec.External.Metric.Name, target, value
+	case spec.Resource != nil:
+		target := "-"
+		if spec.Resource.Target.AverageUtilization != nil {
+			target = fmt.Sprintf("%d%% (Utilization)", *spec.Resource.Target.AverageUtilization)
+		} else if spec.Resource.Target.AverageValue != nil {
+			target = fmt.Sprintf("%s (AverageValue)", spec.Resource.Target.AverageValue.String())
+		}
+		// resource metrics are collected by the HPA from the metrics server
+		return string(spec.Resource.Name), target, "n/a"
+	default:
+		return "-", "-", "-"
+	}
+}
@@ -18,6 +18,7 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"os"
 	"time"
 
@@ -48,6 +49,9 @@ import (
 	"github.com/kedacore/keda/v2/pkg/metricsservice"
 	"github.com/kedacore/keda/v2/pkg/scalers/authentication"
 	"github.com/kedacore/keda/v2/pkg/scaling"
+	scalingcache "github.com/kedacore/keda/v2/pkg/scaling/cache"
+	"github.com/kedacore/keda/v2/pkg/scaling/cache/metricscache"
+	scalinghistory "github.com/kedacore/keda/v2/pkg/scaling/history"
 	kedautil "github.com/kedacore/keda/v2/pkg/util"
 	//+kubebuilder:scaffold:imports
 )
@@ -237,8 +241,89 @@ func main() {
 		SecretLister:    secretInformer.Lister(),
 	}
 
-	scaledHandler := scaling.NewScaleHandler(mgr.GetClient(), scaleClient, mgr.GetScheme(), globalHTTPTimeout, eventRecorder, authClientSet)
+	// the metrics cache is kept in memory by default, it can be persisted to a ConfigMap to survive restarts and leader changes
+	var metricsCacheStorage metricscache.Storage
+	switch metricsCacheStorageType := os.Getenv("KEDA_METRICS_CACHE_STORAGE"); metricsCacheStorageType {
+	case "", "memory":
+		metricsCacheStorage = metricscache.NewMemoryStorage()
+	case "configmap":
+		syncInterval := 30 * time.Second
+		configuredSyncInterval, err := kedautil.ResolveOsEnvDuration("KEDA_METRICS_CACHE_SYNC_INTERVAL")
+		if err != nil || (configuredSyncInterval != nil && *configuredSyncInterval <= 0) {
+			setupLog.Error(err, "invalid KEDA_METRICS_CACHE_SYNC_INTERVAL")
+			os.Exit(1)
+		}
+		if configuredSyncInterval != nil {
+			syncInterval = *configuredSyncInterval
+		}
+		configMapName, found := os.LookupEnv("KEDA_METRICS_CACHE_CONFIGMAP_NAME")
+		if !found || configMapName == "" {
+			configMapName = "keda-operator-metrics-cache"
+		}
+		configMapStorage := metricscache.NewConfigMapStorage(mgr.GetAPIReader(), mgr.GetClient(), kedautil.GetPodNamespace(), configMapName, syncInterval)
+		if err := mgr.Add(configMapStorage); err != nil {
+			setupLog.Error(err, "unable to set up metrics cache storage")
+			os.Exit(1)
+		}
+		metricsCacheStorage = configMapStorage
+	default:
+		setupLog.Error(fmt.Errorf("unknown metrics cache storage %q", metricsCacheStorageType), "invalid KEDA_METRICS_CACHE_STORAGE")
+		os.Exit(1)
+	}
+
+	// identical requests of different ScaledObjects/ScaledJobs to the same backend can be coalesced and the requests per endpoint rate limited
+	requestCoalescing, err := kedautil.ResolveOsEnvBool("KEDA_SCALER_REQUEST_COALESCING", false)
+	if err != nil {
+		setupLog.Error(err, "invalid KEDA_SCALER_REQUEST_COALESCING")
+		os.Exit(1)
+	}
+	requestCoalescingTTL, err := kedautil.ResolveOsEnvDuration("KEDA_SCALER_REQUEST_COALESCING_TTL")
+	if err != nil || (requestCoalescingTTL != nil && *requestCoalescingTTL < 0) {
+		setupLog.Error(err, "invalid KEDA_SCALER_REQUEST_COALESCING_TTL")
+		os.Exit(1)
+	}
+	endpointLimits, err := scalingcache.ParseEndpointLimits(os.Getenv("KEDA_SCALER_ENDPOINT_RATE_LIMITS"))
+	if err != nil {
+		setupLog.Error(err, "invalid KEDA_SCALER_ENDPOINT_RATE_LIMITS")
+		os.Exit(1)
+	}
+	var requestCoalescer *scalingcache.RequestCoalescer
+	if requestCoalescing || len(endpointLimits) > 0 {
+		requestCoalescerConfig := scalingcache.RequestCoalescerConfig{
+			Coalesce:       requestCoalescing,
+			EndpointLimits: endpointLimits,
+		}
+		if requestCoalescingTTL != nil {
+			requestCoalescerConfig.ResultTTL = *requestCoalescingTTL
+		}
+		requestCoalescer = scalingcache.NewRequestCoalescer(requestCoalescerConfig)
+	}
+
+	// the latest scale transitions of each ScaledObject and ScaledJob are kept in memory and can be mirrored to ScalingHistory objects
+	scalingHistorySize, err := kedautil.ResolveOsEnvInt("KEDA_SCALING_HISTORY_SIZE", scalinghistory.DefaultSize)
+	if err != nil || scalingHistorySize <= 0 {
+		setupLog.Error(err, "invalid KEDA_SCALING_HISTORY_SIZE")
+		os.Exit(1)
+	}
+	scalingHistoryCRD, err := kedautil.ResolveOsEnvBool("KEDA_SCALING_HISTORY_CRD", false)
+	if err != nil {
+		setupLog.Error(err, "invalid KEDA_SCALING_HISTORY_CRD")
+		os.Exit(1)
+	}
+	var scalingHistoryMirror scalinghistory.Mirror
+	if scalingHistoryCRD {
+		scalingHistoryMirror = scalinghistory.NewCRDMirror(mgr.GetClient(), scalingHistorySize)
+	}
+	scalingHistory := scalinghistory.NewStore(scalingHistorySize, scalingHistoryMirror)
+	if scalingHistoryMirror != nil {
+		if err := mgr.Add(scalingHistory); err != nil {
+			setupLog.Error(err, "unable to set up scaling history mirror")
+			os.Exit(1)
+		}
+	}
+
 	eventEmitter := eventemitter.NewEventEmitter(mgr.GetClient(), eventRecorder, k8sClusterName, authClientSet)
+	scaledHandler := scaling.NewScaleHandler(mgr.GetClient(), scaleClient, mgr.GetScheme(), globalHTTPTimeout, eventRecorder, eventEmitter, authClientSet, metricsCacheStorage, requestCoalescer, scalingHistory)
 
 	if err = (&kedacontrollers.ScaledObjectReconciler{
 		Client:       mgr.GetClient(),
@@ -258,6 +343,8 @@ func main() {
 		GlobalHTTPTimeout: globalHTTPTimeout,
 		EventEmitter:      eventEmitter,
 		AuthClientSet:     authClientSet,
+		RequestCoalescer:  requestCoalescer,
+		ScalingHistory:    scalingHistory,
 	}).SetupWithManager(mgr, controller.Options{
 		MaxConcurrentReconciles: scaledJobMaxReconciles,
 	}); err != nil {
@@ -374,6 +374,27 @@ func (r *ScaledObjectReconciler) getScaledObjectMetricSpecs(ctx context.Context,
 			scaledObjectMetricSpecs = finalHpaSpecs
 		}
 	}
+
+	// if prediction is enabled, add the forecast as an additional metric, its value is already
+	// the number of replicas needed for the forecasted load, so the target is AverageValue=1
+	if scaledObject.IsUsingPrediction() {
+		predictionSpec := autoscalingv2.MetricSpec{
+			Type: autoscalingv2.ExternalMetricSourceType,
+			External: &autoscalingv2.ExternalMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{
+					Name: kedav1alpha1.PredictionMetricName,
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{kedav1alpha1.ScaledObjectOwnerAnnotation: scaledObject.Name},
+					},
+				},
+				Target: autoscalingv2.MetricTarget{
+					Type:         autoscalingv2.AverageValueMetricType,
+					AverageValue: resource.NewQuantity(1, resource.DecimalSI),
+				},
+			},
+		}
+		scaledObjectMetricSpecs = append(scaledObjectMetricSpecs, predictionSpec)
+	}
 	err = kedastatus.UpdateScaledObjectStatus(ctx, r.Client, logger, scaledObject, status)
 
 	if err != nil {
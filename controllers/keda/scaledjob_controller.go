@@ -28,6 +28,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/cache"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -46,6 +47,9 @@ import (
 	"github.com/kedacore/keda/v2/pkg/metricscollector"
 	"github.com/kedacore/keda/v2/pkg/scalers/authentication"
 	"github.com/kedacore/keda/v2/pkg/scaling"
+	scalingcache "github.com/kedacore/keda/v2/pkg/scaling/cache"
+	"github.com/kedacore/keda/v2/pkg/scaling/cache/metricscache"
+	scalinghistory "github.com/kedacore/keda/v2/pkg/scaling/history"
 	kedastatus "github.com/kedacore/keda/v2/pkg/status"
 	"github.com/kedacore/keda/v2/pkg/util"
 )
@@ -60,6 +64,10 @@ type ScaledJobReconciler struct {
 	GlobalHTTPTimeout time.Duration
 	EventEmitter      eventemitter.EventHandler
 	AuthClientSet     *authentication.AuthClientSet
+	// RequestCoalescer is shared with the ScaledObjects scale handler, it can be nil
+	RequestCoalescer *scalingcache.RequestCoalescer
+	// ScalingHistory is shared with the ScaledObjects scale handler, it can be nil
+	ScalingHistory *scalinghistory.Store
 
 	scaledJobGenerations *sync.Map
 	scaleHandler         scaling.ScaleHandler
@@ -82,7 +90,7 @@ func init() {
 
 // SetupWithManager initializes the ScaledJobReconciler instance and starts a new controller managed by the passed Manager instance.
 func (r *ScaledJobReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
-	r.scaleHandler = scaling.NewScaleHandler(mgr.GetClient(), nil, mgr.GetScheme(), r.GlobalHTTPTimeout, mgr.GetEventRecorderFor("scale-handler"), r.AuthClientSet)
+	r.scaleHandler = scaling.NewScaleHandler(mgr.GetClient(), nil, mgr.GetScheme(), r.GlobalHTTPTimeout, mgr.GetEventRecorderFor("scale-handler"), r.EventEmitter, r.AuthClientSet, metricscache.NewMemoryStorage(), r.RequestCoalescer, r.ScalingHistory)
 	r.scaledJobGenerations = &sync.Map{}
 	return ctrl.NewControllerManagedBy(mgr).
 		WithOptions(options).
@@ -139,9 +147,9 @@ func (r *ScaledJobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		}
 	}
 
-	// Check jobTargetRef is specified
-	if scaledJob.Spec.JobTargetRef == nil {
-		errMsg := "ScaledJob.spec.jobTargetRef not found"
+	// Check jobTargetRef or workloadTemplate is specified
+	if scaledJob.Spec.JobTargetRef == nil && scaledJob.Spec.WorkloadTemplate == nil {
+		errMsg := "ScaledJob.spec.jobTargetRef or ScaledJob.spec.workloadTemplate not found"
 		err := fmt.Errorf("%s", errMsg)
 		reqLogger.Error(err, errMsg)
 		r.EventEmitter.Emit(scaledJob, req.Namespace, corev1.EventTypeWarning, eventingv1alpha1.ScaledJobFailedType, eventreason.ScaledJobCheckFailed, errMsg)
@@ -163,6 +171,10 @@ func (r *ScaledJobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		conditions.SetReadyCondition(metav1.ConditionTrue, "ScaledJobReady", msg)
 	}
 
+	if scaledJob.Spec.Fallback == nil {
+		conditions.SetFallbackCondition(metav1.ConditionFalse, "NoFallbackFound", "No fallbacks are active on this scaled job")
+	}
+
 	if err := kedastatus.SetStatusConditions(ctx, r.Client, reqLogger, scaledJob, &conditions); err != nil {
 		r.EventEmitter.Emit(scaledJob, req.Namespace, corev1.EventTypeWarning, eventingv1alpha1.ScaledJobFailedType, eventreason.ScaledJobUpdateFailed, err.Error())
 		return ctrl.Result{}, err
@@ -261,34 +273,57 @@ func (r *ScaledJobReconciler) checkIfPaused(ctx context.Context, logger logr.Log
 	return false, nil
 }
 
+// listScaledJobWorkloads lists the Jobs, or the workloads created from the workloadTemplate, of the scaledJob
+func (r *ScaledJobReconciler) listScaledJobWorkloads(ctx context.Context, scaledJob *kedav1alpha1.ScaledJob, opts ...client.ListOption) ([]client.Object, error) {
+	var workloads []client.Object
+	if scaledJob.Spec.WorkloadTemplate != nil {
+		gvk := scaledJob.Spec.WorkloadTemplate.GroupVersionKind()
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := r.List(ctx, list, opts...); err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			workloads = append(workloads, &list.Items[i])
+		}
+		return workloads, nil
+	}
+
+	jobs := &batchv1.JobList{}
+	if err := r.List(ctx, jobs, opts...); err != nil {
+		return nil, err
+	}
+	for i := range jobs.Items {
+		workloads = append(workloads, &jobs.Items[i])
+	}
+	return workloads, nil
+}
+
 // Delete Jobs owned by the previous version of the scaledJob based on the rolloutStrategy given for this scaledJob, if any
 func (r *ScaledJobReconciler) deletePreviousVersionScaleJobs(ctx context.Context, logger logr.Logger, scaledJob *kedav1alpha1.ScaledJob) (string, error) {
-	var rolloutStrategy string
 	if len(scaledJob.Spec.RolloutStrategy) > 0 {
 		logger.Info("RolloutStrategy is deprecated, please us Rollout.Strategy in order to define the desired strategy for job rollouts")
-		rolloutStrategy = scaledJob.Spec.RolloutStrategy
-	} else {
-		rolloutStrategy = scaledJob.Spec.Rollout.Strategy
 	}
 
-	switch rolloutStrategy {
-	case "gradual":
+	switch scaledJob.GetRolloutStrategy() {
+	case kedav1alpha1.RolloutStrategyGradual:
 		logger.Info("RolloutStrategy: gradual, Not deleting jobs owned by the previous version of the scaleJob")
+	case kedav1alpha1.RolloutStrategyCanary:
+		logger.Info("RolloutStrategy: canary, Not deleting jobs owned by the previous version of the scaleJob")
 	default:
 		opts := []client.ListOption{
 			client.InNamespace(scaledJob.GetNamespace()),
 			client.MatchingLabels(map[string]string{"scaledjob.keda.sh/name": scaledJob.GetName()}),
 		}
-		jobs := &batchv1.JobList{}
-		err := r.List(ctx, jobs, opts...)
+		jobs, err := r.listScaledJobWorkloads(ctx, scaledJob, opts...)
 		if err != nil {
 			return "Cannot get list of Jobs owned by this scaledJob", err
 		}
 
-		jobIndexes := make([]int, 0, len(jobs.Items))
+		jobIndexes := make([]int, 0, len(jobs))
 		scaledJobGeneration := strconv.FormatInt(scaledJob.Generation, 10)
-		for i, job := range jobs.Items {
-			if jobGen, ok := job.Annotations["scaledjob.keda.sh/generation"]; !ok {
+		for i, job := range jobs {
+			if jobGen, ok := job.GetAnnotations()["scaledjob.keda.sh/generation"]; !ok {
 				// delete Jobs that don't have the generation annotation
 				jobIndexes = append(jobIndexes, i)
 			} else if jobGen != scaledJobGeneration {
@@ -302,15 +337,15 @@ func (r *ScaledJobReconciler) deletePreviousVersionScaleJobs(ctx context.Context
 		} else {
 			logger.Info("RolloutStrategy: immediate, Deleting jobs owned by the previous version of the scaledJob", "numJobsToDelete", len(jobIndexes))
 			for _, index := range jobIndexes {
-				job := jobs.Items[index]
+				job := jobs[index]
 
 				propagationPolicy := metav1.DeletePropagationBackground
 				if scaledJob.Spec.Rollout.PropagationPolicy == "foreground" {
 					propagationPolicy = metav1.DeletePropagationForeground
 				}
-				err = r.Delete(ctx, &job, client.PropagationPolicy(propagationPolicy))
+				err = r.Delete(ctx, job, client.PropagationPolicy(propagationPolicy))
 				if err != nil {
-					return "Not able to delete job: " + job.Name, err
+					return "Not able to delete job: " + job.GetName(), err
 				}
 			}
 			return fmt.Sprintf("RolloutStrategy: immediate, deleted jobs owned by the previous version of the scaleJob: %d jobs deleted", len(jobIndexes)), nil
@@ -27,6 +27,7 @@ import (
 	"github.com/kedacore/keda/v2/controllers/keda/util"
 	"github.com/kedacore/keda/v2/pkg/common/message"
 	"github.com/kedacore/keda/v2/pkg/eventreason"
+	"github.com/kedacore/keda/v2/pkg/metricscollector"
 )
 
 const (
@@ -56,6 +57,7 @@ func (r *ScaledJobReconciler) finalizeScaledJob(ctx context.Context, logger logr
 			logger.Error(err, "Failed to update TriggerAuthentication Status after removing a finalizer")
 		}
 		r.updatePromMetricsOnDelete(namespacedName)
+		metricscollector.DeleteScaledJobStatistics(scaledJob.Namespace, scaledJob.Name)
 	}
 
 	logger.Info("Successfully finalized ScaledJob")
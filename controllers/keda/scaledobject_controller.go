@@ -46,10 +46,12 @@ import (
 	kedacontrollerutil "github.com/kedacore/keda/v2/controllers/keda/util"
 	"github.com/kedacore/keda/v2/pkg/common/message"
 	"github.com/kedacore/keda/v2/pkg/eventemitter"
+	"github.com/kedacore/keda/v2/pkg/eventemitter/eventdata"
 	"github.com/kedacore/keda/v2/pkg/eventreason"
 	"github.com/kedacore/keda/v2/pkg/fallback"
 	"github.com/kedacore/keda/v2/pkg/metricscollector"
 	"github.com/kedacore/keda/v2/pkg/scaling"
+	"github.com/kedacore/keda/v2/pkg/scaling/executor"
 	kedastatus "github.com/kedacore/keda/v2/pkg/status"
 	"github.com/kedacore/keda/v2/pkg/util"
 )
@@ -135,6 +137,7 @@ func (r *ScaledObjectReconciler) SetupWithManager(mgr ctrl.Manager, options cont
 				kedacontrollerutil.PausedScaleOutPredicate{},
 				kedacontrollerutil.ScaleObjectReadyConditionPredicate{},
 				kedacontrollerutil.ForceActivationPredicate{},
+				kedacontrollerutil.DryRunPredicate{},
 				predicate.GenerationChangedPredicate{},
 			),
 		)).
@@ -227,6 +230,15 @@ func (r *ScaledObjectReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	return ctrl.Result{}, err
 }
 
+// emitPaused emits the pause of the ScaledObject, with the paused replica count if any
+func (r *ScaledObjectReconciler) emitPaused(scaledObject *kedav1alpha1.ScaledObject) {
+	data := eventdata.ScalingData{}
+	if pausedCount, err := executor.GetPausedReplicaCount(scaledObject); err == nil && pausedCount != nil {
+		data.ToReplicas = pausedCount
+	}
+	r.EventEmitter.Emit(scaledObject, scaledObject.Namespace, corev1.EventTypeNormal, eventingv1alpha1.ScaledObjectPausedType, eventreason.ScaledObjectPaused, kedav1alpha1.ScaledObjectConditionPausedMessage, data)
+}
+
 // reconcileScaledObject implements reconciler logic for ScaledObject
 func (r *ScaledObjectReconciler) reconcileScaledObject(ctx context.Context, logger logr.Logger, scaledObject *kedav1alpha1.ScaledObject, conditions *kedav1alpha1.Conditions) (string, error) {
 	// Check the presence of  the following annotations on the scaledObject:
@@ -238,10 +250,11 @@ func (r *ScaledObjectReconciler) reconcileScaledObject(ctx context.Context, logg
 	// - "autoscaling.keda.sh/paused-scale-out"
 	// we also set the status to paused but we allow the scale loop to continue and do not delete the HPA because these are unidirectional pauses.
 	needsToPause := scaledObject.NeedToBePausedByAnnotation()
+	wasPaused := conditions.GetPausedCondition().Status == metav1.ConditionTrue
 	switch {
 	case needsToPause:
 		scaledToPausedCount := true
-		if conditions.GetPausedCondition().Status == metav1.ConditionTrue {
+		if wasPaused {
 			// If scaledobject is in paused condition but replica count is not equal to paused replica count, the following scaling logic needs to be trigger again.
 			scaledToPausedCount = r.checkIfTargetResourceReachPausedCount(ctx, logger, scaledObject)
 			if scaledToPausedCount {
@@ -259,12 +272,19 @@ func (r *ScaledObjectReconciler) reconcileScaledObject(ctx context.Context, logg
 				return msg, err
 			}
 			conditions.SetPausedCondition(metav1.ConditionTrue, kedav1alpha1.ScaledObjectConditionPausedReason, msg)
+			if !wasPaused {
+				r.emitPaused(scaledObject)
+			}
 			return msg, nil
 		}
 	case scaledObject.NeedToPauseScaleIn() || scaledObject.NeedToPauseScaleOut():
 		conditions.SetPausedCondition(metav1.ConditionTrue, kedav1alpha1.ScaledObjectConditionPausedReason, kedav1alpha1.ScaledObjectConditionPausedMessage)
-	case conditions.GetPausedCondition().Status == metav1.ConditionTrue:
+		if !wasPaused {
+			r.emitPaused(scaledObject)
+		}
+	case wasPaused:
 		conditions.SetPausedCondition(metav1.ConditionFalse, "ScaledObjectUnpaused", "pause annotation removed for ScaledObject")
+		r.EventEmitter.Emit(scaledObject, scaledObject.Namespace, corev1.EventTypeNormal, eventingv1alpha1.ScaledObjectUnpausedType, eventreason.ScaledObjectUnpaused, "pause annotation removed for ScaledObject")
 	}
 
 	// Check scale target Name is specified
@@ -300,10 +320,18 @@ func (r *ScaledObjectReconciler) reconcileScaledObject(ctx context.Context, logg
 		return "Cannot update ScaledObject status with triggers'types and authentications'types", err
 	}
 
-	// Create a new HPA or update existing one according to ScaledObject
-	newHPACreated, err := r.ensureHPAForScaledObjectExists(ctx, logger, scaledObject, &gvkr)
-	if err != nil {
-		return "failed to ensure HPA is correctly created for ScaledObject", err
+	newHPACreated := false
+	if scaledObject.IsDryRun() {
+		// ScaledObject in dry-run mode must not scale the workload, the HPA created before switching to dry-run is removed
+		if err := r.ensureOwnedHPAForScaledObjectIsDeleted(ctx, logger, scaledObject); err != nil {
+			return "failed to delete HPA for ScaledObject in dry-run mode", err
+		}
+	} else {
+		// Create a new HPA or update existing one according to ScaledObject
+		newHPACreated, err = r.ensureHPAForScaledObjectExists(ctx, logger, scaledObject, &gvkr)
+		if err != nil {
+			return "failed to ensure HPA is correctly created for ScaledObject", err
+		}
 	}
 	scaleObjectSpecChanged := false
 	if !newHPACreated {
@@ -522,6 +550,26 @@ func (r *ScaledObjectReconciler) ensureHPAForScaledObjectIsDeleted(ctx context.C
 	return true, nil
 }
 
+// ensureOwnedHPAForScaledObjectIsDeleted deletes the HPA of the ScaledObject only if it's controlled by the ScaledObject,
+// the HPAs of other autoscalers shadowed by a ScaledObject in dry-run mode are left untouched
+func (r *ScaledObjectReconciler) ensureOwnedHPAForScaledObjectIsDeleted(ctx context.Context, logger logr.Logger, scaledObject *kedav1alpha1.ScaledObject) error {
+	hpaName := getHPANameOnEnsure(scaledObject)
+	foundHpa := &autoscalingv2.HorizontalPodAutoscaler{}
+	err := r.Client.Get(ctx, types.NamespacedName{Name: hpaName, Namespace: scaledObject.Namespace}, foundHpa)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		logger.Error(err, "failed to get HPA from cluster")
+		return err
+	}
+
+	if !metav1.IsControlledBy(foundHpa, scaledObject) {
+		return nil
+	}
+	return r.deleteHPA(ctx, logger, scaledObject, foundHpa)
+}
+
 func getHPANameOnEnsure(scaledObject *kedav1alpha1.ScaledObject) string {
 	if scaledObject.Status.HpaName != "" {
 		return scaledObject.Status.HpaName
@@ -56,6 +56,14 @@ func (PausedPredicate) Update(e event.UpdateEvent) bool {
 	return checkAnnotation(e, kedav1alpha1.PausedAnnotation)
 }
 
+type DryRunPredicate struct {
+	predicate.Funcs
+}
+
+func (DryRunPredicate) Update(e event.UpdateEvent) bool {
+	return checkAnnotation(e, kedav1alpha1.DryRunAnnotation)
+}
+
 type PausedScaleInPredicate struct {
 	predicate.Funcs
 }
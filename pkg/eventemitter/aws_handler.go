@@ -0,0 +1,45 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventemitter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+	awsutils "github.com/kedacore/keda/v2/pkg/scalers/aws"
+)
+
+// getAwsConfig returns the AWS config of the handlers of the AWS destinations, along with the authorization
+// the config is cached with, to clear it when the handler is closed
+func getAwsConfig(ctx context.Context, identifier string, awsRegion string, authParams map[string]string, podIdentity kedav1alpha1.AuthPodIdentity) (*aws.Config, awsutils.AuthorizationMetadata, error) {
+	if awsRegion == "" {
+		return nil, awsutils.AuthorizationMetadata{}, fmt.Errorf("no aws region provided")
+	}
+
+	awsAuthorization, err := awsutils.GetAwsAuthorization(identifier, awsRegion, podIdentity, nil, authParams, nil)
+	if err != nil {
+		return nil, awsutils.AuthorizationMetadata{}, err
+	}
+	cfg, err := awsutils.GetAwsConfig(ctx, awsAuthorization)
+	if err != nil {
+		return nil, awsutils.AuthorizationMetadata{}, fmt.Errorf("error getting AWS config: %w", err)
+	}
+	return cfg, awsAuthorization, nil
+}
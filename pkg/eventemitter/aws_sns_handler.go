@@ -0,0 +1,105 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// ******************************* DESCRIPTION ****************************** \\
+// AwsSnsHandler focuses on emitting the CloudEventSource to an AWS SNS topic
+// ************************************************************************** \\
+
+package eventemitter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	eventingv1alpha1 "github.com/kedacore/keda/v2/apis/eventing/v1alpha1"
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+	"github.com/kedacore/keda/v2/pkg/eventemitter/eventdata"
+	awsutils "github.com/kedacore/keda/v2/pkg/scalers/aws"
+)
+
+// SnsPublishClient is the part of the SNS client used to publish the events
+type SnsPublishClient interface {
+	Publish(ctx context.Context, params *sns.PublishInput, optFns ...func(*sns.Options)) (*sns.PublishOutput, error)
+}
+
+type AwsSnsHandler struct {
+	Context          context.Context
+	TopicArn         string
+	ClusterName      string
+	Client           SnsPublishClient
+	awsAuthorization awsutils.AuthorizationMetadata
+	logger           logr.Logger
+	activeStatus     metav1.ConditionStatus
+}
+
+func NewAwsSnsHandler(context context.Context, clusterName string, identifier string, spec *eventingv1alpha1.AwsSnsSpec, authParams map[string]string, podIdentity kedav1alpha1.AuthPodIdentity, logger logr.Logger) (*AwsSnsHandler, error) {
+	if spec.TopicArn == "" {
+		return nil, fmt.Errorf("no aws sns topic arn provided")
+	}
+	cfg, awsAuthorization, err := getAwsConfig(context, identifier, spec.AwsRegion, authParams, podIdentity)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Create new aws sns handler with topic: " + spec.TopicArn)
+	return &AwsSnsHandler{
+		Context:          context,
+		TopicArn:         spec.TopicArn,
+		ClusterName:      clusterName,
+		Client:           sns.NewFromConfig(*cfg),
+		awsAuthorization: awsAuthorization,
+		logger:           logger,
+		activeStatus:     metav1.ConditionTrue,
+	}, nil
+}
+
+func (a *AwsSnsHandler) CloseHandler() {
+	awsutils.ClearAwsConfig(a.awsAuthorization)
+}
+
+func (a *AwsSnsHandler) SetActiveStatus(status metav1.ConditionStatus) {
+	a.activeStatus = status
+}
+
+func (a *AwsSnsHandler) GetActiveStatus() metav1.ConditionStatus {
+	return a.activeStatus
+}
+
+func (a *AwsSnsHandler) EmitEvent(eventData eventdata.EventData, failureFunc func(eventData eventdata.EventData, err error)) {
+	message, err := generateCloudEventMessage(a.ClusterName, eventData)
+	if err != nil {
+		a.logger.Error(err, "Failed to generate CloudEvent for aws sns")
+		failureFunc(eventData, err)
+		return
+	}
+
+	_, err = a.Client.Publish(a.Context, &sns.PublishInput{
+		TopicArn: aws.String(a.TopicArn),
+		Message:  aws.String(string(message)),
+	})
+	if err != nil {
+		a.logger.Error(err, "Failed to publish event to aws sns")
+		failureFunc(eventData, err)
+		return
+	}
+
+	a.logger.V(1).Info("Successfully published event to aws sns")
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventemitter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/stretchr/testify/assert"
+
+	eventingv1alpha1 "github.com/kedacore/keda/v2/apis/eventing/v1alpha1"
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+	"github.com/kedacore/keda/v2/pkg/eventemitter/eventdata"
+)
+
+type fakeSnsPublishClient struct {
+	input *sns.PublishInput
+	err   error
+}
+
+func (f *fakeSnsPublishClient) Publish(_ context.Context, params *sns.PublishInput, _ ...func(*sns.Options)) (*sns.PublishOutput, error) {
+	f.input = params
+	return &sns.PublishOutput{}, f.err
+}
+
+func TestNewAwsSnsHandlerMissingSpec(t *testing.T) {
+	_, err := NewAwsSnsHandler(context.TODO(), "test", "test", &eventingv1alpha1.AwsSnsSpec{AwsRegion: "eu-west-1"}, map[string]string{}, kedav1alpha1.AuthPodIdentity{}, logger)
+	assert.Error(t, err)
+
+	_, err = NewAwsSnsHandler(context.TODO(), "test", "test", &eventingv1alpha1.AwsSnsSpec{TopicArn: "arn:aws:sns:eu-west-1:account_id:keda"}, map[string]string{}, kedav1alpha1.AuthPodIdentity{}, logger)
+	assert.Error(t, err)
+}
+
+func TestAwsSnsHandlerEmitEvent(t *testing.T) {
+	client := &fakeSnsPublishClient{}
+	h := &AwsSnsHandler{
+		Context:     context.TODO(),
+		TopicArn:    "arn:aws:sns:eu-west-1:account_id:keda",
+		ClusterName: "test",
+		Client:      client,
+		logger:      logger,
+	}
+
+	h.EmitEvent(testErrEventData, func(_ eventdata.EventData, err error) {
+		assert.Fail(t, "unexpected failure", err)
+	})
+	assert.Equal(t, h.TopicArn, aws.ToString(client.input.TopicArn))
+
+	event := map[string]interface{}{}
+	assert.NoError(t, json.Unmarshal([]byte(aws.ToString(client.input.Message)), &event))
+	assert.Equal(t, "ccc", event["type"])
+	assert.Equal(t, "/test/aaa//bbb", event["subject"])
+
+	failed := false
+	client.err = errors.New("unavailable")
+	h.EmitEvent(testErrEventData, func(_ eventdata.EventData, err error) {
+		failed = true
+		assert.Error(t, err)
+	})
+	assert.True(t, failed)
+}
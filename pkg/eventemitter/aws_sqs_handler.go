@@ -0,0 +1,105 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// ******************************* DESCRIPTION ****************************** \\
+// AwsSqsHandler focuses on emitting the CloudEventSource to an AWS SQS queue
+// ************************************************************************** \\
+
+package eventemitter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	eventingv1alpha1 "github.com/kedacore/keda/v2/apis/eventing/v1alpha1"
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+	"github.com/kedacore/keda/v2/pkg/eventemitter/eventdata"
+	awsutils "github.com/kedacore/keda/v2/pkg/scalers/aws"
+)
+
+// SqsSendClient is the part of the SQS client used to send the events
+type SqsSendClient interface {
+	SendMessage(ctx context.Context, params *sqs.SendMessageInput, optFns ...func(*sqs.Options)) (*sqs.SendMessageOutput, error)
+}
+
+type AwsSqsHandler struct {
+	Context          context.Context
+	QueueURL         string
+	ClusterName      string
+	Client           SqsSendClient
+	awsAuthorization awsutils.AuthorizationMetadata
+	logger           logr.Logger
+	activeStatus     metav1.ConditionStatus
+}
+
+func NewAwsSqsHandler(context context.Context, clusterName string, identifier string, spec *eventingv1alpha1.AwsSqsSpec, authParams map[string]string, podIdentity kedav1alpha1.AuthPodIdentity, logger logr.Logger) (*AwsSqsHandler, error) {
+	if spec.QueueURL == "" {
+		return nil, fmt.Errorf("no aws sqs queue url provided")
+	}
+	cfg, awsAuthorization, err := getAwsConfig(context, identifier, spec.AwsRegion, authParams, podIdentity)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Create new aws sqs handler with queue: " + spec.QueueURL)
+	return &AwsSqsHandler{
+		Context:          context,
+		QueueURL:         spec.QueueURL,
+		ClusterName:      clusterName,
+		Client:           sqs.NewFromConfig(*cfg),
+		awsAuthorization: awsAuthorization,
+		logger:           logger,
+		activeStatus:     metav1.ConditionTrue,
+	}, nil
+}
+
+func (a *AwsSqsHandler) CloseHandler() {
+	awsutils.ClearAwsConfig(a.awsAuthorization)
+}
+
+func (a *AwsSqsHandler) SetActiveStatus(status metav1.ConditionStatus) {
+	a.activeStatus = status
+}
+
+func (a *AwsSqsHandler) GetActiveStatus() metav1.ConditionStatus {
+	return a.activeStatus
+}
+
+func (a *AwsSqsHandler) EmitEvent(eventData eventdata.EventData, failureFunc func(eventData eventdata.EventData, err error)) {
+	message, err := generateCloudEventMessage(a.ClusterName, eventData)
+	if err != nil {
+		a.logger.Error(err, "Failed to generate CloudEvent for aws sqs")
+		failureFunc(eventData, err)
+		return
+	}
+
+	_, err = a.Client.SendMessage(a.Context, &sqs.SendMessageInput{
+		QueueUrl:    aws.String(a.QueueURL),
+		MessageBody: aws.String(string(message)),
+	})
+	if err != nil {
+		a.logger.Error(err, "Failed to send event to aws sqs")
+		failureFunc(eventData, err)
+		return
+	}
+
+	a.logger.V(1).Info("Successfully sent event to aws sqs")
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventemitter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kedacore/keda/v2/pkg/eventemitter/eventdata"
+)
+
+type fakeSqsSendClient struct {
+	input *sqs.SendMessageInput
+	err   error
+}
+
+func (f *fakeSqsSendClient) SendMessage(_ context.Context, params *sqs.SendMessageInput, _ ...func(*sqs.Options)) (*sqs.SendMessageOutput, error) {
+	f.input = params
+	return &sqs.SendMessageOutput{}, f.err
+}
+
+func TestAwsSqsHandlerEmitEvent(t *testing.T) {
+	client := &fakeSqsSendClient{}
+	h := &AwsSqsHandler{
+		Context:     context.TODO(),
+		QueueURL:    "https://sqs.eu-west-1.amazonaws.com/account_id/keda",
+		ClusterName: "test",
+		Client:      client,
+		logger:      logger,
+	}
+
+	h.EmitEvent(testErrEventData, func(_ eventdata.EventData, err error) {
+		assert.Fail(t, "unexpected failure", err)
+	})
+	assert.Equal(t, h.QueueURL, aws.ToString(client.input.QueueUrl))
+
+	event := map[string]interface{}{}
+	assert.NoError(t, json.Unmarshal([]byte(aws.ToString(client.input.MessageBody)), &event))
+	assert.Equal(t, "1.0", event["specversion"])
+	assert.Equal(t, "ccc", event["type"])
+	assert.Equal(t, map[string]interface{}{"reason": "ddd", "message": "eee"}, event["data"])
+
+	failed := false
+	client.err = errors.New("unavailable")
+	h.EmitEvent(testErrEventData, func(_ eventdata.EventData, err error) {
+		failed = true
+		assert.Error(t, err)
+	})
+	assert.True(t, failed)
+}
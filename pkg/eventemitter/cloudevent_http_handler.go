@@ -90,7 +90,7 @@ func (c *CloudEventHTTPHandler) EmitEvent(eventData eventdata.EventData, failure
 	event.SetSubject(subject)
 	event.SetType(string(eventData.CloudEventType))
 
-	if err := event.SetData(cloudevents.ApplicationJSON, EmitData{Reason: eventData.Reason, Message: eventData.Message}); err != nil {
+	if err := event.SetData(cloudevents.ApplicationJSON, EmitData{Reason: eventData.Reason, Message: eventData.Message, ScalingData: eventData.ScalingData}); err != nil {
 		c.logger.Error(err, "Failed to set data to CloudEvents receiver")
 		return
 	}
@@ -0,0 +1,289 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventemitter
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	eventingv1alpha1 "github.com/kedacore/keda/v2/apis/eventing/v1alpha1"
+	"github.com/kedacore/keda/v2/pkg/eventemitter/eventdata"
+	"github.com/kedacore/keda/v2/pkg/metricscollector"
+)
+
+const (
+	defaultMaxRetries      = 5
+	defaultBackoff         = time.Second
+	defaultMaxBackoff      = time.Minute
+	defaultMaxQueuedEvents = 1024
+)
+
+// deliveryOptions defines how the events are delivered to a handler, see eventingv1alpha1.Delivery
+type deliveryOptions struct {
+	maxRetries      int
+	backoff         time.Duration
+	maxBackoff      time.Duration
+	maxQueuedEvents int
+}
+
+func newDeliveryOptions(delivery *eventingv1alpha1.Delivery) deliveryOptions {
+	options := deliveryOptions{
+		maxRetries:      defaultMaxRetries,
+		backoff:         defaultBackoff,
+		maxBackoff:      defaultMaxBackoff,
+		maxQueuedEvents: defaultMaxQueuedEvents,
+	}
+	if delivery == nil {
+		return options
+	}
+	if delivery.MaxRetries != nil {
+		options.maxRetries = int(*delivery.MaxRetries)
+	}
+	if delivery.BackoffSeconds != nil {
+		options.backoff = time.Duration(*delivery.BackoffSeconds) * time.Second
+	}
+	if delivery.MaxBackoffSeconds != nil {
+		options.maxBackoff = time.Duration(*delivery.MaxBackoffSeconds) * time.Second
+	}
+	if delivery.MaxQueuedEvents != nil {
+		options.maxQueuedEvents = int(*delivery.MaxQueuedEvents)
+	}
+	return options
+}
+
+// getBackoff returns the delay before the retry following the given attempt, the delay is doubled
+// on each attempt up to maxBackoff, and half of it is randomized for the retries not to be synchronized
+func (o deliveryOptions) getBackoff(attempt int) time.Duration {
+	backoff := o.maxBackoff
+	if attempt < 32 && o.backoff<<attempt < o.maxBackoff {
+		backoff = o.backoff << attempt
+	}
+	if backoff <= 1 {
+		return backoff
+	}
+	return backoff/2 + rand.N(backoff/2)
+}
+
+// deliveryCounts are the numbers of events delivered, dead-lettered and dropped since they were last reported
+type deliveryCounts struct {
+	delivered    int64
+	deadLettered int64
+	dropped      int64
+}
+
+func (c *deliveryCounts) add(counts deliveryCounts) {
+	c.delivered += counts.delivered
+	c.deadLettered += counts.deadLettered
+	c.dropped += counts.dropped
+}
+
+// deliveryQueue delivers the events to a handler in order: the delivery of an event is retried until it succeeds
+// or the retries are exhausted, in which case the event is delivered to the dead-letter handler, if any, or dropped
+type deliveryQueue struct {
+	handlerKey string
+	handler    EventDataHandler
+	deadLetter EventDataHandler
+	options    deliveryOptions
+	events     []eventdata.EventData
+	counts     deliveryCounts
+	lock       *sync.Mutex
+	notify     chan struct{}
+	cancel     context.CancelFunc
+	logger     logr.Logger
+}
+
+// newDeliveryQueue returns a deliveryQueue starting with the given events, the events are delivered until the queue is stopped
+func newDeliveryQueue(handlerKey string, handler EventDataHandler, deadLetter EventDataHandler, options deliveryOptions, events []eventdata.EventData, logger logr.Logger) *deliveryQueue {
+	ctx, cancel := context.WithCancel(context.Background())
+	q := &deliveryQueue{
+		handlerKey: handlerKey,
+		handler:    handler,
+		deadLetter: deadLetter,
+		options:    options,
+		events:     events,
+		lock:       &sync.Mutex{},
+		notify:     make(chan struct{}, 1),
+		cancel:     cancel,
+		logger:     logger.WithValues("handler", handlerKey),
+	}
+	go q.run(ctx)
+	return q
+}
+
+// update replaces the handlers and the options of the queue, the events waiting to be delivered are kept
+func (q *deliveryQueue) update(handler EventDataHandler, deadLetter EventDataHandler, options deliveryOptions) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.handler = handler
+	q.deadLetter = deadLetter
+	q.options = options
+}
+
+// push adds the event to the end of the queue, the event is dropped if the queue is full
+func (q *deliveryQueue) push(eventData eventdata.EventData) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if len(q.events) >= q.options.maxQueuedEvents {
+		q.logger.Error(nil, "Too many events waiting to be delivered, dropping the event", "maxQueuedEvents", q.options.maxQueuedEvents)
+		q.counts.dropped++
+		return
+	}
+	q.events = append(q.events, eventData)
+
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+// snapshot returns the events waiting to be delivered, in order
+func (q *deliveryQueue) snapshot() []eventdata.EventData {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return append([]eventdata.EventData(nil), q.events...)
+}
+
+func (q *deliveryQueue) len() int {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return len(q.events)
+}
+
+// takeCounts returns the counts since the last call and resets them
+func (q *deliveryQueue) takeCounts() deliveryCounts {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	counts := q.counts
+	q.counts = deliveryCounts{}
+	return counts
+}
+
+func (q *deliveryQueue) stop() {
+	q.cancel()
+}
+
+func (q *deliveryQueue) run(ctx context.Context) {
+	for {
+		q.lock.Lock()
+		var eventData eventdata.EventData
+		found := len(q.events) > 0
+		if found {
+			eventData = q.events[0]
+		}
+		q.lock.Unlock()
+
+		if !found {
+			select {
+			case <-q.notify:
+				continue
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		// the event stays in the queue until it's delivered, for it to be persisted meanwhile
+		if !q.deliver(ctx, eventData) {
+			return
+		}
+		q.lock.Lock()
+		q.events = q.events[1:]
+		q.lock.Unlock()
+	}
+}
+
+// deliver delivers the event to the handler, retrying with a backoff, and returns false if the queue was stopped meanwhile
+func (q *deliveryQueue) deliver(ctx context.Context, eventData eventdata.EventData) bool {
+	for attempt := 0; ; attempt++ {
+		q.lock.Lock()
+		handler, options := q.handler, q.options
+		q.lock.Unlock()
+
+		eventData.HandlerKey = q.handlerKey
+		eventData.RetryTimes = attempt
+		err := q.emit(handler, eventData)
+		if err == nil {
+			if handler.GetActiveStatus() != metav1.ConditionTrue {
+				q.logger.Info("Event delivered, the handler is active again")
+				handler.SetActiveStatus(metav1.ConditionTrue)
+			}
+			q.addCounts(deliveryCounts{delivered: 1})
+			return true
+		}
+		eventData.Err = err
+
+		if attempt >= options.maxRetries {
+			q.logger.Error(err, "Failed to emit event multiple times, check if the event destination works well", "retries", attempt)
+			if handler.GetActiveStatus() != metav1.ConditionFalse {
+				handler.SetActiveStatus(metav1.ConditionFalse)
+			}
+			q.deadLetterEvent(eventData)
+			return true
+		}
+
+		backoff := options.getBackoff(attempt)
+		q.logger.V(1).Info("Failed to emit event, retrying", "retries", attempt, "backoff", backoff, "error", err)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
+// deadLetterEvent delivers the event to the dead-letter handler once, the event is dropped if there is none or it fails
+func (q *deliveryQueue) deadLetterEvent(eventData eventdata.EventData) {
+	q.lock.Lock()
+	deadLetter := q.deadLetter
+	q.lock.Unlock()
+
+	if deadLetter != nil {
+		err := q.emit(deadLetter, eventData)
+		if err == nil {
+			q.addCounts(deliveryCounts{deadLettered: 1})
+			return
+		}
+		q.logger.Error(err, "Failed to emit event to the dead-letter destination")
+	}
+	q.logger.Info("Dropping the event", "cloudEventType", eventData.CloudEventType, "object", eventData.ObjectName)
+	q.addCounts(deliveryCounts{dropped: 1})
+}
+
+// emit emits the event with the handler and returns the error of the handler, if any
+func (q *deliveryQueue) emit(handler EventDataHandler, eventData eventdata.EventData) error {
+	var emitErr error
+	handler.EmitEvent(eventData, func(_ eventdata.EventData, err error) {
+		emitErr = err
+	})
+
+	metricscollector.RecordCloudEventEmitted(eventData.Namespace, getSourceNameFromKey(q.handlerKey), getHandlerTypeFromKey(q.handlerKey))
+	if emitErr != nil {
+		metricscollector.RecordCloudEventEmittedError(eventData.Namespace, getSourceNameFromKey(q.handlerKey), getHandlerTypeFromKey(q.handlerKey))
+	}
+	return emitErr
+}
+
+// addCounts adds the counts to the ones not reported yet
+func (q *deliveryQueue) addCounts(counts deliveryCounts) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.counts.add(counts)
+}
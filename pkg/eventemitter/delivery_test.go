@@ -0,0 +1,165 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventemitter
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	eventingv1alpha1 "github.com/kedacore/keda/v2/apis/eventing/v1alpha1"
+	"github.com/kedacore/keda/v2/pkg/eventemitter/eventdata"
+)
+
+// fakeEventDataHandler fails the first failures emits and records the emitted events
+type fakeEventDataHandler struct {
+	failures     int
+	emitted      []string
+	activeStatus metav1.ConditionStatus
+	lock         sync.Mutex
+}
+
+func (f *fakeEventDataHandler) EmitEvent(eventData eventdata.EventData, failureFunc func(eventData eventdata.EventData, err error)) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.failures != 0 {
+		f.failures--
+		failureFunc(eventData, errors.New("unavailable"))
+		return
+	}
+	f.emitted = append(f.emitted, eventData.Message)
+}
+
+func (f *fakeEventDataHandler) SetActiveStatus(status metav1.ConditionStatus) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.activeStatus = status
+}
+
+func (f *fakeEventDataHandler) GetActiveStatus() metav1.ConditionStatus {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.activeStatus
+}
+
+func (f *fakeEventDataHandler) CloseHandler() {}
+
+func (f *fakeEventDataHandler) getEmitted() []string {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return append([]string(nil), f.emitted...)
+}
+
+func TestNewDeliveryOptions(t *testing.T) {
+	assert.Equal(t, deliveryOptions{maxRetries: 5, backoff: time.Second, maxBackoff: time.Minute, maxQueuedEvents: 1024}, newDeliveryOptions(nil))
+
+	maxRetries, backoffSeconds, maxBackoffSeconds, maxQueuedEvents := int32(0), int32(2), int32(30), int32(10)
+	options := newDeliveryOptions(&eventingv1alpha1.Delivery{MaxRetries: &maxRetries, BackoffSeconds: &backoffSeconds, MaxBackoffSeconds: &maxBackoffSeconds, MaxQueuedEvents: &maxQueuedEvents})
+	assert.Equal(t, deliveryOptions{maxRetries: 0, backoff: 2 * time.Second, maxBackoff: 30 * time.Second, maxQueuedEvents: 10}, options)
+}
+
+func TestDeliveryOptionsGetBackoff(t *testing.T) {
+	options := deliveryOptions{backoff: time.Second, maxBackoff: 10 * time.Second}
+	for attempt, expected := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second} {
+		backoff := options.getBackoff(attempt)
+		assert.GreaterOrEqual(t, backoff, expected/2)
+		assert.Less(t, backoff, expected)
+	}
+	assert.LessOrEqual(t, options.getBackoff(100), 10*time.Second)
+}
+
+func TestDeliveryQueueOrderedDelivery(t *testing.T) {
+	handler := &fakeEventDataHandler{failures: 2, activeStatus: metav1.ConditionTrue}
+	queue := newDeliveryQueue("CloudEventSource.ns.name.http", handler, nil, testDeliveryOptions, []eventdata.EventData{{Message: "spooled"}}, logger)
+	defer queue.stop()
+
+	for _, message := range []string{"first", "second", "third"} {
+		queue.push(eventdata.EventData{Message: message})
+	}
+
+	// the failed events are retried before delivering the next ones
+	assert.Eventually(t, func() bool { return len(handler.getEmitted()) == 4 }, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"spooled", "first", "second", "third"}, handler.getEmitted())
+	assert.Equal(t, deliveryCounts{delivered: 4}, queue.takeCounts())
+	assert.Equal(t, deliveryCounts{}, queue.takeCounts())
+	assert.Equal(t, 0, queue.len())
+}
+
+func TestDeliveryQueueDeadLetter(t *testing.T) {
+	options := testDeliveryOptions
+	options.maxRetries = 1
+	handler := &fakeEventDataHandler{failures: 4, activeStatus: metav1.ConditionTrue}
+	deadLetter := &fakeEventDataHandler{failures: 1, activeStatus: metav1.ConditionTrue}
+	queue := newDeliveryQueue("CloudEventSource.ns.name.http", handler, deadLetter, options, nil, logger)
+	defer queue.stop()
+
+	queue.push(eventdata.EventData{Message: "dropped"})
+	queue.push(eventdata.EventData{Message: "dead-lettered"})
+	queue.push(eventdata.EventData{Message: "delivered"})
+
+	assert.Eventually(t, func() bool { return len(handler.getEmitted()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"delivered"}, handler.getEmitted())
+	assert.Equal(t, []string{"dead-lettered"}, deadLetter.getEmitted())
+	assert.Equal(t, deliveryCounts{delivered: 1, deadLettered: 1, dropped: 1}, queue.takeCounts())
+
+	// the handler is active again once an event is delivered
+	assert.Equal(t, metav1.ConditionTrue, handler.GetActiveStatus())
+}
+
+func TestDeliveryQueueMaxQueuedEvents(t *testing.T) {
+	options := testDeliveryOptions
+	options.maxQueuedEvents = 2
+	handler := &fakeEventDataHandler{failures: -1, activeStatus: metav1.ConditionTrue}
+	queue := newDeliveryQueue("CloudEventSource.ns.name.http", handler, nil, options, nil, logger)
+	defer queue.stop()
+
+	for _, message := range []string{"first", "second", "third"} {
+		queue.push(eventdata.EventData{Message: message})
+	}
+
+	assert.Equal(t, 2, queue.len())
+	assert.Equal(t, "first", queue.snapshot()[0].Message)
+	assert.Equal(t, deliveryCounts{dropped: 1}, queue.takeCounts())
+}
+
+func TestDeliveryQueueDeadLettersUnmarshallableEvent(t *testing.T) {
+	options := testDeliveryOptions
+	options.maxRetries = 0
+	handler := &AwsSnsHandler{
+		Context:     context.TODO(),
+		TopicArn:    "arn:aws:sns:eu-west-1:account_id:keda",
+		ClusterName: "test",
+		Client:      &fakeSnsPublishClient{},
+		logger:      logger,
+	}
+	deadLetter := &fakeEventDataHandler{activeStatus: metav1.ConditionTrue}
+	queue := newDeliveryQueue("CloudEventSource.ns.name.awsSns", handler, deadLetter, options, nil, logger)
+	defer queue.stop()
+
+	// a NaN metric value can't be marshaled to JSON
+	queue.push(eventdata.EventData{Message: "unmarshallable", ScalingData: &eventdata.ScalingData{MetricValues: map[string]float64{"metric": math.NaN()}}})
+
+	assert.Eventually(t, func() bool { return queue.len() == 0 }, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"unmarshallable"}, deadLetter.getEmitted())
+	assert.Equal(t, deliveryCounts{deadLettered: 1}, queue.takeCounts())
+}
@@ -30,8 +30,18 @@ type EventData struct {
 	CloudEventType eventingv1alpha1.CloudEventType
 	Reason         string
 	Message        string
+	ScalingData    *ScalingData
 	Time           time.Time
 	HandlerKey     string
 	RetryTimes     int
 	Err            error
 }
+
+// ScalingData is the structured data of the scaling lifecycle events, it's added to the data of the CloudEvent
+type ScalingData struct {
+	FromReplicas   *int32             `json:"fromReplicas,omitempty"`
+	ToReplicas     *int32             `json:"toReplicas,omitempty"`
+	Jobs           *int64             `json:"jobs,omitempty"`
+	ActiveTriggers []string           `json:"activeTriggers,omitempty"`
+	MetricValues   map[string]float64 `json:"metricValues,omitempty"`
+}
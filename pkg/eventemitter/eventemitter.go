@@ -41,6 +41,7 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	eventingv1alpha1 "github.com/kedacore/keda/v2/apis/eventing/v1alpha1"
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
 	"github.com/kedacore/keda/v2/pkg/eventemitter/eventdata"
 	"github.com/kedacore/keda/v2/pkg/metricscollector"
 	"github.com/kedacore/keda/v2/pkg/scalers/authentication"
@@ -49,9 +50,9 @@ import (
 )
 
 const (
-	maxRetryTimes         = 5
-	maxChannelBuffer      = 1024
-	maxWaitingEnqueueTime = 10
+	maxChannelBuffer           = 1024
+	maxWaitingEnqueueTime      = 10
+	deliveryStatusSyncInterval = 10 * time.Second
 )
 
 // EventEmitter is the main struct for eventemitter package
@@ -61,10 +62,13 @@ type EventEmitter struct {
 	recorder                 record.EventRecorder
 	clusterName              string
 	eventHandlersCache       map[string]EventDataHandler
+	deadLetterHandlersCache  map[string]EventDataHandler
+	deliveryQueues           map[string]*deliveryQueue
 	eventFilterCache         map[string]*EventFilter
 	eventHandlersCacheLock   *sync.RWMutex
 	eventFilterCacheLock     *sync.RWMutex
 	eventLoopContexts        *sync.Map
+	spoolHashes              sync.Map
 	cloudEventProcessingChan chan eventdata.EventData
 	authClientSet            *authentication.AuthClientSet
 }
@@ -73,7 +77,7 @@ type EventEmitter struct {
 type EventHandler interface {
 	DeleteCloudEventSource(cloudEventSource eventingv1alpha1.CloudEventSourceInterface) error
 	HandleCloudEventSource(ctx context.Context, cloudEventSource eventingv1alpha1.CloudEventSourceInterface) error
-	Emit(object runtime.Object, namespace string, eventType string, cloudeventType eventingv1alpha1.CloudEventType, reason string, message string)
+	Emit(object runtime.Object, namespace string, eventType string, cloudeventType eventingv1alpha1.CloudEventType, reason string, message string, scalingData ...eventdata.ScalingData)
 }
 
 // EventDataHandler defines the behavior for different event handlers
@@ -88,11 +92,16 @@ type EventDataHandler interface {
 type EmitData struct {
 	Reason  string `json:"reason"`
 	Message string `json:"message"`
+	*eventdata.ScalingData
 }
 
 const (
 	cloudEventHandlerTypeHTTP                = "http"
 	cloudEventHandlerTypeAzureEventGridTopic = "azureEventGridTopic"
+	cloudEventHandlerTypeKafka               = "kafka"
+	cloudEventHandlerTypeNATS                = "nats"
+	cloudEventHandlerTypeAwsSns              = "awsSns"
+	cloudEventHandlerTypeAwsSqs              = "awsSqs"
 )
 
 // NewEventEmitter creates a new EventEmitter
@@ -103,6 +112,8 @@ func NewEventEmitter(client client.Client, recorder record.EventRecorder, cluste
 		recorder:                 recorder,
 		clusterName:              clusterName,
 		eventHandlersCache:       map[string]EventDataHandler{},
+		deadLetterHandlersCache:  map[string]EventDataHandler{},
+		deliveryQueues:           map[string]*deliveryQueue{},
 		eventFilterCache:         map[string]*EventFilter{},
 		eventHandlersCacheLock:   &sync.RWMutex{},
 		eventFilterCacheLock:     &sync.RWMutex{},
@@ -155,24 +166,28 @@ func (e *EventEmitter) HandleCloudEventSource(ctx context.Context, cloudEventSou
 // DeleteCloudEventSource will stop the event loop and clean event handlers in cache.
 func (e *EventEmitter) DeleteCloudEventSource(cloudEventSource eventingv1alpha1.CloudEventSourceInterface) error {
 	key := cloudEventSource.GenerateIdentifier()
-	result, ok := e.eventLoopContexts.Load(key)
+	result, ok := e.eventLoopContexts.LoadAndDelete(key)
 	e.log.V(1).Info("successfully DeleteCloudEventSourceDeleteCloudEventSourceDeleteCloudEventSource", "key", key)
 	if ok {
 		cancel, ok := result.(context.CancelFunc)
 		if ok {
 			cancel()
 		}
-		e.eventLoopContexts.Delete(key)
 		e.clearEventHandlersCache(cloudEventSource)
 	} else {
 		e.log.V(1).Info("successfully CloudEventSource was not found in controller cache", "key", key)
 	}
 
+	if cloudEventSource.GetSpec().IsSpoolEnabled() {
+		if err := e.deleteSpool(context.Background(), key); err != nil {
+			e.log.Error(err, "error deleting spooled events", "key", key)
+		}
+	}
 	return nil
 }
 
 // createEventHandlers will create different handler as defined in CloudEventSource, and store them in cache for repeated
-// use in the loop.
+// use in the loop. The events are delivered to each handler through its deliveryQueue, which is kept across updates.
 func (e *EventEmitter) createEventHandlers(ctx context.Context, cloudEventSourceI eventingv1alpha1.CloudEventSourceInterface) {
 	e.eventHandlersCacheLock.Lock()
 	e.eventFilterCacheLock.Lock()
@@ -197,38 +212,109 @@ func (e *EventEmitter) createEventHandlers(ctx context.Context, cloudEventSource
 	// Create EventFilter from CloudEventSource
 	e.eventFilterCache[key] = NewEventFilter(spec.EventSubscription.IncludedEventTypes, spec.EventSubscription.ExcludedEventTypes)
 
-	// Create different event destinations here
-	if spec.Destination.HTTP != nil {
-		eventHandler, err := NewCloudEventHTTPHandler(ctx, clusterName, spec.Destination.HTTP.URI, initializeLogger(cloudEventSourceI, "cloudevent_http"))
-		if err != nil {
-			e.log.Error(err, "create CloudEvent HTTP handler failed")
-			return
-		}
-
-		eventHandlerKey := newEventHandlerKey(key, cloudEventHandlerTypeHTTP)
-		if h, ok := e.eventHandlersCache[eventHandlerKey]; ok {
-			h.CloseHandler()
-		}
-		e.eventHandlersCache[eventHandlerKey] = eventHandler
+	eventHandler, handlerType, err := newEventDataHandler(ctx, cloudEventSourceI, clusterName, key, &spec.Destination, authParams, podIdentity, "")
+	if err != nil {
+		e.log.Error(err, "create event handler failed", "handlerType", handlerType)
+		return
+	}
+	if eventHandler == nil {
+		e.log.Info("No destionation is defined in CloudEventSource", "CloudEventSource", cloudEventSourceI.GetName())
 		return
 	}
 
-	if spec.Destination.AzureEventGridTopic != nil {
-		eventHandler, err := NewAzureEventGridTopicHandler(ctx, clusterName, spec.Destination.AzureEventGridTopic, authParams, podIdentity, initializeLogger(cloudEventSourceI, "azure_event_grid_topic"))
+	var deadLetterHandler EventDataHandler
+	if spec.Delivery != nil && spec.Delivery.DeadLetter != nil {
+		deadLetterHandler, handlerType, err = newEventDataHandler(ctx, cloudEventSourceI, clusterName, key+".deadLetter", spec.Delivery.DeadLetter, authParams, podIdentity, "_dead_letter")
 		if err != nil {
-			e.log.Error(err, "create Azure Event Grid handler failed")
+			e.log.Error(err, "create dead-letter event handler failed", "handlerType", handlerType)
+			eventHandler.CloseHandler()
 			return
 		}
+	}
+	if h, ok := e.deadLetterHandlersCache[key]; ok {
+		h.CloseHandler()
+		delete(e.deadLetterHandlersCache, key)
+	}
+	if deadLetterHandler != nil {
+		e.deadLetterHandlersCache[key] = deadLetterHandler
+	}
+
+	eventHandlerKey := newEventHandlerKey(key, getHandlerType(&spec.Destination))
+	if h, ok := e.eventHandlersCache[eventHandlerKey]; ok {
+		h.CloseHandler()
+	}
+	e.eventHandlersCache[eventHandlerKey] = eventHandler
 
-		eventHandlerKey := newEventHandlerKey(key, cloudEventHandlerTypeAzureEventGridTopic)
-		if h, ok := e.eventHandlersCache[eventHandlerKey]; ok {
+	// the destination may have changed, the handlers of the previous one are removed
+	for k, h := range e.eventHandlersCache {
+		if getPrefixIdentifierFromKey(k) == key && k != eventHandlerKey {
 			h.CloseHandler()
+			delete(e.eventHandlersCache, k)
+			if queue, ok := e.deliveryQueues[k]; ok {
+				queue.stop()
+				delete(e.deliveryQueues, k)
+			}
 		}
-		e.eventHandlersCache[eventHandlerKey] = eventHandler
+	}
+
+	options := newDeliveryOptions(spec.Delivery)
+	if queue, ok := e.deliveryQueues[eventHandlerKey]; ok {
+		queue.update(eventHandler, deadLetterHandler, options)
 		return
 	}
+	var events []eventdata.EventData
+	if spec.IsSpoolEnabled() {
+		events = e.restoreSpool(ctx, key, getHandlerTypeFromKey(eventHandlerKey))
+	}
+	e.deliveryQueues[eventHandlerKey] = newDeliveryQueue(eventHandlerKey, eventHandler, deadLetterHandler, options, events, e.log)
+}
 
-	e.log.Info("No destionation is defined in CloudEventSource", "CloudEventSource", cloudEventSourceI.GetName())
+// newEventDataHandler creates the handler of the destination and returns it along with its type, nil if no destination is defined
+func newEventDataHandler(ctx context.Context, cloudEventSourceI eventingv1alpha1.CloudEventSourceInterface, clusterName string, identifier string,
+	destination *eventingv1alpha1.Destination, authParams map[string]string, podIdentity kedav1alpha1.AuthPodIdentity, loggerSuffix string) (EventDataHandler, string, error) {
+	handlerType := getHandlerType(destination)
+	var eventHandler EventDataHandler
+	var err error
+	switch handlerType {
+	case cloudEventHandlerTypeHTTP:
+		eventHandler, err = NewCloudEventHTTPHandler(ctx, clusterName, destination.HTTP.URI, initializeLogger(cloudEventSourceI, "cloudevent_http"+loggerSuffix))
+	case cloudEventHandlerTypeAzureEventGridTopic:
+		eventHandler, err = NewAzureEventGridTopicHandler(ctx, clusterName, destination.AzureEventGridTopic, authParams, podIdentity, initializeLogger(cloudEventSourceI, "azure_event_grid_topic"+loggerSuffix))
+	case cloudEventHandlerTypeKafka:
+		eventHandler, err = NewKafkaHandler(ctx, clusterName, identifier, destination.Kafka, authParams, podIdentity, initializeLogger(cloudEventSourceI, "kafka"+loggerSuffix))
+	case cloudEventHandlerTypeNATS:
+		eventHandler, err = NewNATSHandler(clusterName, destination.NATS, authParams, initializeLogger(cloudEventSourceI, "nats"+loggerSuffix))
+	case cloudEventHandlerTypeAwsSns:
+		eventHandler, err = NewAwsSnsHandler(ctx, clusterName, identifier, destination.AwsSns, authParams, podIdentity, initializeLogger(cloudEventSourceI, "aws_sns"+loggerSuffix))
+	case cloudEventHandlerTypeAwsSqs:
+		eventHandler, err = NewAwsSqsHandler(ctx, clusterName, identifier, destination.AwsSqs, authParams, podIdentity, initializeLogger(cloudEventSourceI, "aws_sqs"+loggerSuffix))
+	default:
+		return nil, "", nil
+	}
+	if err != nil {
+		return nil, handlerType, err
+	}
+	return eventHandler, handlerType, nil
+}
+
+// getHandlerType returns the type of the handler of the destination, the first destination defined is used
+func getHandlerType(destination *eventingv1alpha1.Destination) string {
+	switch {
+	case destination.HTTP != nil:
+		return cloudEventHandlerTypeHTTP
+	case destination.AzureEventGridTopic != nil:
+		return cloudEventHandlerTypeAzureEventGridTopic
+	case destination.Kafka != nil:
+		return cloudEventHandlerTypeKafka
+	case destination.NATS != nil:
+		return cloudEventHandlerTypeNATS
+	case destination.AwsSns != nil:
+		return cloudEventHandlerTypeAwsSns
+	case destination.AwsSqs != nil:
+		return cloudEventHandlerTypeAwsSqs
+	default:
+		return ""
+	}
 }
 
 // clearEventHandlersCache will clear all event handlers that created by the passing CloudEventSource
@@ -238,27 +324,27 @@ func (e *EventEmitter) clearEventHandlersCache(cloudEventSource eventingv1alpha1
 	e.eventFilterCacheLock.Lock()
 	defer e.eventFilterCacheLock.Unlock()
 
-	spec := cloudEventSource.GetSpec()
 	key := cloudEventSource.GenerateIdentifier()
 
 	delete(e.eventFilterCache, key)
 
 	// Clear different event destination here.
-	if spec.Destination.HTTP != nil {
-		eventHandlerKey := newEventHandlerKey(key, cloudEventHandlerTypeHTTP)
-		if eventHandler, found := e.eventHandlersCache[eventHandlerKey]; found {
+	for eventHandlerKey, eventHandler := range e.eventHandlersCache {
+		if getPrefixIdentifierFromKey(eventHandlerKey) == key {
 			eventHandler.CloseHandler()
 			delete(e.eventHandlersCache, eventHandlerKey)
 		}
 	}
-
-	if spec.Destination.AzureEventGridTopic != nil {
-		eventHandlerKey := newEventHandlerKey(key, cloudEventHandlerTypeAzureEventGridTopic)
-		if eventHandler, found := e.eventHandlersCache[eventHandlerKey]; found {
-			eventHandler.CloseHandler()
-			delete(e.eventHandlersCache, eventHandlerKey)
+	for eventHandlerKey, queue := range e.deliveryQueues {
+		if getPrefixIdentifierFromKey(eventHandlerKey) == key {
+			queue.stop()
+			delete(e.deliveryQueues, eventHandlerKey)
 		}
 	}
+	if deadLetterHandler, found := e.deadLetterHandlersCache[key]; found {
+		deadLetterHandler.CloseHandler()
+		delete(e.deadLetterHandlersCache, key)
+	}
 }
 
 // checkIfEventHandlersExist will check if the event handlers that were created by passing CloudEventSource exist
@@ -278,6 +364,8 @@ func (e *EventEmitter) checkIfEventHandlersExist(cloudEventSource eventingv1alph
 
 func (e *EventEmitter) startEventLoop(ctx context.Context, cloudEventSourceI eventingv1alpha1.CloudEventSourceInterface, cloudEventSourceMutex sync.Locker) {
 	e.log.V(1).Info("Start CloudEventSource loop.", "name", cloudEventSourceI.GetName())
+	ticker := time.NewTicker(deliveryStatusSyncInterval)
+	defer ticker.Stop()
 	for {
 		select {
 		case eventData := <-e.cloudEventProcessingChan:
@@ -285,15 +373,26 @@ func (e *EventEmitter) startEventLoop(ctx context.Context, cloudEventSourceI eve
 			e.emitEventByHandler(eventData)
 			e.checkEventHandlers(ctx, cloudEventSourceI, cloudEventSourceMutex)
 			metricscollector.RecordCloudEventQueueStatus(cloudEventSourceI.GetNamespace(), len(e.cloudEventProcessingChan))
+		case <-ticker.C:
+			e.syncDeliveryStatus(ctx, cloudEventSourceI, cloudEventSourceMutex)
 		case <-ctx.Done():
 			e.log.V(1).Info("CloudEventSource loop has stopped.")
 			metricscollector.RecordCloudEventQueueStatus(cloudEventSourceI.GetNamespace(), len(e.cloudEventProcessingChan))
+			// persist the events waiting to be delivered unless the CloudEventSource was deleted, the context is already canceled
+			key := cloudEventSourceI.GenerateIdentifier()
+			if _, found := e.eventLoopContexts.Load(key); found && cloudEventSourceI.GetSpec().IsSpoolEnabled() {
+				shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				if err := e.persistSpool(shutdownCtx, key); err != nil {
+					e.log.Error(err, "error spooling events", "cloudEventSource", key)
+				}
+				cancel()
+			}
 			return
 		}
 	}
 }
 
-// checkEventHandlers will check each eventhandler active status
+// checkEventHandlers will check each eventhandler active status, the CloudEventSource is active if all of them are
 func (e *EventEmitter) checkEventHandlers(ctx context.Context, cloudEventSourceI eventingv1alpha1.CloudEventSourceInterface, cloudEventSourceMutex sync.Locker) {
 	e.log.V(1).Info("Checking event handlers status.")
 	cloudEventSourceMutex.Lock()
@@ -305,30 +404,92 @@ func (e *EventEmitter) checkEventHandlers(ctx context.Context, cloudEventSourceI
 		return
 	}
 	keyPrefix := cloudEventSourceI.GenerateIdentifier()
-	needUpdate := false
-	cloudEventSourceStatus := cloudEventSourceI.GetStatus().DeepCopy()
+	activeStatus := metav1.ConditionTrue
+	e.eventHandlersCacheLock.RLock()
 	for k, v := range e.eventHandlersCache {
 		e.log.V(1).Info("Checking event handler status.", "handler", k, "status", cloudEventSourceI.GetStatus().Conditions.GetActiveCondition().Status)
-		if strings.Contains(k, keyPrefix) {
-			if v.GetActiveStatus() != cloudEventSourceI.GetStatus().Conditions.GetActiveCondition().Status {
-				needUpdate = true
-				cloudEventSourceStatus.Conditions.SetActiveCondition(
-					metav1.ConditionFalse,
-					eventingv1alpha1.CloudEventSourceConditionFailedReason,
-					eventingv1alpha1.CloudEventSourceConditionFailedMessage,
-				)
-			}
+		if strings.Contains(k, keyPrefix) && v.GetActiveStatus() != metav1.ConditionTrue {
+			activeStatus = metav1.ConditionFalse
 		}
 	}
-	if needUpdate {
-		if updateErr := e.updateCloudEventSourceStatus(ctx, cloudEventSourceI, cloudEventSourceStatus); updateErr != nil {
-			e.log.Error(updateErr, "Failed to update CloudEventSource status")
+	e.eventHandlersCacheLock.RUnlock()
+
+	if activeStatus == cloudEventSourceI.GetStatus().Conditions.GetActiveCondition().Status {
+		return
+	}
+	cloudEventSourceStatus := cloudEventSourceI.GetStatus().DeepCopy()
+	if activeStatus == metav1.ConditionTrue {
+		cloudEventSourceStatus.Conditions.SetActiveCondition(
+			metav1.ConditionTrue,
+			eventingv1alpha1.CloudEventSourceConditionActiveReason,
+			eventingv1alpha1.CloudEventSourceConditionActiveMessage,
+		)
+	} else {
+		cloudEventSourceStatus.Conditions.SetActiveCondition(
+			metav1.ConditionFalse,
+			eventingv1alpha1.CloudEventSourceConditionFailedReason,
+			eventingv1alpha1.CloudEventSourceConditionFailedMessage,
+		)
+	}
+	if updateErr := e.updateCloudEventSourceStatus(ctx, cloudEventSourceI, cloudEventSourceStatus); updateErr != nil {
+		e.log.Error(updateErr, "Failed to update CloudEventSource status")
+	}
+}
+
+// syncDeliveryStatus adds the events delivered, dead-lettered and dropped since the last sync to the status of
+// the CloudEventSource along with the number of queued events, and spools the queued events if enabled
+func (e *EventEmitter) syncDeliveryStatus(ctx context.Context, cloudEventSourceI eventingv1alpha1.CloudEventSourceInterface, cloudEventSourceMutex sync.Locker) {
+	key := cloudEventSourceI.GenerateIdentifier()
+	if cloudEventSourceI.GetSpec().IsSpoolEnabled() {
+		if err := e.persistSpool(ctx, key); err != nil {
+			e.log.Error(err, "error spooling events", "cloudEventSource", key)
+		}
+	}
+
+	var queues []*deliveryQueue
+	e.eventHandlersCacheLock.RLock()
+	for k, queue := range e.deliveryQueues {
+		if getPrefixIdentifierFromKey(k) == key {
+			queues = append(queues, queue)
+		}
+	}
+	e.eventHandlersCacheLock.RUnlock()
+	if len(queues) == 0 {
+		return
+	}
+
+	var queued int64
+	counts := deliveryCounts{}
+	for _, queue := range queues {
+		queued += int64(queue.len())
+		counts.add(queue.takeCounts())
+	}
+
+	cloudEventSourceMutex.Lock()
+	defer cloudEventSourceMutex.Unlock()
+	err := e.client.Get(ctx, types.NamespacedName{Name: cloudEventSourceI.GetName(), Namespace: cloudEventSourceI.GetNamespace()}, cloudEventSourceI)
+	if err == nil {
+		status := cloudEventSourceI.GetStatus()
+		if counts == (deliveryCounts{}) && status.QueuedEvents == queued {
+			return
 		}
+		status = status.DeepCopy()
+		status.QueuedEvents = queued
+		status.DeliveredEvents += counts.delivered
+		status.DeadLetteredEvents += counts.deadLettered
+		status.DroppedEvents += counts.dropped
+		err = e.updateCloudEventSourceStatus(ctx, cloudEventSourceI, status)
+	}
+	if err != nil {
+		e.log.Error(err, "Failed to update CloudEventSource delivery status")
+		// report them on the next sync
+		queues[0].addCounts(counts)
 	}
 }
 
 // Emit is emitting event to both local kubernetes and custom CloudEventSource handler. After emit event to local kubernetes, event will inqueue and waitng for handler's consuming.
-func (e *EventEmitter) Emit(object runtime.Object, namespace string, eventType string, cloudeventType eventingv1alpha1.CloudEventType, reason, message string) {
+// The scaling data, if any, is added to the data of the CloudEvent.
+func (e *EventEmitter) Emit(object runtime.Object, namespace string, eventType string, cloudeventType eventingv1alpha1.CloudEventType, reason, message string, scalingData ...eventdata.ScalingData) {
 	e.recorder.Event(object, eventType, reason, message)
 
 	e.eventHandlersCacheLock.RLock()
@@ -348,6 +509,9 @@ func (e *EventEmitter) Emit(object runtime.Object, namespace string, eventType s
 		Message:        message,
 		Time:           time.Now().UTC(),
 	}
+	if len(scalingData) > 0 {
+		eventData.ScalingData = &scalingData[0]
+	}
 	go e.enqueueEventData(eventData)
 }
 
@@ -361,70 +525,34 @@ func (e *EventEmitter) enqueueEventData(eventData eventdata.EventData) {
 	}
 }
 
-// emitEventByHandler handles event emitting. It will follow these logic:
-// 1. If there is a new EventData, call all handlers for emitting.
-// 2. Once there is an error when emitting event, record the handler's key and reqeueu this EventData.
-// 3. If the maximum number of retries has been exceeded, discard this event.
+// emitEventByHandler adds the event to the deliveryQueue of each handler whose filter doesn't exclude it,
+// each queue then delivers its events in order, see deliveryQueue.
 func (e *EventEmitter) emitEventByHandler(eventData eventdata.EventData) {
-	if eventData.RetryTimes >= maxRetryTimes {
-		e.log.Error(eventData.Err, "Failed to emit Event multiple times. Will drop this event and need to check if event endpoint works well", "CloudEventSource", eventData.ObjectName)
-		handler, found := e.eventHandlersCache[eventData.HandlerKey]
-		if found {
-			e.log.V(1).Info("Set handler failure status. 1", "handler", eventData.HandlerKey)
-			handler.SetActiveStatus(metav1.ConditionFalse)
-		}
-		return
-	}
-
-	if eventData.HandlerKey == "" {
-		for key, handler := range e.eventHandlersCache {
-			e.eventFilterCacheLock.RLock()
-			defer e.eventFilterCacheLock.RUnlock()
-			// Filter Event
-			identifierKey := getPrefixIdentifierFromKey(key)
-
-			if e.eventFilterCache[identifierKey] != nil {
-				isFiltered := e.eventFilterCache[identifierKey].FilterEvent(eventData.CloudEventType)
-				if isFiltered {
-					e.log.V(1).Info("Event is filtered", "cloudeventType", eventData.CloudEventType, "event identifier", identifierKey)
-					return
-				}
-			}
-			eventData.HandlerKey = key
-			if handler.GetActiveStatus() == metav1.ConditionTrue {
-				go handler.EmitEvent(eventData, e.emitErrorHandle)
-
-				metricscollector.RecordCloudEventEmitted(eventData.Namespace, getSourceNameFromKey(eventData.HandlerKey), getHandlerTypeFromKey(key))
-			} else {
-				e.log.V(1).Info("EventHandler's status is not active. Please check if event endpoint works well", "CloudEventSource", eventData.ObjectName)
+	e.eventHandlersCacheLock.RLock()
+	defer e.eventHandlersCacheLock.RUnlock()
+	e.eventFilterCacheLock.RLock()
+	defer e.eventFilterCacheLock.RUnlock()
+
+	for key := range e.eventHandlersCache {
+		// Filter Event
+		identifierKey := getPrefixIdentifierFromKey(key)
+
+		if e.eventFilterCache[identifierKey] != nil {
+			isFiltered := e.eventFilterCache[identifierKey].FilterEvent(eventData.CloudEventType)
+			if isFiltered {
+				e.log.V(1).Info("Event is filtered", "cloudeventType", eventData.CloudEventType, "event identifier", identifierKey)
+				continue
 			}
 		}
-	} else {
-		e.log.Info("Failed to emit event", "handler", eventData.HandlerKey, "retry times", fmt.Sprintf("%d/%d", eventData.RetryTimes, maxRetryTimes), "error", eventData.Err)
-		handler, found := e.eventHandlersCache[eventData.HandlerKey]
-		if found && handler.GetActiveStatus() == metav1.ConditionTrue {
-			go handler.EmitEvent(eventData, e.emitErrorHandle)
-		}
-	}
-}
-
-func (e *EventEmitter) emitErrorHandle(eventData eventdata.EventData, err error) {
-	metricscollector.RecordCloudEventEmittedError(eventData.Namespace, getSourceNameFromKey(eventData.HandlerKey), getHandlerTypeFromKey(eventData.HandlerKey))
 
-	if eventData.RetryTimes >= maxRetryTimes {
-		e.log.V(1).Info("Failed to emit Event multiple times. Will set handler failure status.", "handler", eventData.HandlerKey, "retry times", eventData.RetryTimes)
-		handler, found := e.eventHandlersCache[eventData.HandlerKey]
-		if found {
-			handler.SetActiveStatus(metav1.ConditionFalse)
+		queue, found := e.deliveryQueues[key]
+		if !found {
+			e.log.V(1).Info("EventHandler has no delivery queue", "handler", key)
+			continue
 		}
-		return
+		eventData.HandlerKey = key
+		queue.push(eventData)
 	}
-
-	requeueData := eventData
-	requeueData.HandlerKey = eventData.HandlerKey
-	requeueData.RetryTimes++
-	requeueData.Err = err
-	e.enqueueEventData(requeueData)
 }
 
 func (e *EventEmitter) setCloudEventSourceStatusActive(ctx context.Context, cloudEventSourceI eventingv1alpha1.CloudEventSourceInterface) error {
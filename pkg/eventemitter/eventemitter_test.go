@@ -18,11 +18,13 @@ package eventemitter
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
@@ -37,6 +39,13 @@ import (
 const testNamespaceGlobal = "testNamespace"
 const testNameGlobal = "testName"
 
+var testDeliveryOptions = deliveryOptions{
+	maxRetries:      defaultMaxRetries,
+	backoff:         10 * time.Millisecond,
+	maxBackoff:      100 * time.Millisecond,
+	maxQueuedEvents: defaultMaxQueuedEvents,
+}
+
 func TestEventHandler_FailedEmitEvent(t *testing.T) {
 	cloudEventSourceName := testNameGlobal
 	cloudEventSourceNamespace := testNamespaceGlobal
@@ -78,6 +87,9 @@ func TestEventHandler_FailedEmitEvent(t *testing.T) {
 		eventFilterCacheLock:     &sync.RWMutex{},
 		eventLoopContexts:        &sync.Map{},
 		cloudEventProcessingChan: make(chan eventdata.EventData, 1),
+		deliveryQueues: map[string]*deliveryQueue{
+			key: newDeliveryQueue(key, eventHandler, nil, testDeliveryOptions, nil, logger),
+		},
 	}
 
 	eventData := eventdata.EventData{
@@ -144,6 +156,9 @@ func TestEventHandler_DirectCall(t *testing.T) {
 		eventFilterCacheLock:     &sync.RWMutex{},
 		eventLoopContexts:        &sync.Map{},
 		cloudEventProcessingChan: make(chan eventdata.EventData, 1),
+		deliveryQueues: map[string]*deliveryQueue{
+			key: newDeliveryQueue(key, eventHandler, nil, newDeliveryOptions(nil), nil, logger),
+		},
 	}
 
 	eventData := eventdata.EventData{
@@ -169,3 +184,23 @@ func TestEventHandler_DirectCall(t *testing.T) {
 	eventEmitter.enqueueEventData(eventData)
 	wg.Wait()
 }
+
+func TestEmitData_ScalingData(t *testing.T) {
+	data, err := json.Marshal(EmitData{Reason: "KEDAScaleTargetActivated", Message: "msg"})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"reason":"KEDAScaleTargetActivated","message":"msg"}`, string(data))
+
+	from, to := int32(0), int32(2)
+	data, err = json.Marshal(EmitData{
+		Reason:  "KEDAScaleTargetActivated",
+		Message: "msg",
+		ScalingData: &eventdata.ScalingData{
+			FromReplicas:   &from,
+			ToReplicas:     &to,
+			ActiveTriggers: []string{"s0-queue"},
+			MetricValues:   map[string]float64{"s0-queue": 12},
+		},
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"reason":"KEDAScaleTargetActivated","message":"msg","fromReplicas":0,"toReplicas":2,"activeTriggers":["s0-queue"],"metricValues":{"s0-queue":12}}`, string(data))
+}
@@ -17,8 +17,12 @@ limitations under the License.
 package eventemitter
 
 import (
+	"encoding/json"
 	"fmt"
 
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+
 	"github.com/kedacore/keda/v2/pkg/eventemitter/eventdata"
 	"github.com/kedacore/keda/v2/pkg/util"
 )
@@ -41,3 +45,19 @@ func generateCloudEventSubject(clusterName string, objectNamespace string, objec
 func generateCloudEventSubjectFromEventData(clusterName string, eventData eventdata.EventData) string {
 	return generateCloudEventSubject(clusterName, eventData.Namespace, eventData.ObjectType, eventData.ObjectName)
 }
+
+// generateCloudEventMessage generates the CloudEvent for eventData in the structured JSON format,
+// for the destinations which publish it as the body of a message
+func generateCloudEventMessage(clusterName string, eventData eventdata.EventData) ([]byte, error) {
+	event := cloudevents.NewEvent()
+	event.SetID(uuid.NewString())
+	event.SetSource(generateCloudEventSource(clusterName))
+	event.SetSubject(generateCloudEventSubjectFromEventData(clusterName, eventData))
+	event.SetType(string(eventData.CloudEventType))
+	event.SetTime(eventData.Time)
+
+	if err := event.SetData(cloudevents.ApplicationJSON, EmitData{Reason: eventData.Reason, Message: eventData.Message, ScalingData: eventData.ScalingData}); err != nil {
+		return nil, err
+	}
+	return json.Marshal(event)
+}
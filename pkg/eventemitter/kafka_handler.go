@@ -0,0 +1,161 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// ******************************* DESCRIPTION ****************************** \\
+// KafkaHandler focuses on emitting the CloudEventSource to a Kafka topic
+// ************************************************************************** \\
+
+package eventemitter
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/IBM/sarama"
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	eventingv1alpha1 "github.com/kedacore/keda/v2/apis/eventing/v1alpha1"
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+	"github.com/kedacore/keda/v2/pkg/eventemitter/eventdata"
+	"github.com/kedacore/keda/v2/pkg/scalers"
+	"github.com/kedacore/keda/v2/pkg/scalers/scalersconfig"
+)
+
+type KafkaHandler struct {
+	Topic         string
+	ClusterName   string
+	Producer      sarama.SyncProducer
+	kerberosFiles []string
+	logger        logr.Logger
+	activeStatus  metav1.ConditionStatus
+}
+
+func NewKafkaHandler(ctx context.Context, clusterName string, identifier string, spec *eventingv1alpha1.KafkaSpec, authParams map[string]string, podIdentity kedav1alpha1.AuthPodIdentity, logger logr.Logger) (*KafkaHandler, error) {
+	if spec.BootstrapServers == "" {
+		return nil, fmt.Errorf("no kafka bootstrap servers provided")
+	}
+	if spec.Topic == "" {
+		return nil, fmt.Errorf("no kafka topic provided")
+	}
+
+	config, err := getKafkaProducerConfig(ctx, identifier, spec, authParams, podIdentity)
+	if err != nil {
+		return nil, err
+	}
+	kerberosFiles := getKerberosFiles(config)
+
+	producer, err := sarama.NewSyncProducer(strings.Split(spec.BootstrapServers, ","), config)
+	if err != nil {
+		removeKerberosFiles(kerberosFiles, logger)
+		return nil, fmt.Errorf("error creating kafka producer: %w", err)
+	}
+
+	logger.Info("Create new kafka handler with topic: " + spec.Topic)
+	return &KafkaHandler{
+		Topic:         spec.Topic,
+		ClusterName:   clusterName,
+		Producer:      producer,
+		kerberosFiles: kerberosFiles,
+		logger:        logger,
+		activeStatus:  metav1.ConditionTrue,
+	}, nil
+}
+
+// getKafkaProducerConfig returns the producer config, the authentication is parsed by the kafka scaler
+func getKafkaProducerConfig(ctx context.Context, identifier string, spec *eventingv1alpha1.KafkaSpec, authParams map[string]string, podIdentity kedav1alpha1.AuthPodIdentity) (*sarama.Config, error) {
+	triggerMetadata := map[string]string{}
+	if spec.AwsRegion != "" {
+		triggerMetadata["awsRegion"] = spec.AwsRegion
+	}
+	config, err := scalers.NewKafkaAuthConfig(ctx, &scalersconfig.ScalerConfig{
+		TriggerUniqueKey: identifier,
+		TriggerMetadata:  triggerMetadata,
+		AuthParams:       authParams,
+		PodIdentity:      podIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+	config.Producer.Return.Successes = true
+
+	if spec.Version != "" {
+		version, err := sarama.ParseKafkaVersion(spec.Version)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing kafka version: %w", err)
+		}
+		config.Version = version
+	}
+	return config, nil
+}
+
+// getKerberosFiles returns the temporary files the kerberos configuration and keytab are written to
+func getKerberosFiles(config *sarama.Config) []string {
+	var files []string
+	for _, file := range []string{config.Net.SASL.GSSAPI.KerberosConfigPath, config.Net.SASL.GSSAPI.KeyTabPath} {
+		if file != "" {
+			files = append(files, file)
+		}
+	}
+	return files
+}
+
+func removeKerberosFiles(files []string, logger logr.Logger) {
+	for _, file := range files {
+		if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+			logger.Error(err, "Failed to remove kerberos file", "file", file)
+		}
+	}
+}
+
+func (k *KafkaHandler) CloseHandler() {
+	if err := k.Producer.Close(); err != nil {
+		k.logger.Error(err, "Failed to close kafka producer")
+	}
+	removeKerberosFiles(k.kerberosFiles, k.logger)
+}
+
+func (k *KafkaHandler) SetActiveStatus(status metav1.ConditionStatus) {
+	k.activeStatus = status
+}
+
+func (k *KafkaHandler) GetActiveStatus() metav1.ConditionStatus {
+	return k.activeStatus
+}
+
+func (k *KafkaHandler) EmitEvent(eventData eventdata.EventData, failureFunc func(eventData eventdata.EventData, err error)) {
+	message, err := generateCloudEventMessage(k.ClusterName, eventData)
+	if err != nil {
+		k.logger.Error(err, "Failed to generate CloudEvent for kafka")
+		failureFunc(eventData, err)
+		return
+	}
+
+	_, _, err = k.Producer.SendMessage(&sarama.ProducerMessage{
+		Topic: k.Topic,
+		Key:   sarama.StringEncoder(generateCloudEventSubjectFromEventData(k.ClusterName, eventData)),
+		Value: sarama.ByteEncoder(message),
+	})
+	if err != nil {
+		k.logger.Error(err, "Failed to publish event to kafka")
+		failureFunc(eventData, err)
+		return
+	}
+
+	k.logger.V(1).Info("Successfully published event to kafka")
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventemitter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+
+	eventingv1alpha1 "github.com/kedacore/keda/v2/apis/eventing/v1alpha1"
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+)
+
+type parseKafkaProducerConfigTestData struct {
+	name       string
+	spec       eventingv1alpha1.KafkaSpec
+	authParams map[string]string
+	isError    bool
+	mechanism  sarama.SASLMechanism
+}
+
+var parseKafkaProducerConfigTestDataset = []parseKafkaProducerConfigTestData{
+	{name: "no auth", spec: eventingv1alpha1.KafkaSpec{}, authParams: map[string]string{}},
+	{name: "version", spec: eventingv1alpha1.KafkaSpec{Version: "3.6.0"}, authParams: map[string]string{}},
+	{name: "invalid version", spec: eventingv1alpha1.KafkaSpec{Version: "foo"}, authParams: map[string]string{}, isError: true},
+	{name: "sasl plaintext", authParams: map[string]string{"sasl": "plaintext", "username": "admin", "password": "admin"}, mechanism: sarama.SASLTypePlaintext},
+	{name: "sasl scram_sha512", authParams: map[string]string{"sasl": "scram_sha512", "username": "admin", "password": "admin"}, mechanism: sarama.SASLTypeSCRAMSHA512},
+	{name: "sasl without password", authParams: map[string]string{"sasl": "scram_sha256", "username": "admin"}, isError: true},
+	{name: "invalid sasl", authParams: map[string]string{"sasl": "foo", "username": "admin", "password": "admin"}, isError: true},
+	{name: "tls", authParams: map[string]string{"tls": "enable"}},
+	{name: "tls cert without key", authParams: map[string]string{"tls": "enable", "cert": "foo"}, isError: true},
+	{name: "invalid tls", authParams: map[string]string{"tls": "foo"}, isError: true},
+	{name: "sasl oauthbearer", authParams: map[string]string{"sasl": "oauthbearer", "username": "admin", "password": "admin", "oauthTokenEndpointUri": "https://website.com"}, mechanism: sarama.SASLTypeOAuth},
+	{name: "sasl oauthbearer without token endpoint", authParams: map[string]string{"sasl": "oauthbearer", "username": "admin", "password": "admin"}, isError: true},
+	{name: "sasl gssapi", authParams: map[string]string{"sasl": "gssapi", "username": "admin", "password": "admin", "kerberosConfig": "<config>", "realm": "tst.com"}, mechanism: sarama.SASLTypeGSSAPI},
+	{name: "sasl aws msk iam", spec: eventingv1alpha1.KafkaSpec{AwsRegion: "eu-west-1"}, authParams: map[string]string{"sasl": "oauthbearer", "saslTokenProvider": "aws_msk_iam", "tls": "enable", "awsAccessKeyID": "none", "awsSecretAccessKey": "none"}, mechanism: sarama.SASLTypeOAuth},
+	{name: "sasl aws msk iam without region", authParams: map[string]string{"sasl": "oauthbearer", "saslTokenProvider": "aws_msk_iam", "tls": "enable", "awsAccessKeyID": "none", "awsSecretAccessKey": "none"}, isError: true},
+}
+
+func TestGetKafkaProducerConfig(t *testing.T) {
+	for _, testData := range parseKafkaProducerConfigTestDataset {
+		t.Run(testData.name, func(t *testing.T) {
+			config, err := getKafkaProducerConfig(context.Background(), "test", &testData.spec, testData.authParams, kedav1alpha1.AuthPodIdentity{})
+			if testData.isError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			removeKerberosFiles(getKerberosFiles(config), logger)
+			assert.True(t, config.Producer.Return.Successes)
+			assert.Equal(t, testData.mechanism != "", config.Net.SASL.Enable)
+			if testData.mechanism != "" {
+				assert.Equal(t, testData.mechanism, config.Net.SASL.Mechanism)
+			}
+			assert.Equal(t, testData.authParams["tls"] == "enable", config.Net.TLS.Enable)
+		})
+	}
+}
+
+func TestNewKafkaHandlerMissingSpec(t *testing.T) {
+	_, err := NewKafkaHandler(context.Background(), "test", "test", &eventingv1alpha1.KafkaSpec{Topic: "keda"}, map[string]string{}, kedav1alpha1.AuthPodIdentity{}, logger)
+	assert.Error(t, err)
+
+	_, err = NewKafkaHandler(context.Background(), "test", "test", &eventingv1alpha1.KafkaSpec{BootstrapServers: "localhost:9092"}, map[string]string{}, kedav1alpha1.AuthPodIdentity{}, logger)
+	assert.Error(t, err)
+}
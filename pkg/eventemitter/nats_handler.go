@@ -0,0 +1,139 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// ******************************* DESCRIPTION ****************************** \\
+// NATSHandler focuses on emitting the CloudEventSource to a NATS subject
+// ************************************************************************** \\
+
+package eventemitter
+
+import (
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/nats-io/nats.go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	eventingv1alpha1 "github.com/kedacore/keda/v2/apis/eventing/v1alpha1"
+	"github.com/kedacore/keda/v2/pkg/eventemitter/eventdata"
+	kedautil "github.com/kedacore/keda/v2/pkg/util"
+)
+
+type NATSHandler struct {
+	Subject      string
+	ClusterName  string
+	Conn         *nats.Conn
+	logger       logr.Logger
+	activeStatus metav1.ConditionStatus
+}
+
+func NewNATSHandler(clusterName string, spec *eventingv1alpha1.NATSSpec, authParams map[string]string, logger logr.Logger) (*NATSHandler, error) {
+	if spec.ServerURL == "" {
+		return nil, fmt.Errorf("no nats server url provided")
+	}
+	if spec.Subject == "" {
+		return nil, fmt.Errorf("no nats subject provided")
+	}
+
+	options, err := getNATSOptions(authParams)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := nats.Connect(spec.ServerURL, options...)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to nats: %w", err)
+	}
+
+	logger.Info("Create new nats handler with subject: " + spec.Subject)
+	return &NATSHandler{
+		Subject:      spec.Subject,
+		ClusterName:  clusterName,
+		Conn:         conn,
+		logger:       logger,
+		activeStatus: metav1.ConditionTrue,
+	}, nil
+}
+
+// getNATSOptions returns the connection options given by the authentication parameters,
+// either a token or a username and a password, and the TLS configuration
+func getNATSOptions(authParams map[string]string) ([]nats.Option, error) {
+	var options []nats.Option
+
+	switch {
+	case authParams["token"] != "" && authParams["username"] != "":
+		return nil, fmt.Errorf("unable to set both token and username for nats")
+	case authParams["token"] != "":
+		options = append(options, nats.Token(authParams["token"]))
+	case authParams["username"] != "":
+		if authParams["password"] == "" {
+			return nil, fmt.Errorf("no password given")
+		}
+		options = append(options, nats.UserInfo(authParams["username"], authParams["password"]))
+	}
+
+	switch authParams["tls"] {
+	case "", "disable":
+	case "enable":
+		certGiven := authParams["cert"] != ""
+		keyGiven := authParams["key"] != ""
+		if certGiven != keyGiven {
+			return nil, fmt.Errorf("cert and key must be provided together")
+		}
+		tlsConfig, err := kedautil.NewTLSConfig(authParams["cert"], authParams["key"], authParams["ca"], false)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, nats.Secure(tlsConfig))
+	default:
+		return nil, fmt.Errorf("error incorrect TLS value given, got %s", authParams["tls"])
+	}
+	return options, nil
+}
+
+func (n *NATSHandler) CloseHandler() {
+	n.Conn.Close()
+}
+
+func (n *NATSHandler) SetActiveStatus(status metav1.ConditionStatus) {
+	n.activeStatus = status
+}
+
+func (n *NATSHandler) GetActiveStatus() metav1.ConditionStatus {
+	return n.activeStatus
+}
+
+func (n *NATSHandler) EmitEvent(eventData eventdata.EventData, failureFunc func(eventData eventdata.EventData, err error)) {
+	message, err := generateCloudEventMessage(n.ClusterName, eventData)
+	if err != nil {
+		n.logger.Error(err, "Failed to generate CloudEvent for nats")
+		failureFunc(eventData, err)
+		return
+	}
+
+	if err := n.Conn.Publish(n.Subject, message); err != nil {
+		n.logger.Error(err, "Failed to publish event to nats")
+		failureFunc(eventData, err)
+		return
+	}
+	if err := n.Conn.Flush(); err != nil {
+		n.logger.Error(err, "Failed to flush event to nats")
+		failureFunc(eventData, err)
+		return
+	}
+
+	n.logger.V(1).Info("Successfully published event to nats")
+}
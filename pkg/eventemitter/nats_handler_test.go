@@ -0,0 +1,65 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventemitter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	eventingv1alpha1 "github.com/kedacore/keda/v2/apis/eventing/v1alpha1"
+)
+
+type parseNATSOptionsTestData struct {
+	name       string
+	authParams map[string]string
+	isError    bool
+	options    int
+}
+
+var parseNATSOptionsTestDataset = []parseNATSOptionsTestData{
+	{name: "no auth", authParams: map[string]string{}},
+	{name: "token", authParams: map[string]string{"token": "foo"}, options: 1},
+	{name: "username and password", authParams: map[string]string{"username": "admin", "password": "admin"}, options: 1},
+	{name: "username without password", authParams: map[string]string{"username": "admin"}, isError: true},
+	{name: "token and username", authParams: map[string]string{"token": "foo", "username": "admin", "password": "admin"}, isError: true},
+	{name: "token and tls", authParams: map[string]string{"token": "foo", "tls": "enable"}, options: 2},
+	{name: "tls cert without key", authParams: map[string]string{"tls": "enable", "cert": "foo"}, isError: true},
+	{name: "invalid tls", authParams: map[string]string{"tls": "foo"}, isError: true},
+}
+
+func TestGetNATSOptions(t *testing.T) {
+	for _, testData := range parseNATSOptionsTestDataset {
+		t.Run(testData.name, func(t *testing.T) {
+			options, err := getNATSOptions(testData.authParams)
+			if testData.isError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, options, testData.options)
+		})
+	}
+}
+
+func TestNewNATSHandlerMissingSpec(t *testing.T) {
+	_, err := NewNATSHandler("test", &eventingv1alpha1.NATSSpec{Subject: "keda"}, map[string]string{}, logger)
+	assert.Error(t, err)
+
+	_, err = NewNATSHandler("test", &eventingv1alpha1.NATSSpec{ServerURL: "nats://localhost:4222"}, map[string]string{}, logger)
+	assert.Error(t, err)
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventemitter
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	eventingv1alpha1 "github.com/kedacore/keda/v2/apis/eventing/v1alpha1"
+	"github.com/kedacore/keda/v2/pkg/eventemitter/eventdata"
+	kedautil "github.com/kedacore/keda/v2/pkg/util"
+)
+
+// +kubebuilder:rbac:groups="",namespace=keda,resources=configmaps,verbs=get;create;update;delete
+
+// maxSpoolSize bounds the size of the spooled events, as the whole ConfigMap is limited to 1MiB
+const maxSpoolSize = 900 * 1024
+
+// spooledEvent is the serialized form of the EventData waiting to be delivered
+type spooledEvent struct {
+	Namespace      string                          `json:"namespace"`
+	ObjectName     string                          `json:"objectName"`
+	ObjectType     string                          `json:"objectType"`
+	CloudEventType eventingv1alpha1.CloudEventType `json:"cloudEventType"`
+	Reason         string                          `json:"reason"`
+	Message        string                          `json:"message"`
+	ScalingData    *eventdata.ScalingData          `json:"scalingData,omitempty"`
+	Time           time.Time                       `json:"time"`
+}
+
+// getSpoolConfigMapName returns the name of the ConfigMap the events of the CloudEventSource are spooled to
+func getSpoolConfigMapName(cloudEventSourceIdentifier string) string {
+	return "keda-cloudevents-spool-" + strings.ToLower(cloudEventSourceIdentifier)
+}
+
+// persistSpool writes the events waiting to be delivered by the handlers of the CloudEventSource
+// to its ConfigMap, the newest events are dropped if they don't fit in it. The ConfigMap isn't written
+// if the events haven't changed since the last time they were persisted
+func (e *EventEmitter) persistSpool(ctx context.Context, cloudEventSourceIdentifier string) error {
+	e.eventHandlersCacheLock.RLock()
+	events := map[string][]eventdata.EventData{}
+	for key, queue := range e.deliveryQueues {
+		if getPrefixIdentifierFromKey(key) == cloudEventSourceIdentifier {
+			events[getHandlerTypeFromKey(key)] = queue.snapshot()
+		}
+	}
+	e.eventHandlersCacheLock.RUnlock()
+
+	size := 0
+	data := map[string]string{}
+	for handlerType, handlerEvents := range events {
+		spooledEvents := make([]spooledEvent, 0, len(handlerEvents))
+		for _, eventData := range handlerEvents {
+			spooledEvents = append(spooledEvents, spooledEvent{
+				Namespace:      eventData.Namespace,
+				ObjectName:     eventData.ObjectName,
+				ObjectType:     eventData.ObjectType,
+				CloudEventType: eventData.CloudEventType,
+				Reason:         eventData.Reason,
+				Message:        eventData.Message,
+				ScalingData:    eventData.ScalingData,
+				Time:           eventData.Time,
+			})
+		}
+		encoded, err := json.Marshal(spooledEvents)
+		if err != nil {
+			return err
+		}
+		for len(encoded)+size > maxSpoolSize && len(spooledEvents) > 0 {
+			spooledEvents = spooledEvents[:len(spooledEvents)/2]
+			if encoded, err = json.Marshal(spooledEvents); err != nil {
+				return err
+			}
+		}
+		if len(spooledEvents) < len(handlerEvents) {
+			e.log.Error(nil, "Too many events waiting to be delivered, not all of them are spooled", "handler", handlerType, "spooled", len(spooledEvents), "queued", len(handlerEvents))
+		}
+		size += len(encoded)
+		data[handlerType] = string(encoded)
+	}
+
+	hash := hashSpoolData(data)
+	if persisted, found := e.spoolHashes.Load(cloudEventSourceIdentifier); found && persisted == hash {
+		return nil
+	}
+	if err := e.writeSpool(ctx, cloudEventSourceIdentifier, data); err != nil {
+		return err
+	}
+	e.spoolHashes.Store(cloudEventSourceIdentifier, hash)
+	return nil
+}
+
+// hashSpoolData returns a hash of the spooled events of every handler
+func hashSpoolData(data map[string]string) string {
+	// the keys of a map are sorted by json.Marshal, the hash doesn't depend on the iteration order
+	encoded, _ := json.Marshal(data)
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:])
+}
+
+func (e *EventEmitter) writeSpool(ctx context.Context, cloudEventSourceIdentifier string, data map[string]string) error {
+	namespace := kedautil.GetPodNamespace()
+	name := getSpoolConfigMapName(cloudEventSourceIdentifier)
+	configMap := &corev1.ConfigMap{}
+	err := e.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, configMap)
+	if apierrors.IsNotFound(err) {
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+				Labels: map[string]string{
+					"app.kubernetes.io/managed-by": "keda-operator",
+				},
+			},
+			Data: data,
+		}
+		return e.client.Create(ctx, configMap)
+	}
+	if err != nil {
+		return err
+	}
+	configMap.Data = data
+	return e.client.Update(ctx, configMap)
+}
+
+// restoreSpool returns the events spooled for the handler of the CloudEventSource
+func (e *EventEmitter) restoreSpool(ctx context.Context, cloudEventSourceIdentifier, handlerType string) []eventdata.EventData {
+	configMap := &corev1.ConfigMap{}
+	err := e.client.Get(ctx, types.NamespacedName{Namespace: kedautil.GetPodNamespace(), Name: getSpoolConfigMapName(cloudEventSourceIdentifier)}, configMap)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			e.log.Error(err, "error restoring spooled events", "cloudEventSource", cloudEventSourceIdentifier)
+		}
+		return nil
+	}
+
+	data, found := configMap.Data[handlerType]
+	if !found {
+		return nil
+	}
+	var spooledEvents []spooledEvent
+	if err := json.Unmarshal([]byte(data), &spooledEvents); err != nil {
+		e.log.Error(err, "error decoding spooled events, skipping", "cloudEventSource", cloudEventSourceIdentifier, "handler", handlerType)
+		return nil
+	}
+
+	events := make([]eventdata.EventData, 0, len(spooledEvents))
+	for _, spooled := range spooledEvents {
+		events = append(events, eventdata.EventData{
+			Namespace:      spooled.Namespace,
+			ObjectName:     spooled.ObjectName,
+			ObjectType:     spooled.ObjectType,
+			CloudEventType: spooled.CloudEventType,
+			Reason:         spooled.Reason,
+			Message:        spooled.Message,
+			ScalingData:    spooled.ScalingData,
+			Time:           spooled.Time,
+		})
+	}
+	e.log.V(1).Info("Restored spooled events", "cloudEventSource", cloudEventSourceIdentifier, "handler", handlerType, "count", len(events))
+	return events
+}
+
+// deleteSpool deletes the ConfigMap the events of the CloudEventSource are spooled to
+func (e *EventEmitter) deleteSpool(ctx context.Context, cloudEventSourceIdentifier string) error {
+	e.spoolHashes.Delete(cloudEventSourceIdentifier)
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: kedautil.GetPodNamespace(),
+			Name:      getSpoolConfigMapName(cloudEventSourceIdentifier),
+		},
+	}
+	if err := e.client.Delete(ctx, configMap); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
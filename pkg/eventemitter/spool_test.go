@@ -0,0 +1,85 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventemitter
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	eventingv1alpha1 "github.com/kedacore/keda/v2/apis/eventing/v1alpha1"
+	"github.com/kedacore/keda/v2/pkg/eventemitter/eventdata"
+)
+
+func TestSpoolRoundtrip(t *testing.T) {
+	ctx := context.Background()
+	identifier := "CloudEventSource.aaa.bbb"
+	handler := &fakeEventDataHandler{failures: -1}
+	queue := newDeliveryQueue(newEventHandlerKey(identifier, cloudEventHandlerTypeHTTP), handler, nil, testDeliveryOptions, nil, logger)
+	defer queue.stop()
+
+	// the events are kept in the queue as the handler always fails
+	events := []eventdata.EventData{
+		{Namespace: "aaa", ObjectName: "bbb", CloudEventType: eventingv1alpha1.ScaledObjectReadyType, Reason: "reason", Message: "first", Time: time.Now().UTC().Truncate(time.Second)},
+		{Namespace: "aaa", ObjectName: "bbb", CloudEventType: eventingv1alpha1.ScaledObjectFailedType, Reason: "reason", Message: "second", Time: time.Now().UTC().Truncate(time.Second)},
+	}
+	for _, eventData := range events {
+		queue.push(eventData)
+	}
+
+	e := EventEmitter{
+		log:                    logger,
+		client:                 fake.NewClientBuilder().Build(),
+		deliveryQueues:         map[string]*deliveryQueue{newEventHandlerKey(identifier, cloudEventHandlerTypeHTTP): queue},
+		eventHandlersCacheLock: &sync.RWMutex{},
+	}
+
+	assert.NoError(t, e.persistSpool(ctx, identifier))
+	configMap := &corev1.ConfigMap{}
+	assert.NoError(t, e.client.Get(ctx, types.NamespacedName{Namespace: "keda", Name: getSpoolConfigMapName(identifier)}, configMap))
+
+	// persisting unchanged events doesn't write the ConfigMap
+	assert.NoError(t, e.persistSpool(ctx, identifier))
+	unchanged := &corev1.ConfigMap{}
+	assert.NoError(t, e.client.Get(ctx, types.NamespacedName{Namespace: "keda", Name: getSpoolConfigMapName(identifier)}, unchanged))
+	assert.Equal(t, configMap.ResourceVersion, unchanged.ResourceVersion)
+
+	// persisting new events updates the existing ConfigMap
+	events = append(events, eventdata.EventData{Namespace: "aaa", ObjectName: "bbb", CloudEventType: eventingv1alpha1.ScaledObjectReadyType, Reason: "reason", Message: "third", Time: time.Now().UTC().Truncate(time.Second)})
+	queue.push(events[2])
+	assert.NoError(t, e.persistSpool(ctx, identifier))
+	updated := &corev1.ConfigMap{}
+	assert.NoError(t, e.client.Get(ctx, types.NamespacedName{Namespace: "keda", Name: getSpoolConfigMapName(identifier)}, updated))
+	assert.NotEqual(t, configMap.ResourceVersion, updated.ResourceVersion)
+
+	assert.Equal(t, events, e.restoreSpool(ctx, identifier, cloudEventHandlerTypeHTTP))
+	assert.Empty(t, e.restoreSpool(ctx, identifier, cloudEventHandlerTypeKafka))
+	assert.Empty(t, e.restoreSpool(ctx, "CloudEventSource.aaa.ccc", cloudEventHandlerTypeHTTP))
+
+	assert.NoError(t, e.deleteSpool(ctx, identifier))
+	err := e.client.Get(ctx, types.NamespacedName{Namespace: "keda", Name: getSpoolConfigMapName(identifier)}, &corev1.ConfigMap{})
+	assert.True(t, apierrors.IsNotFound(err))
+	// deleting a missing spool is not an error
+	assert.NoError(t, e.deleteSpool(ctx, identifier))
+}
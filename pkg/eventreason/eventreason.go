@@ -35,6 +35,12 @@ const (
 	// ScaledJobUpdateFailed is for event when ScaledJob update status fails
 	ScaledJobUpdateFailed = "ScaledJobUpdateFailed"
 
+	// ScaledObjectPaused is for event when ScaledObject is paused
+	ScaledObjectPaused = "ScaledObjectPaused"
+
+	// ScaledObjectUnpaused is for event when ScaledObject is unpaused
+	ScaledObjectUnpaused = "ScaledObjectUnpaused"
+
 	// ScaledObjectDeleted is for event when ScaledObject is deleted
 	ScaledObjectDeleted = "ScaledObjectDeleted"
 
@@ -62,6 +68,21 @@ const (
 	// KEDAScaleTargetDeactivated is for event when the scale target for ScaledObject was deactivated
 	KEDAScaleTargetDeactivated = "KEDAScaleTargetDeactivated"
 
+	// KEDAScaleTargetScaledOut is for event when the replicas of the scale target of ScaledObject were increased
+	KEDAScaleTargetScaledOut = "KEDAScaleTargetScaledOut"
+
+	// KEDAScaleTargetScaledIn is for event when the replicas of the scale target of ScaledObject were decreased
+	KEDAScaleTargetScaledIn = "KEDAScaleTargetScaledIn"
+
+	// KEDAFallbackEntered is for event when at least one trigger of ScaledObject started falling back
+	KEDAFallbackEntered = "KEDAFallbackEntered"
+
+	// KEDAFallbackExited is for event when no trigger of ScaledObject is falling back anymore
+	KEDAFallbackExited = "KEDAFallbackExited"
+
+	// KEDAScaleTargetDryRunScaled is for event when a ScaledObject in dry-run mode would have scaled its scale target
+	KEDAScaleTargetDryRunScaled = "KEDAScaleTargetDryRunScaled"
+
 	// KEDAScaleTargetActivationFailed is for event when the activation the scale target for ScaledObject fails
 	KEDAScaleTargetActivationFailed = "KEDAScaleTargetActivationFailed"
 
@@ -71,6 +92,18 @@ const (
 	// KEDAJobsCreated is for event when jobs for ScaledJob are created
 	KEDAJobsCreated = "KEDAJobsCreated"
 
+	// KEDAJobsFailureBudgetExhausted is for event when the creation of jobs for ScaledJob is paused because too many jobs failed
+	KEDAJobsFailureBudgetExhausted = "KEDAJobsFailureBudgetExhausted"
+
+	// KEDAJobsFailureBudgetRecovered is for event when the creation of jobs for ScaledJob resumes after the failure budget backoff
+	KEDAJobsFailureBudgetRecovered = "KEDAJobsFailureBudgetRecovered"
+
+	// KEDAJobsRolloutPromoted is for event when the canary generation of ScaledJob is promoted
+	KEDAJobsRolloutPromoted = "KEDAJobsRolloutPromoted"
+
+	// KEDAJobsRolloutRolledBack is for event when the canary generation of ScaledJob is rolled back
+	KEDAJobsRolloutRolledBack = "KEDAJobsRolloutRolledBack"
+
 	// TriggerAuthenticationDeleted is for event when a TriggerAuthentication is deleted
 	TriggerAuthenticationDeleted = "TriggerAuthenticationDeleted"
 
@@ -19,8 +19,10 @@ package fallback
 import (
 	"context"
 	"fmt"
+	"math"
 	"reflect"
 	"strconv"
+	"time"
 
 	v2 "k8s.io/api/autoscaling/v2"
 	v1 "k8s.io/api/core/v1"
@@ -29,6 +31,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/scale"
 	"k8s.io/metrics/pkg/apis/external_metrics"
+	"k8s.io/utils/ptr"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -46,12 +49,13 @@ func GetMetricsWithFallback(ctx context.Context, client runtimeclient.Client, sc
 	status := scaledObject.Status.DeepCopy()
 
 	initHealthStatus(status)
-	healthStatus := getHealthStatus(status, metricName)
+	healthStatus := getHealthStatus(status.Health, metricName)
 
 	if suppressedError == nil {
 		zero := int32(0)
 		healthStatus.NumberOfFailures = &zero
 		healthStatus.Status = kedav1alpha1.HealthStatusHappy
+		trackLastKnownValue(scaledObject, healthStatus, metrics, metricName)
 		status.Health[metricName] = *healthStatus
 
 		updateStatus(ctx, client, scaledObject, status)
@@ -75,14 +79,14 @@ func GetMetricsWithFallback(ctx context.Context, client runtimeclient.Client, sc
 		var currentReplicas int32
 		var err error
 
-		if scaledObject.Spec.Fallback.Behavior != kedav1alpha1.FallbackBehaviorStatic {
+		if scaledObject.Spec.Fallback.Behavior != kedav1alpha1.FallbackBehaviorStatic && !scaledObject.Spec.Fallback.UsesLastKnownValue() {
 			currentReplicas, err = resolver.GetCurrentReplicas(ctx, client, scaleClient, scaledObject)
 			if err != nil {
 				return nil, false, suppressedError
 			}
 		}
 
-		l := doFallback(ctx, client, scaleClient, scaledObject, metricSpec, metricName, currentReplicas, suppressedError)
+		l := doFallback(ctx, client, scaleClient, scaledObject, metricSpec, metricName, currentReplicas, healthStatus, suppressedError)
 		if l == nil {
 			return l, false, fmt.Errorf("error performing fallback")
 		}
@@ -158,11 +162,73 @@ func getReadyReplicasCount(ctx context.Context, client runtimeclient.Client, sca
 	return readyPodCount, nil
 }
 
-func doFallback(ctx context.Context, client runtimeclient.Client, scaleClient scale.ScalesGetter, scaledObject *kedav1alpha1.ScaledObject, metricSpec v2.MetricSpec, metricName string, currentReplicas int32, suppressedError error) []external_metrics.ExternalMetricValue {
+// lastKnownValueRefreshInterval is how often the time of an unchanged last known value is refreshed,
+// so the status isn't patched on every metrics request
+const lastKnownValueRefreshInterval = 30 * time.Second
+
+// trackLastKnownValue records the last successfully observed value of the metric in its health status,
+// so it can be used by the lastKnownValue and decay behaviors when the metric starts failing
+func trackLastKnownValue(scaledObject *kedav1alpha1.ScaledObject, healthStatus *kedav1alpha1.HealthStatus, metrics []external_metrics.ExternalMetricValue, metricName string) {
+	if !isFallbackEnabled(scaledObject) || !scaledObject.Spec.Fallback.UsesLastKnownValue() {
+		return
+	}
+	for _, metric := range metrics {
+		if metric.MetricName == metricName {
+			if healthStatus.LastKnownValue != nil && healthStatus.LastKnownValue.Cmp(metric.Value) == 0 &&
+				healthStatus.LastKnownValueTime != nil && time.Since(healthStatus.LastKnownValueTime.Time) < lastKnownValueRefreshInterval {
+				return
+			}
+			value := metric.Value.DeepCopy()
+			healthStatus.LastKnownValue = &value
+			healthStatus.LastKnownValueTime = ptr.To(metav1.Now())
+			return
+		}
+	}
+}
+
+// getDecayProgress returns how far the fallback has moved from the last known value toward the fallback replicas:
+// 0 during the hold period, growing linearly to 1 during the decay period of the decay behavior, 1 afterwards
+func getDecayProgress(fallback *kedav1alpha1.Fallback, healthStatus *kedav1alpha1.HealthStatus, now time.Time) float64 {
+	if healthStatus.LastKnownValue == nil || healthStatus.LastKnownValueTime == nil {
+		return 1
+	}
+	elapsed := now.Sub(healthStatus.LastKnownValueTime.Time) - fallback.GetHoldPeriod()
+	switch {
+	case elapsed <= 0:
+		return 0
+	case fallback.Behavior != kedav1alpha1.FallbackBehaviorDecay:
+		return 1
+	default:
+		return math.Min(float64(elapsed)/float64(fallback.GetDecayPeriod()), 1)
+	}
+}
+
+func doFallback(ctx context.Context, client runtimeclient.Client, scaleClient scale.ScalesGetter, scaledObject *kedav1alpha1.ScaledObject, metricSpec v2.MetricSpec, metricName string, currentReplicas int32, healthStatus *kedav1alpha1.HealthStatus, suppressedError error) []external_metrics.ExternalMetricValue {
 	fallbackBehavior := scaledObject.Spec.Fallback.Behavior
 	fallbackReplicas := int64(scaledObject.Spec.Fallback.Replicas)
 	var replicas float64
 
+	decayProgress := float64(1)
+	if scaledObject.Spec.Fallback.UsesLastKnownValue() {
+		decayProgress = getDecayProgress(scaledObject.Spec.Fallback, healthStatus, time.Now())
+	}
+	if decayProgress == 0 {
+		log.Info("Suppressing error, using last known metric value",
+			"scaledObject.Namespace", scaledObject.Namespace,
+			"scaledObject.Name", scaledObject.Name,
+			"suppressedError", suppressedError,
+			"fallback.behavior", fallbackBehavior,
+			"lastKnownValue", healthStatus.LastKnownValue,
+			"lastKnownValueTime", healthStatus.LastKnownValueTime)
+		return []external_metrics.ExternalMetricValue{
+			{
+				MetricName: metricName,
+				Value:      healthStatus.LastKnownValue.DeepCopy(),
+				Timestamp:  metav1.Now(),
+			},
+		}
+	}
+
 	switch fallbackBehavior {
 	case kedav1alpha1.FallbackBehaviorStatic:
 		replicas = float64(fallbackReplicas)
@@ -215,9 +281,16 @@ func doFallback(ctx context.Context, client runtimeclient.Client, scaleClient sc
 		metricName = kedav1alpha1.CompositeMetricName
 	}
 
+	value := normalisationValue * replicas
+	// the decay behavior linearly moves from the last known value to the fallback replicas
+	if decayProgress < 1 {
+		lastKnownValue := healthStatus.LastKnownValue.AsApproximateFloat64()
+		value = lastKnownValue + (value-lastKnownValue)*decayProgress
+	}
+
 	metric := external_metrics.ExternalMetricValue{
 		MetricName: metricName,
-		Value:      *resource.NewMilliQuantity(int64(normalisationValue*1000*replicas), resource.DecimalSI),
+		Value:      *resource.NewMilliQuantity(int64(value*1000), resource.DecimalSI),
 		Timestamp:  metav1.Now(),
 	}
 	fallbackMetrics := []external_metrics.ExternalMetricValue{metric}
@@ -228,6 +301,7 @@ func doFallback(ctx context.Context, client runtimeclient.Client, scaleClient sc
 		"suppressedError", suppressedError,
 		"fallback.behavior", fallbackBehavior,
 		"fallback.replicas", fallbackReplicas,
+		"fallback.decayProgress", decayProgress,
 		"workload.currentReplicas", currentReplicas)
 	return fallbackMetrics
 }
@@ -256,18 +330,18 @@ func updateStatus(ctx context.Context, client runtimeclient.Client, scaledObject
 	}
 }
 
-func getHealthStatus(status *kedav1alpha1.ScaledObjectStatus, metricName string) *kedav1alpha1.HealthStatus {
+func getHealthStatus(health map[string]kedav1alpha1.HealthStatus, metricName string) *kedav1alpha1.HealthStatus {
 	// Get health status for a specific metric
-	_, healthStatusExists := status.Health[metricName]
+	_, healthStatusExists := health[metricName]
 	if !healthStatusExists {
 		zero := int32(0)
 		healthStatus := kedav1alpha1.HealthStatus{
 			NumberOfFailures: &zero,
 			Status:           kedav1alpha1.HealthStatusHappy,
 		}
-		status.Health[metricName] = healthStatus
+		health[metricName] = healthStatus
 	}
-	healthStatus := status.Health[metricName]
+	healthStatus := health[metricName]
 	return &healthStatus
 }
 
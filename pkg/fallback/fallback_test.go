@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -452,6 +453,155 @@ var _ = Describe("fallback", func() {
 		expectedValue := float64(100) // 10 replicas * 10 target value, ignoring current 15
 		Expect(value).Should(Equal(expectedValue))
 	})
+
+	It("should track the last known value when behavior is 'lastKnownValue'", func() {
+		primeGetMetrics(scaler, float64(7))
+
+		so := buildScaledObject(
+			&kedav1alpha1.Fallback{
+				FailureThreshold: int32(3),
+				Replicas:         int32(10),
+				Behavior:         kedav1alpha1.FallbackBehaviorLastKnownValue,
+			},
+			nil,
+		)
+		metricSpec := createMetricSpec(10)
+		expectStatusPatch(ctrl, client)
+
+		metrics, _, err := scaler.GetMetricsAndActivity(context.Background(), metricName)
+		_, _, err = GetMetricsWithFallback(context.Background(), client, scaleClient, metrics, err, metricName, so, metricSpec)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(so.Status.Health[metricName].LastKnownValue.AsApproximateFloat64()).Should(Equal(float64(7)))
+		Expect(so.Status.Health[metricName].LastKnownValueTime).ToNot(BeNil())
+	})
+
+	It("should not patch the status when the last known value is unchanged", func() {
+		primeGetMetrics(scaler, float64(7))
+		primeGetMetrics(scaler, float64(7))
+
+		so := buildScaledObject(
+			&kedav1alpha1.Fallback{
+				FailureThreshold: int32(3),
+				Replicas:         int32(10),
+				Behavior:         kedav1alpha1.FallbackBehaviorLastKnownValue,
+			},
+			nil,
+		)
+		metricSpec := createMetricSpec(10)
+		// only the first request patches the status
+		expectStatusPatch(ctrl, client)
+
+		for i := 0; i < 2; i++ {
+			metrics, _, err := scaler.GetMetricsAndActivity(context.Background(), metricName)
+			_, _, err = GetMetricsWithFallback(context.Background(), client, scaleClient, metrics, err, metricName, so, metricSpec)
+			Expect(err).ToNot(HaveOccurred())
+		}
+		Expect(so.Status.Health[metricName].LastKnownValue.AsApproximateFloat64()).Should(Equal(float64(7)))
+	})
+
+	It("should use the last known value during the hold period when behavior is 'lastKnownValue'", func() {
+		scaler.EXPECT().GetMetricsAndActivity(gomock.Any(), gomock.Eq(metricName)).Return(nil, false, errors.New("some error"))
+		startingNumberOfFailures := int32(3)
+
+		so := buildScaledObject(
+			&kedav1alpha1.Fallback{
+				FailureThreshold: int32(3),
+				Replicas:         int32(10),
+				Behavior:         kedav1alpha1.FallbackBehaviorLastKnownValue,
+				HoldPeriod:       ptr.To[int32](600),
+			},
+			&kedav1alpha1.ScaledObjectStatus{
+				Health: map[string]kedav1alpha1.HealthStatus{
+					metricName: {
+						NumberOfFailures:   &startingNumberOfFailures,
+						Status:             kedav1alpha1.HealthStatusFailing,
+						LastKnownValue:     resource.NewQuantity(42, resource.DecimalSI),
+						LastKnownValueTime: ptr.To(metav1.NewTime(time.Now().Add(-5 * time.Minute))),
+					},
+				},
+			},
+		)
+		metricSpec := createMetricSpec(10)
+		expectStatusPatch(ctrl, client)
+
+		metrics, _, err := scaler.GetMetricsAndActivity(context.Background(), metricName)
+		metrics, fallbackActive, err := GetMetricsWithFallback(context.Background(), client, scaleClient, metrics, err, metricName, so, metricSpec)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fallbackActive).To(BeTrue())
+		value := metrics[0].Value.AsApproximateFloat64()
+		Expect(value).Should(Equal(float64(42)))
+	})
+
+	It("should use fallback replicas after the hold period when behavior is 'lastKnownValue'", func() {
+		scaler.EXPECT().GetMetricsAndActivity(gomock.Any(), gomock.Eq(metricName)).Return(nil, false, errors.New("some error"))
+		startingNumberOfFailures := int32(3)
+
+		so := buildScaledObject(
+			&kedav1alpha1.Fallback{
+				FailureThreshold: int32(3),
+				Replicas:         int32(10),
+				Behavior:         kedav1alpha1.FallbackBehaviorLastKnownValue,
+				HoldPeriod:       ptr.To[int32](60),
+			},
+			&kedav1alpha1.ScaledObjectStatus{
+				Health: map[string]kedav1alpha1.HealthStatus{
+					metricName: {
+						NumberOfFailures:   &startingNumberOfFailures,
+						Status:             kedav1alpha1.HealthStatusFailing,
+						LastKnownValue:     resource.NewQuantity(42, resource.DecimalSI),
+						LastKnownValueTime: ptr.To(metav1.NewTime(time.Now().Add(-5 * time.Minute))),
+					},
+				},
+			},
+		)
+		metricSpec := createMetricSpec(10)
+		expectStatusPatch(ctrl, client)
+
+		metrics, _, err := scaler.GetMetricsAndActivity(context.Background(), metricName)
+		metrics, _, err = GetMetricsWithFallback(context.Background(), client, scaleClient, metrics, err, metricName, so, metricSpec)
+
+		Expect(err).ToNot(HaveOccurred())
+		value := metrics[0].Value.AsApproximateFloat64()
+		expectedValue := float64(100) // 10 replicas * 10 target value
+		Expect(value).Should(Equal(expectedValue))
+	})
+
+	It("should decay from the last known value to fallback replicas when behavior is 'decay'", func() {
+		scaler.EXPECT().GetMetricsAndActivity(gomock.Any(), gomock.Eq(metricName)).Return(nil, false, errors.New("some error"))
+		startingNumberOfFailures := int32(3)
+
+		so := buildScaledObject(
+			&kedav1alpha1.Fallback{
+				FailureThreshold: int32(3),
+				Replicas:         int32(10),
+				Behavior:         kedav1alpha1.FallbackBehaviorDecay,
+				HoldPeriod:       ptr.To[int32](60),
+				DecayPeriod:      ptr.To[int32](3600),
+			},
+			&kedav1alpha1.ScaledObjectStatus{
+				Health: map[string]kedav1alpha1.HealthStatus{
+					metricName: {
+						NumberOfFailures:   &startingNumberOfFailures,
+						Status:             kedav1alpha1.HealthStatusFailing,
+						LastKnownValue:     resource.NewQuantity(300, resource.DecimalSI),
+						LastKnownValueTime: ptr.To(metav1.NewTime(time.Now().Add(-31 * time.Minute))),
+					},
+				},
+			},
+		)
+		metricSpec := createMetricSpec(10)
+		expectStatusPatch(ctrl, client)
+
+		metrics, _, err := scaler.GetMetricsAndActivity(context.Background(), metricName)
+		metrics, _, err = GetMetricsWithFallback(context.Background(), client, scaleClient, metrics, err, metricName, so, metricSpec)
+
+		Expect(err).ToNot(HaveOccurred())
+		value := metrics[0].Value.AsApproximateFloat64()
+		// halfway through the decay between 300 and 10 replicas * 10 target value
+		Expect(value).Should(BeNumerically("~", float64(200), 1))
+	})
 })
 
 // Helper functions
@@ -0,0 +1,124 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fallback
+
+import (
+	"context"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+	"github.com/kedacore/keda/v2/pkg/scaling/executor"
+)
+
+// GetJobCountWithFallback updates the health status of the ScaledJob metric depending on suppressedError and,
+// if the trigger has been failing for more than fallback.failureThreshold times, returns the number of jobs to
+// request for it. The second return value indicates whether the fallback is active.
+func GetJobCountWithFallback(ctx context.Context, client runtimeclient.Client, scaledJob *kedav1alpha1.ScaledJob, suppressedError error, metricName string) (int64, bool) {
+	status := scaledJob.Status.DeepCopy()
+	if status.Health == nil {
+		status.Health = make(map[string]kedav1alpha1.HealthStatus)
+	}
+	healthStatus := getHealthStatus(status.Health, metricName)
+
+	if suppressedError == nil {
+		zero := int32(0)
+		healthStatus.NumberOfFailures = &zero
+		healthStatus.Status = kedav1alpha1.HealthStatusHappy
+		// the running jobs are counted again on the next failure
+		healthStatus.LastKnownValue = nil
+		healthStatus.LastKnownValueTime = nil
+		status.Health[metricName] = *healthStatus
+
+		updateScaledJobStatus(ctx, client, scaledJob, status)
+		return 0, false
+	}
+
+	healthStatus.Status = kedav1alpha1.HealthStatusFailing
+	*healthStatus.NumberOfFailures++
+
+	fallback := scaledJob.Spec.Fallback
+	if fallback == nil || fallback.FailureThreshold < 0 || fallback.Replicas < 0 ||
+		*healthStatus.NumberOfFailures <= fallback.FailureThreshold {
+		status.Health[metricName] = *healthStatus
+		updateScaledJobStatus(ctx, client, scaledJob, status)
+		return 0, false
+	}
+
+	jobCount := int64(fallback.Replicas)
+	if fallback.Behavior == kedav1alpha1.FallbackBehaviorCurrentReplicas {
+		// keep the number of jobs that were running when the fallback started
+		if healthStatus.LastKnownValue == nil {
+			runningJobs, err := executor.GetRunningJobCount(ctx, client, scaledJob)
+			if err != nil {
+				log.Error(err, "failed to count running jobs, using fallback replicas", "scaledJob.Namespace", scaledJob.Namespace, "scaledJob.Name", scaledJob.Name)
+				runningJobs = int64(fallback.Replicas)
+			}
+			healthStatus.LastKnownValue = resource.NewQuantity(runningJobs, resource.DecimalSI)
+			healthStatus.LastKnownValueTime = ptr.To(metav1.Now())
+		}
+		jobCount = healthStatus.LastKnownValue.Value()
+	}
+	status.Health[metricName] = *healthStatus
+	updateScaledJobStatus(ctx, client, scaledJob, status)
+
+	log.Info("Suppressing error, using fallback job count",
+		"scaledJob.Namespace", scaledJob.Namespace,
+		"scaledJob.Name", scaledJob.Name,
+		"suppressedError", suppressedError,
+		"fallback.behavior", fallback.Behavior,
+		"fallback.replicas", fallback.Replicas,
+		"jobCount", jobCount)
+	return jobCount, true
+}
+
+func fallbackExistsInScaledJob(scaledJob *kedav1alpha1.ScaledJob, status *kedav1alpha1.ScaledJobStatus) bool {
+	for _, element := range status.Health {
+		if element.Status == kedav1alpha1.HealthStatusFailing && *element.NumberOfFailures > scaledJob.Spec.Fallback.FailureThreshold {
+			return true
+		}
+	}
+	return false
+}
+
+func updateScaledJobStatus(ctx context.Context, client runtimeclient.Client, scaledJob *kedav1alpha1.ScaledJob, status *kedav1alpha1.ScaledJobStatus) {
+	patch := runtimeclient.MergeFrom(scaledJob.DeepCopy())
+
+	if scaledJob.Spec.Fallback == nil {
+		log.V(1).Info("Fallback is not enabled, hence skipping the health update to the scaledjob", "scaledJob.Namespace", scaledJob.Namespace, "scaledJob.Name", scaledJob.Name)
+		return
+	}
+
+	if fallbackExistsInScaledJob(scaledJob, status) {
+		status.Conditions.SetFallbackCondition(metav1.ConditionTrue, "FallbackExists", "At least one trigger is falling back on this scaled job")
+	} else {
+		status.Conditions.SetFallbackCondition(metav1.ConditionFalse, "NoFallbackFound", "No fallbacks are active on this scaled job")
+	}
+
+	// Update status only if it has changed
+	if !reflect.DeepEqual(scaledJob.Status, *status) {
+		scaledJob.Status = *status
+		err := client.Status().Patch(ctx, scaledJob, patch)
+		if err != nil {
+			log.Error(err, "failed to patch ScaledJob Status", "scaledJob.Namespace", scaledJob.Namespace, "scaledJob.Name", scaledJob.Name)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fallback
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+	"github.com/kedacore/keda/v2/pkg/mock/mock_client"
+)
+
+var _ = Describe("scaledjob fallback", func() {
+	var (
+		client *mock_client.MockClient
+		ctrl   *gomock.Controller
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		client = mock_client.NewMockClient(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should not track the health status when fallback is not enabled", func() {
+		sj := buildScaledJob(nil, nil)
+
+		jobCount, fallbackActive := GetJobCountWithFallback(context.Background(), client, sj, errors.New("some error"), metricName)
+
+		Expect(fallbackActive).To(BeFalse())
+		Expect(jobCount).To(Equal(int64(0)))
+		Expect(sj.Status.Health).To(BeNil())
+	})
+
+	It("should bump the number of failures when metrics call fails", func() {
+		startingNumberOfFailures := int32(0)
+		sj := buildScaledJob(
+			&kedav1alpha1.ScaledJobFallback{
+				FailureThreshold: int32(3),
+				Replicas:         int32(10),
+			},
+			map[string]kedav1alpha1.HealthStatus{
+				metricName: {
+					NumberOfFailures: &startingNumberOfFailures,
+					Status:           kedav1alpha1.HealthStatusHappy,
+				},
+			},
+		)
+		expectStatusPatch(ctrl, client)
+
+		_, fallbackActive := GetJobCountWithFallback(context.Background(), client, sj, errors.New("some error"), metricName)
+
+		Expect(fallbackActive).To(BeFalse())
+		Expect(sj.Status.Health[metricName]).To(haveFailureAndStatus(1, kedav1alpha1.HealthStatusFailing))
+	})
+
+	It("should return the static job count when number of failures are beyond threshold", func() {
+		startingNumberOfFailures := int32(3)
+		sj := buildScaledJob(
+			&kedav1alpha1.ScaledJobFallback{
+				FailureThreshold: int32(3),
+				Replicas:         int32(10),
+				Behavior:         kedav1alpha1.FallbackBehaviorStatic,
+			},
+			map[string]kedav1alpha1.HealthStatus{
+				metricName: {
+					NumberOfFailures: &startingNumberOfFailures,
+					Status:           kedav1alpha1.HealthStatusFailing,
+				},
+			},
+		)
+		expectStatusPatch(ctrl, client)
+
+		jobCount, fallbackActive := GetJobCountWithFallback(context.Background(), client, sj, errors.New("some error"), metricName)
+
+		Expect(fallbackActive).To(BeTrue())
+		Expect(jobCount).To(Equal(int64(10)))
+		Expect(sj.Status.Conditions.GetFallbackCondition().Status).To(Equal(metav1.ConditionTrue))
+	})
+
+	It("should keep the running job count when behavior is 'currentReplicas'", func() {
+		startingNumberOfFailures := int32(3)
+		sj := buildScaledJob(
+			&kedav1alpha1.ScaledJobFallback{
+				FailureThreshold: int32(3),
+				Behavior:         kedav1alpha1.FallbackBehaviorCurrentReplicas,
+			},
+			map[string]kedav1alpha1.HealthStatus{
+				metricName: {
+					NumberOfFailures: &startingNumberOfFailures,
+					Status:           kedav1alpha1.HealthStatusFailing,
+				},
+			},
+		)
+		jobs := batchv1.JobList{
+			Items: []batchv1.Job{
+				{},
+				{},
+				{Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}}},
+			},
+		}
+		client.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).SetArg(1, jobs)
+		expectStatusPatch(ctrl, client)
+
+		jobCount, fallbackActive := GetJobCountWithFallback(context.Background(), client, sj, errors.New("some error"), metricName)
+
+		Expect(fallbackActive).To(BeTrue())
+		Expect(jobCount).To(Equal(int64(2)))
+		Expect(sj.Status.Health[metricName].LastKnownValue.Value()).To(Equal(int64(2)))
+
+		// the running jobs counted when the fallback started are kept, even if some of them finish
+		expectStatusPatch(ctrl, client)
+		jobCount, fallbackActive = GetJobCountWithFallback(context.Background(), client, sj, errors.New("some error"), metricName)
+
+		Expect(fallbackActive).To(BeTrue())
+		Expect(jobCount).To(Equal(int64(2)))
+	})
+
+	It("should keep the running workload count when behavior is 'currentReplicas' and a workloadTemplate is used", func() {
+		startingNumberOfFailures := int32(3)
+		sj := buildScaledJob(
+			&kedav1alpha1.ScaledJobFallback{
+				FailureThreshold: int32(3),
+				Behavior:         kedav1alpha1.FallbackBehaviorCurrentReplicas,
+			},
+			map[string]kedav1alpha1.HealthStatus{
+				metricName: {
+					NumberOfFailures: &startingNumberOfFailures,
+					Status:           kedav1alpha1.HealthStatusFailing,
+				},
+			},
+		)
+		sj.Spec.WorkloadTemplate = &kedav1alpha1.WorkloadTemplate{APIVersion: "v1", Kind: "Pod"}
+		pods := unstructured.UnstructuredList{
+			Items: []unstructured.Unstructured{
+				{Object: map[string]any{"status": map[string]any{"phase": "Running"}}},
+				{Object: map[string]any{}},
+				{Object: map[string]any{"status": map[string]any{"phase": "Succeeded"}}},
+			},
+		}
+		client.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&unstructured.UnstructuredList{}), gomock.Any()).Return(nil).SetArg(1, pods)
+		expectStatusPatch(ctrl, client)
+
+		jobCount, fallbackActive := GetJobCountWithFallback(context.Background(), client, sj, errors.New("some error"), metricName)
+
+		Expect(fallbackActive).To(BeTrue())
+		Expect(jobCount).To(Equal(int64(2)))
+	})
+
+	It("should reset the health status when scaler metrics are available", func() {
+		startingNumberOfFailures := int32(5)
+		sj := buildScaledJob(
+			&kedav1alpha1.ScaledJobFallback{
+				FailureThreshold: int32(3),
+				Replicas:         int32(10),
+			},
+			map[string]kedav1alpha1.HealthStatus{
+				metricName: {
+					NumberOfFailures: &startingNumberOfFailures,
+					Status:           kedav1alpha1.HealthStatusFailing,
+				},
+			},
+		)
+		expectStatusPatch(ctrl, client)
+
+		_, fallbackActive := GetJobCountWithFallback(context.Background(), client, sj, nil, metricName)
+
+		Expect(fallbackActive).To(BeFalse())
+		Expect(sj.Status.Health[metricName]).To(haveFailureAndStatus(0, kedav1alpha1.HealthStatusHappy))
+		Expect(sj.Status.Conditions.GetFallbackCondition().Status).To(Equal(metav1.ConditionFalse))
+	})
+})
+
+func buildScaledJob(fallbackConfig *kedav1alpha1.ScaledJobFallback, health map[string]kedav1alpha1.HealthStatus) *kedav1alpha1.ScaledJob {
+	scaledJob := &kedav1alpha1.ScaledJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "clean-up-test", Namespace: "default"},
+		Spec: kedav1alpha1.ScaledJobSpec{
+			JobTargetRef: &batchv1.JobSpec{},
+			Triggers: []kedav1alpha1.ScaleTriggers{
+				{
+					Type: "rabbitmq",
+				},
+			},
+			Fallback: fallbackConfig,
+		},
+		Status: kedav1alpha1.ScaledJobStatus{
+			Health: health,
+		},
+	}
+	scaledJob.Status.Conditions = *kedav1alpha1.GetInitializedConditions()
+
+	return scaledJob
+}
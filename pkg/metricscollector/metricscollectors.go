@@ -48,12 +48,33 @@ type MetricsCollector interface {
 	// RecordScalableObjectLatency create a measurement of the latency executing scalable object loop
 	RecordScalableObjectLatency(namespace string, name string, isScaledObject bool, value time.Duration)
 
+	// RecordScalerMetricAge create a measurement of the age of the metric served to the HPA
+	RecordScalerMetricAge(namespace string, scaledResource string, scaler string, triggerIndex int, metric string, isScaledObject bool, value time.Duration)
+
 	// RecordScalerActive create a measurement of the activity of the scaler
 	RecordScalerActive(namespace string, scaledResource string, scaler string, triggerIndex int, metric string, isScaledObject bool, active bool)
 
 	// RecordScaledObjectPaused marks whether the current ScaledObject is paused.
 	RecordScaledObjectPaused(namespace string, scaledObject string, active bool)
 
+	// RecordScaledObjectShadowDesiredReplicas records the replicas a ScaledObject in dry-run mode would have requested
+	RecordScaledObjectShadowDesiredReplicas(namespace string, scaledObject string, replicas int32)
+
+	// DeleteScaledObjectShadowDesiredReplicas deletes the shadow desired replicas of a ScaledObject no longer in dry-run mode
+	DeleteScaledObjectShadowDesiredReplicas(namespace string, scaledObject string)
+
+	// RecordScaledJobJobs records the number of Jobs of a ScaledJob in a state: running, pending, succeeded or failed
+	RecordScaledJobJobs(namespace string, scaledJob string, state string, count int64)
+
+	// RecordScaledJobScaleDecision records the last scale decision of a ScaledJob
+	RecordScaledJobScaleDecision(namespace string, scaledJob string, queueLength int64, maxValue int64, effectiveMaxScale int64)
+
+	// RecordScaledJobAverageJobDuration records the average duration of the succeeded Jobs of a ScaledJob
+	RecordScaledJobAverageJobDuration(namespace string, scaledJob string, value time.Duration)
+
+	// DeleteScaledJobStatistics deletes the runtime statistics of a deleted ScaledJob
+	DeleteScaledJobStatistics(namespace string, scaledJob string)
+
 	// RecordScalerError counts the number of errors occurred in trying to get an external metric used by the HPA
 	RecordScalerError(namespace string, scaledResource string, scaler string, triggerIndex int, metric string, isScaledObject bool, err error)
 
@@ -125,6 +146,13 @@ func RecordScalableObjectLatency(namespace string, name string, isScaledObject b
 	}
 }
 
+// RecordScalerMetricAge create a measurement of the age of the metric served to the HPA
+func RecordScalerMetricAge(namespace string, scaledObject string, scaler string, triggerIndex int, metric string, isScaledObject bool, value time.Duration) {
+	for _, element := range collectors {
+		element.RecordScalerMetricAge(namespace, scaledObject, scaler, triggerIndex, metric, isScaledObject, value)
+	}
+}
+
 // RecordScalerActive create a measurement of the activity of the scaler
 func RecordScalerActive(namespace string, scaledObject string, scaler string, triggerIndex int, metric string, isScaledObject bool, active bool) {
 	for _, element := range collectors {
@@ -139,6 +167,48 @@ func RecordScaledObjectPaused(namespace string, scaledObject string, active bool
 	}
 }
 
+// RecordScaledObjectShadowDesiredReplicas records the replicas a ScaledObject in dry-run mode would have requested
+func RecordScaledObjectShadowDesiredReplicas(namespace string, scaledObject string, replicas int32) {
+	for _, element := range collectors {
+		element.RecordScaledObjectShadowDesiredReplicas(namespace, scaledObject, replicas)
+	}
+}
+
+// DeleteScaledObjectShadowDesiredReplicas deletes the shadow desired replicas of a ScaledObject no longer in dry-run mode
+func DeleteScaledObjectShadowDesiredReplicas(namespace string, scaledObject string) {
+	for _, element := range collectors {
+		element.DeleteScaledObjectShadowDesiredReplicas(namespace, scaledObject)
+	}
+}
+
+// RecordScaledJobJobs records the number of Jobs of a ScaledJob in a state: running, pending, succeeded or failed
+func RecordScaledJobJobs(namespace string, scaledJob string, state string, count int64) {
+	for _, element := range collectors {
+		element.RecordScaledJobJobs(namespace, scaledJob, state, count)
+	}
+}
+
+// RecordScaledJobScaleDecision records the last scale decision of a ScaledJob
+func RecordScaledJobScaleDecision(namespace string, scaledJob string, queueLength int64, maxValue int64, effectiveMaxScale int64) {
+	for _, element := range collectors {
+		element.RecordScaledJobScaleDecision(namespace, scaledJob, queueLength, maxValue, effectiveMaxScale)
+	}
+}
+
+// RecordScaledJobAverageJobDuration records the average duration of the succeeded Jobs of a ScaledJob
+func RecordScaledJobAverageJobDuration(namespace string, scaledJob string, value time.Duration) {
+	for _, element := range collectors {
+		element.RecordScaledJobAverageJobDuration(namespace, scaledJob, value)
+	}
+}
+
+// DeleteScaledJobStatistics deletes the runtime statistics of a deleted ScaledJob
+func DeleteScaledJobStatistics(namespace string, scaledJob string) {
+	for _, element := range collectors {
+		element.DeleteScaledJobStatistics(namespace, scaledJob)
+	}
+}
+
 // RecordScalerError counts the number of errors occurred in trying to get an external metric used by the HPA
 func RecordScalerError(namespace string, scaledObject string, scaler string, triggerIndex int, metric string, isScaledObject bool, err error) {
 	for _, element := range collectors {
@@ -38,6 +38,7 @@ var (
 	otelScalerMetricVals                  []OtelMetricFloat64Val
 	otelScalerMetricsLatencyVals          []OtelMetricFloat64Val
 	otelScalerMetricsLatencyValDeprecated []OtelMetricFloat64Val
+	otelScalerMetricsAgeVals              []OtelMetricFloat64Val
 	otelInternalLoopLatencyVals           []OtelMetricFloat64Val
 	otelInternalLoopLatencyValDeprecated  []OtelMetricFloat64Val
 	otelBuildInfoVal                      OtelMetricInt64Val
@@ -47,6 +48,14 @@ var (
 
 	otelScalerActiveVals []OtelMetricFloat64Val
 	otelScalerPauseVals  []OtelMetricFloat64Val
+
+	otelScaledObjectShadowDesiredReplicasVals []OtelMetricFloat64Val
+
+	otelScaledJobJobsVals               []OtelMetricFloat64Val
+	otelScaledJobQueueLengthVals        []OtelMetricFloat64Val
+	otelScaledJobMaxValueVals           []OtelMetricFloat64Val
+	otelScaledJobEffectiveMaxScaleVals  []OtelMetricFloat64Val
+	otelScaledJobAverageJobDurationVals []OtelMetricFloat64Val
 )
 
 type OtelMetrics struct {
@@ -162,6 +171,16 @@ func initMeters() {
 		otLog.Error(err, msg)
 	}
 
+	_, err = meter.Float64ObservableGauge(
+		"keda.scaler.metrics.age.seconds",
+		api.WithDescription("The age of the metric served to the HPA for each scaler, greater than 0 when the metric is read from the cache"),
+		api.WithUnit("s"),
+		api.WithFloat64Callback(ScalerMetricsAgeCallback),
+	)
+	if err != nil {
+		otLog.Error(err, msg)
+	}
+
 	_, err = meter.Float64ObservableGauge(
 		"keda.internal.scale.loop.latency",
 		api.WithDescription("DEPRECATED - use `keda.internal.scale.loop.latency.seconds` instead"),
@@ -220,6 +239,61 @@ func initMeters() {
 	if err != nil {
 		otLog.Error(err, msg)
 	}
+
+	_, err = meter.Float64ObservableGauge(
+		"keda.scaledobject.shadow.desired.replicas",
+		api.WithDescription("The number of replicas a ScaledObject in dry-run mode would have requested"),
+		api.WithFloat64Callback(ShadowDesiredReplicasCallback),
+	)
+	if err != nil {
+		otLog.Error(err, msg)
+	}
+
+	_, err = meter.Float64ObservableGauge(
+		"keda.scaledjob.jobs",
+		api.WithDescription("The number of Jobs of a ScaledJob per state: running, pending, succeeded or failed"),
+		api.WithFloat64Callback(ScaledJobJobsCallback),
+	)
+	if err != nil {
+		otLog.Error(err, msg)
+	}
+
+	_, err = meter.Float64ObservableGauge(
+		"keda.scaledjob.queue.length",
+		api.WithDescription("The number of Jobs requested by the triggers of a ScaledJob on the last scale decision"),
+		api.WithFloat64Callback(ScaledJobQueueLengthCallback),
+	)
+	if err != nil {
+		otLog.Error(err, msg)
+	}
+
+	_, err = meter.Float64ObservableGauge(
+		"keda.scaledjob.max.value",
+		api.WithDescription("The number of Jobs requested by the triggers of a ScaledJob, capped by its max replica count, on the last scale decision"),
+		api.WithFloat64Callback(ScaledJobMaxValueCallback),
+	)
+	if err != nil {
+		otLog.Error(err, msg)
+	}
+
+	_, err = meter.Float64ObservableGauge(
+		"keda.scaledjob.effective.max.scale",
+		api.WithDescription("The number of Jobs the scaling strategy of a ScaledJob allowed to create on the last scale decision"),
+		api.WithFloat64Callback(ScaledJobEffectiveMaxScaleCallback),
+	)
+	if err != nil {
+		otLog.Error(err, msg)
+	}
+
+	_, err = meter.Float64ObservableGauge(
+		"keda.scaledjob.average.job.duration.seconds",
+		api.WithDescription("The average duration of the succeeded Jobs kept in the history of a ScaledJob"),
+		api.WithUnit("s"),
+		api.WithFloat64Callback(ScaledJobAverageJobDurationCallback),
+	)
+	if err != nil {
+		otLog.Error(err, msg)
+	}
 }
 
 func BuildInfoCallback(_ context.Context, obsrv api.Int64Observer) error {
@@ -291,6 +365,22 @@ func (o *OtelMetrics) RecordScalerLatency(namespace string, scaledResource strin
 	otelScalerMetricsLatencyValDeprecated = append(otelScalerMetricsLatencyValDeprecated, otelScalerMetricsLatencyValD)
 }
 
+func ScalerMetricsAgeCallback(_ context.Context, obsrv api.Float64Observer) error {
+	for _, v := range otelScalerMetricsAgeVals {
+		obsrv.Observe(v.val, v.measurementOption)
+	}
+	otelScalerMetricsAgeVals = []OtelMetricFloat64Val{}
+	return nil
+}
+
+// RecordScalerMetricAge create a measurement of the age of the metric served to the HPA
+func (o *OtelMetrics) RecordScalerMetricAge(namespace string, scaledResource string, scaler string, triggerIndex int, metric string, isScaledObject bool, value time.Duration) {
+	otelScalerMetricsAge := OtelMetricFloat64Val{}
+	otelScalerMetricsAge.val = value.Seconds()
+	otelScalerMetricsAge.measurementOption = getScalerMeasurementOption(namespace, scaledResource, scaler, triggerIndex, metric, isScaledObject)
+	otelScalerMetricsAgeVals = append(otelScalerMetricsAgeVals, otelScalerMetricsAge)
+}
+
 func ScalableObjectLatencyCallback(_ context.Context, obsrv api.Float64Observer) error {
 	for _, v := range otelInternalLoopLatencyVals {
 		obsrv.Observe(v.val, v.measurementOption)
@@ -375,6 +465,104 @@ func (o *OtelMetrics) RecordScaledObjectPaused(namespace string, scaledObject st
 	otelScalerPauseVals = append(otelScalerPauseVals, otelScalerPause)
 }
 
+func ShadowDesiredReplicasCallback(_ context.Context, obsrv api.Float64Observer) error {
+	for _, v := range otelScaledObjectShadowDesiredReplicasVals {
+		obsrv.Observe(v.val, v.measurementOption)
+	}
+	otelScaledObjectShadowDesiredReplicasVals = []OtelMetricFloat64Val{}
+	return nil
+}
+
+// RecordScaledObjectShadowDesiredReplicas records the replicas a ScaledObject in dry-run mode would have requested
+func (o *OtelMetrics) RecordScaledObjectShadowDesiredReplicas(namespace string, scaledObject string, replicas int32) {
+	opt := api.WithAttributes(
+		attribute.Key("namespace").String(namespace),
+		attribute.Key("scaledObject").String(scaledObject))
+
+	otelShadowDesiredReplicas := OtelMetricFloat64Val{}
+	otelShadowDesiredReplicas.val = float64(replicas)
+	otelShadowDesiredReplicas.measurementOption = opt
+	otelScaledObjectShadowDesiredReplicasVals = append(otelScaledObjectShadowDesiredReplicasVals, otelShadowDesiredReplicas)
+}
+
+func (o *OtelMetrics) DeleteScaledObjectShadowDesiredReplicas(string, string) {
+	// noop for OTel
+}
+
+func ScaledJobJobsCallback(_ context.Context, obsrv api.Float64Observer) error {
+	for _, v := range otelScaledJobJobsVals {
+		obsrv.Observe(v.val, v.measurementOption)
+	}
+	otelScaledJobJobsVals = []OtelMetricFloat64Val{}
+	return nil
+}
+
+func ScaledJobQueueLengthCallback(_ context.Context, obsrv api.Float64Observer) error {
+	for _, v := range otelScaledJobQueueLengthVals {
+		obsrv.Observe(v.val, v.measurementOption)
+	}
+	otelScaledJobQueueLengthVals = []OtelMetricFloat64Val{}
+	return nil
+}
+
+func ScaledJobMaxValueCallback(_ context.Context, obsrv api.Float64Observer) error {
+	for _, v := range otelScaledJobMaxValueVals {
+		obsrv.Observe(v.val, v.measurementOption)
+	}
+	otelScaledJobMaxValueVals = []OtelMetricFloat64Val{}
+	return nil
+}
+
+func ScaledJobEffectiveMaxScaleCallback(_ context.Context, obsrv api.Float64Observer) error {
+	for _, v := range otelScaledJobEffectiveMaxScaleVals {
+		obsrv.Observe(v.val, v.measurementOption)
+	}
+	otelScaledJobEffectiveMaxScaleVals = []OtelMetricFloat64Val{}
+	return nil
+}
+
+func ScaledJobAverageJobDurationCallback(_ context.Context, obsrv api.Float64Observer) error {
+	for _, v := range otelScaledJobAverageJobDurationVals {
+		obsrv.Observe(v.val, v.measurementOption)
+	}
+	otelScaledJobAverageJobDurationVals = []OtelMetricFloat64Val{}
+	return nil
+}
+
+// RecordScaledJobJobs records the number of Jobs of a ScaledJob in a state: running, pending, succeeded or failed
+func (o *OtelMetrics) RecordScaledJobJobs(namespace string, scaledJob string, state string, count int64) {
+	opt := api.WithAttributes(
+		attribute.Key("namespace").String(namespace),
+		attribute.Key("scaledJob").String(scaledJob),
+		attribute.Key("state").String(state))
+
+	otelScaledJobJobsVals = append(otelScaledJobJobsVals, OtelMetricFloat64Val{val: float64(count), measurementOption: opt})
+}
+
+// RecordScaledJobScaleDecision records the last scale decision of a ScaledJob
+func (o *OtelMetrics) RecordScaledJobScaleDecision(namespace string, scaledJob string, queueLength int64, maxValue int64, effectiveMaxScale int64) {
+	opt := api.WithAttributes(
+		attribute.Key("namespace").String(namespace),
+		attribute.Key("scaledJob").String(scaledJob))
+
+	otelScaledJobQueueLengthVals = append(otelScaledJobQueueLengthVals, OtelMetricFloat64Val{val: float64(queueLength), measurementOption: opt})
+	otelScaledJobMaxValueVals = append(otelScaledJobMaxValueVals, OtelMetricFloat64Val{val: float64(maxValue), measurementOption: opt})
+	otelScaledJobEffectiveMaxScaleVals = append(otelScaledJobEffectiveMaxScaleVals, OtelMetricFloat64Val{val: float64(effectiveMaxScale), measurementOption: opt})
+}
+
+// RecordScaledJobAverageJobDuration records the average duration of the succeeded Jobs of a ScaledJob
+func (o *OtelMetrics) RecordScaledJobAverageJobDuration(namespace string, scaledJob string, value time.Duration) {
+	opt := api.WithAttributes(
+		attribute.Key("namespace").String(namespace),
+		attribute.Key("scaledJob").String(scaledJob))
+
+	otelScaledJobAverageJobDurationVals = append(otelScaledJobAverageJobDurationVals, OtelMetricFloat64Val{val: value.Seconds(), measurementOption: opt})
+}
+
+func (o *OtelMetrics) DeleteScaledJobStatistics(string, string) {
+	// noop for OTel
+}
+
 // RecordScalerError counts the number of errors occurred in trying to get an external metric used by the HPA
 func (o *OtelMetrics) RecordScalerError(namespace string, scaledResource string, scaler string, triggerIndex int, metric string, isScaledObject bool, err error) {
 	if err != nil {
@@ -155,3 +155,47 @@ func TestContinuousMetrics(t *testing.T) {
 	assert.Equal(t, attribute.AsString(), "testmetric")
 	assert.Equal(t, scaledJobMetric.Value, 0.0)
 }
+
+func TestScalerMetricAge(t *testing.T) {
+	testOtel.RecordScalerMetricAge("testnamespace", "testresource", "testscaler", 0, "testmetric", true, 90*time.Second)
+	got := metricdata.ResourceMetrics{}
+	err := testReader.Collect(context.Background(), &got)
+
+	assert.Nil(t, err)
+	scopeMetrics := got.ScopeMetrics[0]
+	assert.NotEqual(t, len(scopeMetrics.Metrics), 0)
+
+	age := retrieveMetric(scopeMetrics.Metrics, "keda.scaler.metrics.age.seconds")
+	assert.NotNil(t, age)
+	assert.Equal(t, age.Unit, "s")
+	data := age.Data.(metricdata.Gauge[float64]).DataPoints[0]
+	assert.Equal(t, data.Value, float64(90))
+}
+
+func TestScaledJobStatistics(t *testing.T) {
+	testOtel.RecordScaledJobJobs("testnamespace", "testresource", "running", 3)
+	testOtel.RecordScaledJobScaleDecision("testnamespace", "testresource", 12, 10, 7)
+	testOtel.RecordScaledJobAverageJobDuration("testnamespace", "testresource", 2*time.Minute)
+	got := metricdata.ResourceMetrics{}
+	err := testReader.Collect(context.Background(), &got)
+
+	assert.Nil(t, err)
+	scopeMetrics := got.ScopeMetrics[0]
+	assert.NotEqual(t, len(scopeMetrics.Metrics), 0)
+
+	jobs := retrieveMetric(scopeMetrics.Metrics, "keda.scaledjob.jobs")
+	assert.NotNil(t, jobs)
+	data := jobs.Data.(metricdata.Gauge[float64]).DataPoints[0]
+	attribute, _ := data.Attributes.Value("state")
+	assert.Equal(t, attribute.AsString(), "running")
+	assert.Equal(t, data.Value, float64(3))
+
+	effectiveMaxScale := retrieveMetric(scopeMetrics.Metrics, "keda.scaledjob.effective.max.scale")
+	assert.NotNil(t, effectiveMaxScale)
+	assert.Equal(t, effectiveMaxScale.Data.(metricdata.Gauge[float64]).DataPoints[0].Value, float64(7))
+
+	duration := retrieveMetric(scopeMetrics.Metrics, "keda.scaledjob.average.job.duration.seconds")
+	assert.NotNil(t, duration)
+	assert.Equal(t, duration.Unit, "s")
+	assert.Equal(t, duration.Data.(metricdata.Gauge[float64]).DataPoints[0].Value, float64(120))
+}
@@ -59,6 +59,15 @@ var (
 		},
 		metricLabels,
 	)
+	scalerMetricsAge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: DefaultPromMetricsNamespace,
+			Subsystem: "scaler",
+			Name:      "metrics_age_seconds",
+			Help:      "The age of the metric served to the HPA for each scaler, in seconds. It's greater than 0 when the metric is read from the cache.",
+		},
+		metricLabels,
+	)
 	scalerActive = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: DefaultPromMetricsNamespace,
@@ -77,6 +86,60 @@ var (
 		},
 		[]string{"namespace", "scaledObject"},
 	)
+	scaledObjectShadowDesiredReplicas = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: DefaultPromMetricsNamespace,
+			Subsystem: "scaledobject",
+			Name:      "shadow_desired_replicas",
+			Help:      "The number of replicas a ScaledObject in dry-run mode would have requested.",
+		},
+		[]string{"namespace", "scaledObject"},
+	)
+	scaledJobJobs = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: DefaultPromMetricsNamespace,
+			Subsystem: "scaled_job",
+			Name:      "jobs",
+			Help:      "The number of Jobs of a ScaledJob per state: running, pending, succeeded or failed.",
+		},
+		[]string{"namespace", "scaledJob", "state"},
+	)
+	scaledJobQueueLength = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: DefaultPromMetricsNamespace,
+			Subsystem: "scaled_job",
+			Name:      "queue_length",
+			Help:      "The number of Jobs requested by the triggers of a ScaledJob on the last scale decision.",
+		},
+		[]string{"namespace", "scaledJob"},
+	)
+	scaledJobMaxValue = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: DefaultPromMetricsNamespace,
+			Subsystem: "scaled_job",
+			Name:      "max_value",
+			Help:      "The number of Jobs requested by the triggers of a ScaledJob, capped by its max replica count, on the last scale decision.",
+		},
+		[]string{"namespace", "scaledJob"},
+	)
+	scaledJobEffectiveMaxScale = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: DefaultPromMetricsNamespace,
+			Subsystem: "scaled_job",
+			Name:      "effective_max_scale",
+			Help:      "The number of Jobs the scaling strategy of a ScaledJob allowed to create on the last scale decision.",
+		},
+		[]string{"namespace", "scaledJob"},
+	)
+	scaledJobAverageJobDuration = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: DefaultPromMetricsNamespace,
+			Subsystem: "scaled_job",
+			Name:      "average_job_duration_seconds",
+			Help:      "The average duration of the succeeded Jobs kept in the history of a ScaledJob, in seconds.",
+		},
+		[]string{"namespace", "scaledJob"},
+	)
 	scalerErrors = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Namespace: DefaultPromMetricsNamespace,
@@ -160,11 +223,18 @@ type PromMetrics struct {
 func NewPromMetrics() *PromMetrics {
 	metrics.Registry.MustRegister(scalerMetricsValue)
 	metrics.Registry.MustRegister(scalerMetricsLatency)
+	metrics.Registry.MustRegister(scalerMetricsAge)
 	metrics.Registry.MustRegister(internalLoopLatency)
 	metrics.Registry.MustRegister(scalerActive)
 	metrics.Registry.MustRegister(scalerErrors)
 	metrics.Registry.MustRegister(scaledObjectErrors)
 	metrics.Registry.MustRegister(scaledObjectPaused)
+	metrics.Registry.MustRegister(scaledObjectShadowDesiredReplicas)
+	metrics.Registry.MustRegister(scaledJobJobs)
+	metrics.Registry.MustRegister(scaledJobQueueLength)
+	metrics.Registry.MustRegister(scaledJobMaxValue)
+	metrics.Registry.MustRegister(scaledJobEffectiveMaxScale)
+	metrics.Registry.MustRegister(scaledJobAverageJobDuration)
 	metrics.Registry.MustRegister(triggerRegistered)
 	metrics.Registry.MustRegister(crdRegistered)
 	metrics.Registry.MustRegister(scaledJobErrors)
@@ -194,6 +264,7 @@ func (p *PromMetrics) DeleteScalerMetrics(namespace string, scaledResource strin
 	scalerActive.DeletePartialMatch(prometheus.Labels{"namespace": namespace, "scaledObject": scaledResource, "type": getResourceType(isScaledObject)})
 	scalerErrors.DeletePartialMatch(prometheus.Labels{"namespace": namespace, "scaledObject": scaledResource, "type": getResourceType(isScaledObject)})
 	scalerMetricsLatency.DeletePartialMatch(prometheus.Labels{"namespace": namespace, "scaledObject": scaledResource, "type": getResourceType(isScaledObject)})
+	scalerMetricsAge.DeletePartialMatch(prometheus.Labels{"namespace": namespace, "scaledObject": scaledResource, "type": getResourceType(isScaledObject)})
 }
 
 // RecordScalerLatency create a measurement of the latency to external metric
@@ -201,6 +272,11 @@ func (p *PromMetrics) RecordScalerLatency(namespace string, scaledResource strin
 	scalerMetricsLatency.With(getLabels(namespace, scaledResource, scaler, triggerIndex, metric, isScaledObject)).Set(value.Seconds())
 }
 
+// RecordScalerMetricAge create a measurement of the age of the metric served to the HPA
+func (p *PromMetrics) RecordScalerMetricAge(namespace string, scaledResource string, scaler string, triggerIndex int, metric string, isScaledObject bool, value time.Duration) {
+	scalerMetricsAge.With(getLabels(namespace, scaledResource, scaler, triggerIndex, metric, isScaledObject)).Set(value.Seconds())
+}
+
 // RecordScalableObjectLatency create a measurement of the latency executing scalable object loop
 func (p *PromMetrics) RecordScalableObjectLatency(namespace string, name string, isScaledObject bool, value time.Duration) {
 	internalLoopLatency.WithLabelValues(namespace, getResourceType(isScaledObject), name).Set(value.Seconds())
@@ -228,6 +304,44 @@ func (p *PromMetrics) RecordScaledObjectPaused(namespace string, scaledObject st
 	scaledObjectPaused.With(labels).Set(float64(activeVal))
 }
 
+// RecordScaledObjectShadowDesiredReplicas records the replicas a ScaledObject in dry-run mode would have requested
+func (p *PromMetrics) RecordScaledObjectShadowDesiredReplicas(namespace string, scaledObject string, replicas int32) {
+	scaledObjectShadowDesiredReplicas.With(prometheus.Labels{"namespace": namespace, "scaledObject": scaledObject}).Set(float64(replicas))
+}
+
+// DeleteScaledObjectShadowDesiredReplicas deletes the shadow desired replicas of a ScaledObject no longer in dry-run mode
+func (p *PromMetrics) DeleteScaledObjectShadowDesiredReplicas(namespace string, scaledObject string) {
+	scaledObjectShadowDesiredReplicas.Delete(prometheus.Labels{"namespace": namespace, "scaledObject": scaledObject})
+}
+
+// RecordScaledJobJobs records the number of Jobs of a ScaledJob in a state: running, pending, succeeded or failed
+func (p *PromMetrics) RecordScaledJobJobs(namespace string, scaledJob string, state string, count int64) {
+	scaledJobJobs.With(prometheus.Labels{"namespace": namespace, "scaledJob": scaledJob, "state": state}).Set(float64(count))
+}
+
+// RecordScaledJobScaleDecision records the last scale decision of a ScaledJob
+func (p *PromMetrics) RecordScaledJobScaleDecision(namespace string, scaledJob string, queueLength int64, maxValue int64, effectiveMaxScale int64) {
+	labels := prometheus.Labels{"namespace": namespace, "scaledJob": scaledJob}
+	scaledJobQueueLength.With(labels).Set(float64(queueLength))
+	scaledJobMaxValue.With(labels).Set(float64(maxValue))
+	scaledJobEffectiveMaxScale.With(labels).Set(float64(effectiveMaxScale))
+}
+
+// RecordScaledJobAverageJobDuration records the average duration of the succeeded Jobs of a ScaledJob
+func (p *PromMetrics) RecordScaledJobAverageJobDuration(namespace string, scaledJob string, value time.Duration) {
+	scaledJobAverageJobDuration.With(prometheus.Labels{"namespace": namespace, "scaledJob": scaledJob}).Set(value.Seconds())
+}
+
+// DeleteScaledJobStatistics deletes the runtime statistics of a deleted ScaledJob
+func (p *PromMetrics) DeleteScaledJobStatistics(namespace string, scaledJob string) {
+	labels := prometheus.Labels{"namespace": namespace, "scaledJob": scaledJob}
+	scaledJobJobs.DeletePartialMatch(labels)
+	scaledJobQueueLength.Delete(labels)
+	scaledJobMaxValue.Delete(labels)
+	scaledJobEffectiveMaxScale.Delete(labels)
+	scaledJobAverageJobDuration.Delete(labels)
+}
+
 // RecordScalerError counts the number of errors occurred in trying to get an external metric used by the HPA
 func (p *PromMetrics) RecordScalerError(namespace string, scaledResource string, scaler string, triggerIndex int, metric string, isScaledObject bool, err error) {
 	if err != nil {
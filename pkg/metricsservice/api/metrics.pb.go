@@ -24,6 +24,7 @@ package api
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	v1beta1 "k8s.io/metrics/pkg/apis/external_metrics/v1beta1"
 	reflect "reflect"
@@ -291,10 +292,12 @@ func (x *RawMetricsResponse) GetMetrics() []*RawMetric {
 }
 
 type RawMetric struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Value         float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
-	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Metadata      *ScaledObjectRef       `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Value     float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Metadata  *ScaledObjectRef       `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// age of the value, i.e. the time elapsed since it was collected from the scaler
+	Age           *durationpb.Duration `protobuf:"bytes,4,opt,name=age,proto3" json:"age,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -350,11 +353,233 @@ func (x *RawMetric) GetMetadata() *ScaledObjectRef {
 	return nil
 }
 
+func (x *RawMetric) GetAge() *durationpb.Duration {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+type ScalingHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// kind of the scalable object, ScaledObject or ScaledJob
+	Kind          string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScalingHistoryRequest) Reset() {
+	*x = ScalingHistoryRequest{}
+	mi := &file_metrics_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScalingHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScalingHistoryRequest) ProtoMessage() {}
+
+func (x *ScalingHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metrics_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScalingHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ScalingHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_metrics_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ScalingHistoryRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ScalingHistoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ScalingHistoryRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ScalingHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// transitions from the oldest to the latest
+	Transitions   []*ScalingTransition `protobuf:"bytes,1,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScalingHistoryResponse) Reset() {
+	*x = ScalingHistoryResponse{}
+	mi := &file_metrics_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScalingHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScalingHistoryResponse) ProtoMessage() {}
+
+func (x *ScalingHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metrics_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScalingHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ScalingHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_metrics_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ScalingHistoryResponse) GetTransitions() []*ScalingTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+type ScalingTransition struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// replicas of the scale target, or running Jobs of a ScaledJob, before and after the transition
+	FromReplicas   int32              `protobuf:"varint,2,opt,name=fromReplicas,proto3" json:"fromReplicas,omitempty"`
+	ToReplicas     int32              `protobuf:"varint,3,opt,name=toReplicas,proto3" json:"toReplicas,omitempty"`
+	Reason         string             `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message        string             `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	ActiveTriggers []string           `protobuf:"bytes,6,rep,name=activeTriggers,proto3" json:"activeTriggers,omitempty"`
+	MetricValues   map[string]float64 `protobuf:"bytes,7,rep,name=metricValues,proto3" json:"metricValues,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	Paused         bool               `protobuf:"varint,8,opt,name=paused,proto3" json:"paused,omitempty"`
+	Fallback       bool               `protobuf:"varint,9,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ScalingTransition) Reset() {
+	*x = ScalingTransition{}
+	mi := &file_metrics_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScalingTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScalingTransition) ProtoMessage() {}
+
+func (x *ScalingTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_metrics_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScalingTransition.ProtoReflect.Descriptor instead.
+func (*ScalingTransition) Descriptor() ([]byte, []int) {
+	return file_metrics_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ScalingTransition) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *ScalingTransition) GetFromReplicas() int32 {
+	if x != nil {
+		return x.FromReplicas
+	}
+	return 0
+}
+
+func (x *ScalingTransition) GetToReplicas() int32 {
+	if x != nil {
+		return x.ToReplicas
+	}
+	return 0
+}
+
+func (x *ScalingTransition) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ScalingTransition) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ScalingTransition) GetActiveTriggers() []string {
+	if x != nil {
+		return x.ActiveTriggers
+	}
+	return nil
+}
+
+func (x *ScalingTransition) GetMetricValues() map[string]float64 {
+	if x != nil {
+		return x.MetricValues
+	}
+	return nil
+}
+
+func (x *ScalingTransition) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *ScalingTransition) GetFallback() bool {
+	if x != nil {
+		return x.Fallback
+	}
+	return false
+}
+
 var File_metrics_proto protoreflect.FileDescriptor
 
 const file_metrics_proto_rawDesc = "" +
 	"\n" +
-	"\rmetrics.proto\x12\x03api\x1a\x1fgoogle/protobuf/timestamp.proto\x1a@k8s.io/metrics/pkg/apis/external_metrics/v1beta1/generated.proto\"c\n" +
+	"\rmetrics.proto\x12\x03api\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a@k8s.io/metrics/pkg/apis/external_metrics/v1beta1/generated.proto\"c\n" +
 	"\x0fScaledObjectRef\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
 	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x1e\n" +
@@ -376,14 +601,37 @@ const file_metrics_proto_rawDesc = "" +
 	"\n" +
 	"\b_message\">\n" +
 	"\x12RawMetricsResponse\x12(\n" +
-	"\ametrics\x18\x01 \x03(\v2\x0e.api.RawMetricR\ametrics\"\x8d\x01\n" +
+	"\ametrics\x18\x01 \x03(\v2\x0e.api.RawMetricR\ametrics\"\xba\x01\n" +
 	"\tRawMetric\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\x01R\x05value\x128\n" +
 	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x120\n" +
-	"\bmetadata\x18\x03 \x01(\v2\x14.api.ScaledObjectRefR\bmetadata2\x81\x01\n" +
+	"\bmetadata\x18\x03 \x01(\v2\x14.api.ScaledObjectRefR\bmetadata\x12+\n" +
+	"\x03age\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x03age\"]\n" +
+	"\x15ScalingHistoryRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\"R\n" +
+	"\x16ScalingHistoryResponse\x128\n" +
+	"\vtransitions\x18\x01 \x03(\v2\x16.api.ScalingTransitionR\vtransitions\"\xae\x03\n" +
+	"\x11ScalingTransition\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\"\n" +
+	"\ffromReplicas\x18\x02 \x01(\x05R\ffromReplicas\x12\x1e\n" +
+	"\n" +
+	"toReplicas\x18\x03 \x01(\x05R\n" +
+	"toReplicas\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12&\n" +
+	"\x0eactiveTriggers\x18\x06 \x03(\tR\x0eactiveTriggers\x12L\n" +
+	"\fmetricValues\x18\a \x03(\v2(.api.ScalingTransition.MetricValuesEntryR\fmetricValues\x12\x16\n" +
+	"\x06paused\x18\b \x01(\bR\x06paused\x12\x1a\n" +
+	"\bfallback\x18\t \x01(\bR\bfallback\x1a?\n" +
+	"\x11MetricValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x012\xd1\x01\n" +
 	"\x0eMetricsService\x12o\n" +
 	"\n" +
-	"GetMetrics\x12\x14.api.ScaledObjectRef\x1aI.k8s.io.metrics.pkg.apis.external_metrics.v1beta1.ExternalMetricValueList\"\x002\xeb\x01\n" +
+	"GetMetrics\x12\x14.api.ScaledObjectRef\x1aI.k8s.io.metrics.pkg.apis.external_metrics.v1beta1.ExternalMetricValueList\"\x00\x12N\n" +
+	"\x11GetScalingHistory\x12\x1a.api.ScalingHistoryRequest\x1a\x1b.api.ScalingHistoryResponse\"\x002\xeb\x01\n" +
 	"\x11RawMetricsService\x12J\n" +
 	"\x13GetRawMetricsStream\x12\x16.api.RawMetricsRequest\x1a\x17.api.RawMetricsResponse\"\x000\x01\x12C\n" +
 	"\x0fSubscribeMetric\x12\x18.api.SubscriptionRequest\x1a\x14.api.SubscriptionAck\"\x00\x12E\n" +
@@ -401,7 +649,7 @@ func file_metrics_proto_rawDescGZIP() []byte {
 	return file_metrics_proto_rawDescData
 }
 
-var file_metrics_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_metrics_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_metrics_proto_goTypes = []any{
 	(*ScaledObjectRef)(nil),                 // 0: api.ScaledObjectRef
 	(*RawMetricsRequest)(nil),               // 1: api.RawMetricsRequest
@@ -409,27 +657,38 @@ var file_metrics_proto_goTypes = []any{
 	(*SubscriptionAck)(nil),                 // 3: api.SubscriptionAck
 	(*RawMetricsResponse)(nil),              // 4: api.RawMetricsResponse
 	(*RawMetric)(nil),                       // 5: api.RawMetric
-	(*timestamppb.Timestamp)(nil),           // 6: google.protobuf.Timestamp
-	(*v1beta1.ExternalMetricValueList)(nil), // 7: k8s.io.metrics.pkg.apis.external_metrics.v1beta1.ExternalMetricValueList
+	(*ScalingHistoryRequest)(nil),           // 6: api.ScalingHistoryRequest
+	(*ScalingHistoryResponse)(nil),          // 7: api.ScalingHistoryResponse
+	(*ScalingTransition)(nil),               // 8: api.ScalingTransition
+	nil,                                     // 9: api.ScalingTransition.MetricValuesEntry
+	(*timestamppb.Timestamp)(nil),           // 10: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),             // 11: google.protobuf.Duration
+	(*v1beta1.ExternalMetricValueList)(nil), // 12: k8s.io.metrics.pkg.apis.external_metrics.v1beta1.ExternalMetricValueList
 }
 var file_metrics_proto_depIdxs = []int32{
-	0, // 0: api.SubscriptionRequest.metricMetadata:type_name -> api.ScaledObjectRef
-	5, // 1: api.RawMetricsResponse.metrics:type_name -> api.RawMetric
-	6, // 2: api.RawMetric.timestamp:type_name -> google.protobuf.Timestamp
-	0, // 3: api.RawMetric.metadata:type_name -> api.ScaledObjectRef
-	0, // 4: api.MetricsService.GetMetrics:input_type -> api.ScaledObjectRef
-	1, // 5: api.RawMetricsService.GetRawMetricsStream:input_type -> api.RawMetricsRequest
-	2, // 6: api.RawMetricsService.SubscribeMetric:input_type -> api.SubscriptionRequest
-	2, // 7: api.RawMetricsService.UnsubscribeMetric:input_type -> api.SubscriptionRequest
-	7, // 8: api.MetricsService.GetMetrics:output_type -> k8s.io.metrics.pkg.apis.external_metrics.v1beta1.ExternalMetricValueList
-	4, // 9: api.RawMetricsService.GetRawMetricsStream:output_type -> api.RawMetricsResponse
-	3, // 10: api.RawMetricsService.SubscribeMetric:output_type -> api.SubscriptionAck
-	3, // 11: api.RawMetricsService.UnsubscribeMetric:output_type -> api.SubscriptionAck
-	8, // [8:12] is the sub-list for method output_type
-	4, // [4:8] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	0,  // 0: api.SubscriptionRequest.metricMetadata:type_name -> api.ScaledObjectRef
+	5,  // 1: api.RawMetricsResponse.metrics:type_name -> api.RawMetric
+	10, // 2: api.RawMetric.timestamp:type_name -> google.protobuf.Timestamp
+	0,  // 3: api.RawMetric.metadata:type_name -> api.ScaledObjectRef
+	11, // 4: api.RawMetric.age:type_name -> google.protobuf.Duration
+	8,  // 5: api.ScalingHistoryResponse.transitions:type_name -> api.ScalingTransition
+	10, // 6: api.ScalingTransition.timestamp:type_name -> google.protobuf.Timestamp
+	9,  // 7: api.ScalingTransition.metricValues:type_name -> api.ScalingTransition.MetricValuesEntry
+	0,  // 8: api.MetricsService.GetMetrics:input_type -> api.ScaledObjectRef
+	6,  // 9: api.MetricsService.GetScalingHistory:input_type -> api.ScalingHistoryRequest
+	1,  // 10: api.RawMetricsService.GetRawMetricsStream:input_type -> api.RawMetricsRequest
+	2,  // 11: api.RawMetricsService.SubscribeMetric:input_type -> api.SubscriptionRequest
+	2,  // 12: api.RawMetricsService.UnsubscribeMetric:input_type -> api.SubscriptionRequest
+	12, // 13: api.MetricsService.GetMetrics:output_type -> k8s.io.metrics.pkg.apis.external_metrics.v1beta1.ExternalMetricValueList
+	7,  // 14: api.MetricsService.GetScalingHistory:output_type -> api.ScalingHistoryResponse
+	4,  // 15: api.RawMetricsService.GetRawMetricsStream:output_type -> api.RawMetricsResponse
+	3,  // 16: api.RawMetricsService.SubscribeMetric:output_type -> api.SubscriptionAck
+	3,  // 17: api.RawMetricsService.UnsubscribeMetric:output_type -> api.SubscriptionAck
+	13, // [13:18] is the sub-list for method output_type
+	8,  // [8:13] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_metrics_proto_init() }
@@ -444,7 +703,7 @@ func file_metrics_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_metrics_proto_rawDesc), len(file_metrics_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
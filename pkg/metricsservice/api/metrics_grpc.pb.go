@@ -35,7 +35,8 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MetricsService_GetMetrics_FullMethodName = "/api.MetricsService/GetMetrics"
+	MetricsService_GetMetrics_FullMethodName        = "/api.MetricsService/GetMetrics"
+	MetricsService_GetScalingHistory_FullMethodName = "/api.MetricsService/GetScalingHistory"
 )
 
 // MetricsServiceClient is the client API for MetricsService service.
@@ -43,6 +44,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MetricsServiceClient interface {
 	GetMetrics(ctx context.Context, in *ScaledObjectRef, opts ...grpc.CallOption) (*v1beta1.ExternalMetricValueList, error)
+	GetScalingHistory(ctx context.Context, in *ScalingHistoryRequest, opts ...grpc.CallOption) (*ScalingHistoryResponse, error)
 }
 
 type metricsServiceClient struct {
@@ -63,11 +65,22 @@ func (c *metricsServiceClient) GetMetrics(ctx context.Context, in *ScaledObjectR
 	return out, nil
 }
 
+func (c *metricsServiceClient) GetScalingHistory(ctx context.Context, in *ScalingHistoryRequest, opts ...grpc.CallOption) (*ScalingHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScalingHistoryResponse)
+	err := c.cc.Invoke(ctx, MetricsService_GetScalingHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MetricsServiceServer is the server API for MetricsService service.
 // All implementations must embed UnimplementedMetricsServiceServer
 // for forward compatibility.
 type MetricsServiceServer interface {
 	GetMetrics(context.Context, *ScaledObjectRef) (*v1beta1.ExternalMetricValueList, error)
+	GetScalingHistory(context.Context, *ScalingHistoryRequest) (*ScalingHistoryResponse, error)
 	mustEmbedUnimplementedMetricsServiceServer()
 }
 
@@ -81,6 +94,9 @@ type UnimplementedMetricsServiceServer struct{}
 func (UnimplementedMetricsServiceServer) GetMetrics(context.Context, *ScaledObjectRef) (*v1beta1.ExternalMetricValueList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
 }
+func (UnimplementedMetricsServiceServer) GetScalingHistory(context.Context, *ScalingHistoryRequest) (*ScalingHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScalingHistory not implemented")
+}
 func (UnimplementedMetricsServiceServer) mustEmbedUnimplementedMetricsServiceServer() {}
 func (UnimplementedMetricsServiceServer) testEmbeddedByValue()                        {}
 
@@ -120,6 +136,24 @@ func _MetricsService_GetMetrics_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MetricsService_GetScalingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScalingHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetricsServiceServer).GetScalingHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetricsService_GetScalingHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetricsServiceServer).GetScalingHistory(ctx, req.(*ScalingHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MetricsService_ServiceDesc is the grpc.ServiceDesc for MetricsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -131,6 +165,10 @@ var MetricsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMetrics",
 			Handler:    _MetricsService_GetMetrics_Handler,
 		},
+		{
+			MethodName: "GetScalingHistory",
+			Handler:    _MetricsService_GetScalingHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "metrics.proto",
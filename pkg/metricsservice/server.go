@@ -20,10 +20,12 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"k8s.io/metrics/pkg/apis/external_metrics/v1beta1"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -64,6 +66,32 @@ func (s *GrpcServer) GetMetrics(ctx context.Context, in *api.ScaledObjectRef) (*
 	return v1beta1ExtMetrics, nil
 }
 
+// GetScalingHistory returns the latest scale transitions of the specified ScaledObject or ScaledJob, from the oldest to the latest
+func (s *GrpcServer) GetScalingHistory(_ context.Context, in *api.ScalingHistoryRequest) (*api.ScalingHistoryResponse, error) {
+	if in.GetKind() == "" || in.GetName() == "" || in.GetNamespace() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "kind, name and namespace must be specified, request: %+v", in)
+	}
+
+	resp := &api.ScalingHistoryResponse{}
+	for _, transition := range (*s.scalerHandler).GetScalingHistory(in.GetKind(), in.GetNamespace(), in.GetName()) {
+		resp.Transitions = append(resp.Transitions, &api.ScalingTransition{
+			Timestamp:      timestamppb.New(transition.Time),
+			FromReplicas:   transition.FromReplicas,
+			ToReplicas:     transition.ToReplicas,
+			Reason:         transition.Reason,
+			Message:        transition.Message,
+			ActiveTriggers: transition.ActiveTriggers,
+			MetricValues:   transition.MetricValues,
+			Paused:         transition.Paused,
+			Fallback:       transition.Fallback,
+		})
+	}
+
+	log.V(1).WithValues("kind", in.GetKind(), "name", in.GetName(), "namespace", in.GetNamespace(), "transitions", len(resp.Transitions)).Info("Providing scaling history")
+
+	return resp, nil
+}
+
 // GetRawMetricsStream opens the gRPC stream for sending metrics
 func (s *GrpcServer) GetRawMetricsStream(request *api.RawMetricsRequest, stream grpc.ServerStreamingServer[api.RawMetricsResponse]) error {
 	logger := log.WithName("GetRawMetricsStream").WithValues("subscriber", request.GetSubscriber())
@@ -88,6 +116,7 @@ func (s *GrpcServer) GetRawMetricsStream(request *api.RawMetricsRequest, stream
 				metrics = append(metrics, &api.RawMetric{
 					Value:     v.Value.AsApproximateFloat64(),
 					Timestamp: timestamppb.New(v.Timestamp.Time),
+					Age:       durationpb.New(time.Since(rm.CollectedAt)),
 					Metadata: &api.ScaledObjectRef{
 						Name:       rm.Meta.ScaledObjectName,
 						Namespace:  rm.Meta.Namespace,
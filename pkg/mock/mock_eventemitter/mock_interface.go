@@ -59,15 +59,20 @@ func (mr *MockEventHandlerMockRecorder) DeleteCloudEventSource(cloudEventSource
 }
 
 // Emit mocks base method.
-func (m *MockEventHandler) Emit(object runtime.Object, namespace, eventType string, cloudeventType v1alpha1.CloudEventType, reason, message string) {
+func (m *MockEventHandler) Emit(object runtime.Object, namespace, eventType string, cloudeventType v1alpha1.CloudEventType, reason, message string, scalingData ...eventdata.ScalingData) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Emit", object, namespace, eventType, cloudeventType, reason, message)
+	varargs := []any{object, namespace, eventType, cloudeventType, reason, message}
+	for _, a := range scalingData {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "Emit", varargs...)
 }
 
 // Emit indicates an expected call of Emit.
-func (mr *MockEventHandlerMockRecorder) Emit(object, namespace, eventType, cloudeventType, reason, message any) *gomock.Call {
+func (mr *MockEventHandlerMockRecorder) Emit(object, namespace, eventType, cloudeventType, reason, message any, scalingData ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Emit", reflect.TypeOf((*MockEventHandler)(nil).Emit), object, namespace, eventType, cloudeventType, reason, message)
+	varargs := append([]any{object, namespace, eventType, cloudeventType, reason, message}, scalingData...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Emit", reflect.TypeOf((*MockEventHandler)(nil).Emit), varargs...)
 }
 
 // HandleCloudEventSource mocks base method.
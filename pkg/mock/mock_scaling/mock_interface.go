@@ -16,6 +16,7 @@ import (
 	api "github.com/kedacore/keda/v2/pkg/metricsservice/api"
 	scaling "github.com/kedacore/keda/v2/pkg/scaling"
 	cache "github.com/kedacore/keda/v2/pkg/scaling/cache"
+	history "github.com/kedacore/keda/v2/pkg/scaling/history"
 	gomock "go.uber.org/mock/gomock"
 	external_metrics "k8s.io/metrics/pkg/apis/external_metrics"
 )
@@ -116,6 +117,20 @@ func (mr *MockScaleHandlerMockRecorder) GetScalersCache(ctx, scalableObject any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScalersCache", reflect.TypeOf((*MockScaleHandler)(nil).GetScalersCache), ctx, scalableObject)
 }
 
+// GetScalingHistory mocks base method.
+func (m *MockScaleHandler) GetScalingHistory(kind, namespace, name string) []history.Transition {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScalingHistory", kind, namespace, name)
+	ret0, _ := ret[0].([]history.Transition)
+	return ret0
+}
+
+// GetScalingHistory indicates an expected call of GetScalingHistory.
+func (mr *MockScaleHandlerMockRecorder) GetScalingHistory(kind, namespace, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScalingHistory", reflect.TypeOf((*MockScaleHandler)(nil).GetScalingHistory), kind, namespace, name)
+}
+
 // HandleScalableObject mocks base method.
 func (m *MockScaleHandler) HandleScalableObject(ctx context.Context, scalableObject any) error {
 	m.ctrl.T.Helper()
@@ -2,10 +2,12 @@ package scalers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
@@ -19,6 +21,17 @@ import (
 	kedautil "github.com/kedacore/keda/v2/pkg/util"
 )
 
+// sqsQueueAttributes shares the attributes of a queue across the triggers using batchFetch. SQS doesn't provide an API
+// returning the attributes of several queues at once, so the triggers targeting the same queue share one request
+var sqsQueueAttributes = newBatchListingCache[string]()
+
+// sqsBatchFetchAttributeNames are the attributes fetched with batchFetch, all the triggers can compute their queue length from them
+var sqsBatchFetchAttributeNames = []types.QueueAttributeName{
+	types.QueueAttributeNameApproximateNumberOfMessages,
+	types.QueueAttributeNameApproximateNumberOfMessagesNotVisible,
+	types.QueueAttributeNameApproximateNumberOfMessagesDelayed,
+}
+
 type awsSqsQueueScaler struct {
 	metricType       v2.MetricTargetType
 	metadata         *awsSqsQueueMetadata
@@ -40,6 +53,13 @@ type awsSqsQueueMetadata struct {
 	awsSqsQueueMetricNames      []types.QueueAttributeName
 
 	IdentityOwner string `keda:"name=identityOwner, order=triggerMetadata, optional"`
+
+	BatchFetch       bool `keda:"name=batchFetch, order=triggerMetadata, optional"`
+	batchFetchMaxAge time.Duration
+
+	// ClaimVisibilityTimeout is the visibility timeout in seconds of the messages claimed for the Jobs of a ScaledJob,
+	// the default visibility timeout of the queue is used if not set
+	ClaimVisibilityTimeout int32 `keda:"name=claimVisibilityTimeout, order=triggerMetadata, optional"`
 }
 
 // NewAwsSqsQueueScaler creates a new awsSqsQueueScaler
@@ -71,6 +91,8 @@ func NewAwsSqsQueueScaler(ctx context.Context, config *scalersconfig.ScalerConfi
 
 type SqsWrapperClient interface {
 	GetQueueAttributes(ctx context.Context, params *sqs.GetQueueAttributesInput, optFns ...func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error)
+	ReceiveMessage(ctx context.Context, params *sqs.ReceiveMessageInput, optFns ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error)
+	ChangeMessageVisibility(ctx context.Context, params *sqs.ChangeMessageVisibilityInput, optFns ...func(*sqs.Options)) (*sqs.ChangeMessageVisibilityOutput, error)
 }
 
 type sqsWrapperClient struct {
@@ -81,6 +103,14 @@ func (w sqsWrapperClient) GetQueueAttributes(ctx context.Context, params *sqs.Ge
 	return w.sqsClient.GetQueueAttributes(ctx, params, optFns...)
 }
 
+func (w sqsWrapperClient) ReceiveMessage(ctx context.Context, params *sqs.ReceiveMessageInput, optFns ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error) {
+	return w.sqsClient.ReceiveMessage(ctx, params, optFns...)
+}
+
+func (w sqsWrapperClient) ChangeMessageVisibility(ctx context.Context, params *sqs.ChangeMessageVisibilityInput, optFns ...func(*sqs.Options)) (*sqs.ChangeMessageVisibilityOutput, error) {
+	return w.sqsClient.ChangeMessageVisibility(ctx, params, optFns...)
+}
+
 func parseAwsSqsQueueMetadata(config *scalersconfig.ScalerConfig) (*awsSqsQueueMetadata, error) {
 	meta := &awsSqsQueueMetadata{}
 
@@ -93,6 +123,9 @@ func parseAwsSqsQueueMetadata(config *scalersconfig.ScalerConfig) (*awsSqsQueueM
 	if meta.ScaleOnInFlight {
 		meta.awsSqsQueueMetricNames = append(meta.awsSqsQueueMetricNames, types.QueueAttributeNameApproximateNumberOfMessagesNotVisible)
 	}
+	if meta.ClaimVisibilityTimeout < 0 {
+		return nil, fmt.Errorf("claimVisibilityTimeout must be greater than or equal to 0")
+	}
 	if meta.ScaleOnDelayed {
 		meta.awsSqsQueueMetricNames = append(meta.awsSqsQueueMetricNames, types.QueueAttributeNameApproximateNumberOfMessagesDelayed)
 	}
@@ -119,6 +152,7 @@ func parseAwsSqsQueueMetadata(config *scalersconfig.ScalerConfig) (*awsSqsQueueM
 	meta.awsAuthorization = auth
 
 	meta.triggerIndex = config.TriggerIndex
+	meta.batchFetchMaxAge = getBatchFetchMaxAge(config)
 
 	return meta, nil
 }
@@ -167,6 +201,10 @@ func (s *awsSqsQueueScaler) GetMetricsAndActivity(ctx context.Context, metricNam
 
 // Get SQS Queue Length
 func (s *awsSqsQueueScaler) getAwsSqsQueueLength(ctx context.Context) (int64, error) {
+	if s.metadata.BatchFetch {
+		return s.getAwsSqsQueueLengthFromSharedAttributes(ctx)
+	}
+
 	input := &sqs.GetQueueAttributesInput{
 		AttributeNames: s.metadata.awsSqsQueueMetricNames,
 		QueueUrl:       aws.String(s.metadata.QueueURL),
@@ -180,6 +218,30 @@ func (s *awsSqsQueueScaler) getAwsSqsQueueLength(ctx context.Context) (int64, er
 	return s.processQueueLengthFromSqsQueueAttributesOutput(output)
 }
 
+// getAwsSqsQueueLengthFromSharedAttributes computes the queue length from the attributes shared with the other
+// triggers targeting the same queue with the same credentials, the attributes are fetched at most once per polling interval
+func (s *awsSqsQueueScaler) getAwsSqsQueueLengthFromSharedAttributes(ctx context.Context) (int64, error) {
+	auth := s.metadata.awsAuthorization
+	key := batchListingKey(s.metadata.QueueURL, s.metadata.AwsRegion, s.metadata.AwsEndpoint,
+		auth.AwsRoleArn, auth.AwsAccessKeyID, auth.AwsSecretAccessKey, auth.AwsSessionToken,
+		strconv.FormatBool(auth.PodIdentityOwner), strconv.FormatBool(auth.UsingPodIdentity))
+	attributes, err := sqsQueueAttributes.get(ctx, key, s.metadata.batchFetchMaxAge, func(ctx context.Context) (map[string]string, error) {
+		output, err := s.sqsWrapperClient.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+			AttributeNames: sqsBatchFetchAttributeNames,
+			QueueUrl:       aws.String(s.metadata.QueueURL),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return output.Attributes, nil
+	})
+	if err != nil {
+		return -1, err
+	}
+
+	return s.processQueueLengthFromSqsQueueAttributesOutput(&sqs.GetQueueAttributesOutput{Attributes: attributes})
+}
+
 func (s *awsSqsQueueScaler) processQueueLengthFromSqsQueueAttributesOutput(output *sqs.GetQueueAttributesOutput) (int64, error) {
 	var approximateNumberOfMessages int64
 
@@ -199,3 +261,57 @@ func (s *awsSqsQueueScaler) processQueueLengthFromSqsQueueAttributesOutput(outpu
 
 	return approximateNumberOfMessages, nil
 }
+
+// sqsMaxReceivedMessages is the maximum number of messages returned by a ReceiveMessage request
+const sqsMaxReceivedMessages = 10
+
+// ClaimMessages receives up to count messages, they stay invisible to the other consumers during the visibility timeout
+// and must be deleted with their receipt handle, which is the handle of the claimed message
+func (s *awsSqsQueueScaler) ClaimMessages(ctx context.Context, count int) ([]ClaimedMessage, error) {
+	var messages []ClaimedMessage
+	for len(messages) < count {
+		input := &sqs.ReceiveMessageInput{
+			QueueUrl:            aws.String(s.metadata.QueueURL),
+			MaxNumberOfMessages: int32(min(count-len(messages), sqsMaxReceivedMessages)),
+		}
+		if s.metadata.ClaimVisibilityTimeout > 0 {
+			input.VisibilityTimeout = s.metadata.ClaimVisibilityTimeout
+		}
+		output, err := s.sqsWrapperClient.ReceiveMessage(ctx, input)
+		if err != nil {
+			return messages, err
+		}
+		if len(output.Messages) == 0 {
+			break
+		}
+		for _, message := range output.Messages {
+			messages = append(messages, ClaimedMessage{
+				ID:      aws.ToString(message.MessageId),
+				Handle:  aws.ToString(message.ReceiptHandle),
+				Payload: []byte(aws.ToString(message.Body)),
+			})
+		}
+	}
+	return messages, nil
+}
+
+// AckMessages doesn't delete the messages, the Jobs delete them with their receipt handle once processed
+func (s *awsSqsQueueScaler) AckMessages(context.Context, []ClaimedMessage) error {
+	return nil
+}
+
+// ReleaseMessages makes the messages visible again to the other consumers
+func (s *awsSqsQueueScaler) ReleaseMessages(ctx context.Context, messages []ClaimedMessage) error {
+	var errs []error
+	for _, message := range messages {
+		_, err := s.sqsWrapperClient.ChangeMessageVisibility(ctx, &sqs.ChangeMessageVisibilityInput{
+			QueueUrl:          aws.String(s.metadata.QueueURL),
+			ReceiptHandle:     aws.String(message.Handle),
+			VisibilityTimeout: 0,
+		})
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
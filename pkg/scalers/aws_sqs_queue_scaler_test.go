@@ -3,9 +3,12 @@ package scalers
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strconv"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
 	"github.com/go-logr/logr"
@@ -83,6 +86,17 @@ func (m *mockSqs) GetQueueAttributes(_ context.Context, input *sqs.GetQueueAttri
 	}, nil
 }
 
+func (m *mockSqs) ReceiveMessage(_ context.Context, input *sqs.ReceiveMessageInput, _ ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error) {
+	if *input.QueueUrl == testAWSSQSErrorQueueURL {
+		return nil, errors.New("some error")
+	}
+	return &sqs.ReceiveMessageOutput{}, nil
+}
+
+func (m *mockSqs) ChangeMessageVisibility(context.Context, *sqs.ChangeMessageVisibilityInput, ...func(*sqs.Options)) (*sqs.ChangeMessageVisibilityOutput, error) {
+	return &sqs.ChangeMessageVisibilityOutput{}, nil
+}
+
 var testAWSSQSMetadata = []parseAWSSQSMetadataTestData{
 	{map[string]string{},
 		testAWSSQSAuthentication,
@@ -607,3 +621,99 @@ func TestQueueURLFromEnvResolution(t *testing.T) {
 		})
 	}
 }
+
+type countingMockSqs struct {
+	mockSqs
+	calls int
+}
+
+func (m *countingMockSqs) GetQueueAttributes(ctx context.Context, input *sqs.GetQueueAttributesInput, optFns ...func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error) {
+	m.calls++
+	return m.mockSqs.GetQueueAttributes(ctx, input, optFns...)
+}
+
+func TestAWSSQSScalerBatchFetch(t *testing.T) {
+	client := &countingMockSqs{}
+	getQueueLength := func(scaleOnInFlight string) int64 {
+		meta, err := parseAwsSqsQueueMetadata(&scalersconfig.ScalerConfig{
+			TriggerMetadata: map[string]string{
+				"queueURL":        "https://sqs.eu-west-1.amazonaws.com/account_id/BatchQ",
+				"awsRegion":       "eu-west-1",
+				"scaleOnInFlight": scaleOnInFlight,
+				"batchFetch":      "true",
+			},
+			AuthParams:                    testAWSSQSAuthentication,
+			ScalableObjectPollingInterval: time.Minute,
+		})
+		assert.NoError(t, err)
+		scaler := awsSqsQueueScaler{"", meta, client, logr.Discard()}
+		value, _, err := scaler.GetMetricsAndActivity(context.Background(), "MetricName")
+		assert.NoError(t, err)
+		return value[0].Value.Value()
+	}
+
+	assert.EqualValues(t, testAWSSQSApproximateNumberOfMessagesVisible+testAWSSQSApproximateNumberOfMessagesNotVisible, getQueueLength("true"))
+	assert.EqualValues(t, testAWSSQSApproximateNumberOfMessagesVisible, getQueueLength("false"))
+	// both triggers are served by a single request
+	assert.Equal(t, 1, client.calls)
+}
+
+type claimingMockSqs struct {
+	mockSqs
+	messages []types.Message
+	inputs   []*sqs.ReceiveMessageInput
+	released []string
+}
+
+func (m *claimingMockSqs) ReceiveMessage(_ context.Context, input *sqs.ReceiveMessageInput, _ ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error) {
+	m.inputs = append(m.inputs, input)
+	count := min(int(input.MaxNumberOfMessages), len(m.messages))
+	received := m.messages[:count]
+	m.messages = m.messages[count:]
+	return &sqs.ReceiveMessageOutput{Messages: received}, nil
+}
+
+func (m *claimingMockSqs) ChangeMessageVisibility(_ context.Context, input *sqs.ChangeMessageVisibilityInput, _ ...func(*sqs.Options)) (*sqs.ChangeMessageVisibilityOutput, error) {
+	m.released = append(m.released, aws.ToString(input.ReceiptHandle))
+	return &sqs.ChangeMessageVisibilityOutput{}, nil
+}
+
+func TestAWSSQSScalerClaimMessages(t *testing.T) {
+	client := &claimingMockSqs{}
+	for i := 0; i < 15; i++ {
+		client.messages = append(client.messages, types.Message{
+			MessageId:     aws.String(fmt.Sprintf("id-%d", i)),
+			ReceiptHandle: aws.String(fmt.Sprintf("handle-%d", i)),
+			Body:          aws.String(fmt.Sprintf(`{"order":%d}`, i)),
+		})
+	}
+	meta, err := parseAwsSqsQueueMetadata(&scalersconfig.ScalerConfig{
+		TriggerMetadata: map[string]string{
+			"queueURL":               testAWSSQSProperQueueURL,
+			"awsRegion":              "eu-west-1",
+			"claimVisibilityTimeout": "300",
+		},
+		AuthParams: testAWSSQSAuthentication,
+	})
+	assert.NoError(t, err)
+	scaler := awsSqsQueueScaler{"", meta, client, logr.Discard()}
+
+	messages, err := scaler.ClaimMessages(context.Background(), 12)
+	assert.NoError(t, err)
+	assert.Len(t, messages, 12)
+	assert.Equal(t, ClaimedMessage{ID: "id-11", Handle: "handle-11", Payload: []byte(`{"order":11}`)}, messages[11])
+	assert.Len(t, client.inputs, 2)
+	assert.Equal(t, int32(10), client.inputs[0].MaxNumberOfMessages)
+	assert.Equal(t, int32(2), client.inputs[1].MaxNumberOfMessages)
+	assert.Equal(t, int32(300), client.inputs[0].VisibilityTimeout)
+
+	// only 3 messages are left in the queue
+	messages, err = scaler.ClaimMessages(context.Background(), 5)
+	assert.NoError(t, err)
+	assert.Len(t, messages, 3)
+
+	// the released messages are visible again right away
+	assert.NoError(t, scaler.AckMessages(context.Background(), messages[:1]))
+	assert.NoError(t, scaler.ReleaseMessages(context.Background(), messages[1:]))
+	assert.Equal(t, []string{"handle-13", "handle-14"}, client.released)
+}
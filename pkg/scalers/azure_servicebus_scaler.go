@@ -46,6 +46,10 @@ const (
 	defaultTargetMessageCount                   = 5
 )
 
+// serviceBusEntityListings shares the active message counts of the queues of a namespace, or of the subscriptions
+// of a topic, across the triggers using batchFetch
+var serviceBusEntityListings = newBatchListingCache[int64]()
+
 type azureServiceBusScaler struct {
 	ctx         context.Context
 	metricType  v2.MetricTargetType
@@ -68,8 +72,10 @@ type azureServiceBusMetadata struct {
 	UseRegex                bool `keda:"name=useRegex,          order=triggerMetadata, optional"`
 	EntityNameRegex         *regexp.Regexp
 	Operation               string `keda:"name=operation,          order=triggerMetadata, enum=sum;max;avg, default=sum"`
+	BatchFetch              bool   `keda:"name=batchFetch,          order=triggerMetadata, optional"`
 	triggerIndex            int
 	timeout                 time.Duration
+	batchFetchMaxAge        time.Duration
 }
 
 func (a *azureServiceBusMetadata) Validate() error {
@@ -153,6 +159,7 @@ func parseAzureServiceBusMetadata(config *scalersconfig.ScalerConfig) (*azureSer
 
 	meta.triggerIndex = config.TriggerIndex
 	meta.timeout = config.GlobalHTTPTimeout
+	meta.batchFetchMaxAge = getBatchFetchMaxAge(config)
 
 	switch config.PodIdentity.Provider {
 	case "", kedav1alpha1.PodIdentityProviderNone:
@@ -235,6 +242,9 @@ func (s *azureServiceBusScaler) getAzureServiceBusLength(ctx context.Context) (i
 	if err != nil {
 		return -1, err
 	}
+	if s.metadata.BatchFetch {
+		return s.getLengthFromListing(ctx, adminClient)
+	}
 	// switch case for queue vs topic here
 	switch s.metadata.EntityType {
 	case queue:
@@ -276,6 +286,81 @@ func (s *azureServiceBusScaler) getServiceBusAdminClient() (*admin.Client, error
 	return client, err
 }
 
+// getLengthFromListing returns the length of the queue or subscription from the listing of the namespace queues
+// or of the topic subscriptions, shared with the other triggers targeting the same namespace with the same credentials.
+// The entities are listed at most once per polling interval.
+func (s *azureServiceBusScaler) getLengthFromListing(ctx context.Context, adminClient *admin.Client) (int64, error) {
+	key := batchListingKey(s.metadata.Connection, s.metadata.FullyQualifiedNamespace, string(s.podIdentity.Provider), s.podIdentity.GetIdentityID(), s.metadata.TopicName)
+
+	var entityName string
+	var listEntities func(ctx context.Context) (map[string]int64, error)
+	switch s.metadata.EntityType {
+	case queue:
+		entityName = s.metadata.QueueName
+		listEntities = func(ctx context.Context) (map[string]int64, error) {
+			return listQueueMessageCounts(ctx, adminClient)
+		}
+	case subscription:
+		entityName = s.metadata.SubscriptionName
+		listEntities = func(ctx context.Context) (map[string]int64, error) {
+			return listSubscriptionMessageCounts(ctx, adminClient, s.metadata.TopicName)
+		}
+	default:
+		return -1, fmt.Errorf("no entity type")
+	}
+
+	messageCounts, err := serviceBusEntityListings.get(ctx, key, s.metadata.batchFetchMaxAge, listEntities)
+	if err != nil {
+		return -1, err
+	}
+
+	if !s.metadata.UseRegex {
+		messageCount, found := messageCounts[entityName]
+		if !found {
+			return -1, fmt.Errorf("service bus entity %s doesn't exist", entityName)
+		}
+		return messageCount, nil
+	}
+
+	matchingMessageCounts := make([]int64, 0)
+	for name, messageCount := range messageCounts {
+		if s.metadata.EntityNameRegex.FindString(name) == name {
+			matchingMessageCounts = append(matchingMessageCounts, messageCount)
+		}
+	}
+	return performOperation(matchingMessageCounts, s.metadata.Operation), nil
+}
+
+func listQueueMessageCounts(ctx context.Context, adminClient *admin.Client) (map[string]int64, error) {
+	messageCounts := map[string]int64{}
+	queuePager := adminClient.NewListQueuesRuntimePropertiesPager(nil)
+	for queuePager.More() {
+		page, err := queuePager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, queue := range page.QueueRuntimeProperties {
+			messageCounts[queue.QueueName] = int64(queue.ActiveMessageCount)
+		}
+	}
+	return messageCounts, nil
+}
+
+func listSubscriptionMessageCounts(ctx context.Context, adminClient *admin.Client, topicName string) (map[string]int64, error) {
+	messageCounts := map[string]int64{}
+	subscriptionPager := adminClient.NewListSubscriptionsRuntimePropertiesPager(topicName, nil)
+	for subscriptionPager.More() {
+		page, err := subscriptionPager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, subscription := range page.SubscriptionRuntimeProperties {
+			messageCounts[subscription.SubscriptionName] = int64(subscription.ActiveMessageCount)
+		}
+	}
+	return messageCounts, nil
+}
+
 func getQueueLength(ctx context.Context, adminClient *admin.Client, meta *azureServiceBusMetadata) (int64, error) {
 	if !meta.UseRegex {
 		queueEntity, err := adminClient.GetQueueRuntimeProperties(ctx, meta.QueueName, &admin.GetQueueRuntimePropertiesOptions{})
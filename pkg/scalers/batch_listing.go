@@ -0,0 +1,116 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/kedacore/keda/v2/pkg/scalers/scalersconfig"
+)
+
+const (
+	// defaultBatchFetchMaxAge is used when the polling interval of the trigger is unknown
+	defaultBatchFetchMaxAge = 30 * time.Second
+	// batchListingRetention is how long an unused listing is kept before being evicted
+	batchListingRetention = 10 * time.Minute
+)
+
+type batchListing[T any] struct {
+	items     map[string]T
+	fetchedAt time.Time
+}
+
+// batchListingCache shares the listing of the entities of a broker (queues of a RabbitMQ vhost, queues of a Service Bus namespace...)
+// across all the scalers targeting that broker, so a single request to the management API serves all the triggers.
+// The listings are identified by a key which must include the credentials, so scalers using different
+// credentials never share a listing.
+type batchListingCache[T any] struct {
+	group    singleflight.Group
+	listings map[string]batchListing[T]
+	lock     *sync.Mutex
+}
+
+func newBatchListingCache[T any]() *batchListingCache[T] {
+	return &batchListingCache[T]{
+		listings: map[string]batchListing[T]{},
+		lock:     &sync.Mutex{},
+	}
+}
+
+// get returns the listing identified by key if it has been fetched less than maxAge ago,
+// otherwise it fetches it. Concurrent fetches of the same listing are collapsed into one.
+func (c *batchListingCache[T]) get(ctx context.Context, key string, maxAge time.Duration, fetch func(ctx context.Context) (map[string]T, error)) (map[string]T, error) {
+	now := time.Now()
+	c.lock.Lock()
+	listing, found := c.listings[key]
+	c.lock.Unlock()
+	if found && now.Sub(listing.fetchedAt) < maxAge {
+		return listing.items, nil
+	}
+
+	value, err, _ := c.group.Do(key, func() (interface{}, error) {
+		items, err := fetch(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.store(key, batchListing[T]{items: items, fetchedAt: time.Now()})
+		return items, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.(map[string]T), nil
+}
+
+func (c *batchListingCache[T]) store(key string, listing batchListing[T]) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for k, v := range c.listings {
+		if listing.fetchedAt.Sub(v.fetchedAt) > batchListingRetention {
+			delete(c.listings, k)
+		}
+	}
+	c.listings[key] = listing
+}
+
+// batchListingKey hashes the parts identifying a listing, so the credentials they contain aren't kept in plain text
+func batchListingKey(parts ...string) string {
+	hash := sha256.New()
+	for _, part := range parts {
+		hash.Write([]byte(part))
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// getBatchFetchMaxAge returns the maximum age of the listings used by the trigger, which is its polling interval,
+// so that the broker is listed at most once per polling interval whatever the number of triggers targeting it
+func getBatchFetchMaxAge(config *scalersconfig.ScalerConfig) time.Duration {
+	if config.TriggerPollingInterval > 0 {
+		return config.TriggerPollingInterval
+	}
+	if config.ScalableObjectPollingInterval > 0 {
+		return config.ScalableObjectPollingInterval
+	}
+	return defaultBatchFetchMaxAge
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kedacore/keda/v2/pkg/scalers/scalersconfig"
+)
+
+func TestBatchListingCache(t *testing.T) {
+	cache := newBatchListingCache[int]()
+	fetches := 0
+	fetch := func(context.Context) (map[string]int, error) {
+		fetches++
+		if fetches == 1 {
+			return nil, errors.New("error")
+		}
+		return map[string]int{"queue": fetches}, nil
+	}
+
+	// errors aren't cached
+	_, err := cache.get(context.Background(), "broker", time.Minute, fetch)
+	assert.Error(t, err)
+
+	listing, err := cache.get(context.Background(), "broker", time.Minute, fetch)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, listing["queue"])
+	listing, err = cache.get(context.Background(), "broker", time.Minute, fetch)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, listing["queue"])
+
+	// a trigger with a shorter polling interval needs a fresher listing
+	listing, err = cache.get(context.Background(), "broker", 0, fetch)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, listing["queue"])
+
+	// other brokers have their own listing
+	listing, err = cache.get(context.Background(), "other-broker", time.Minute, fetch)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, listing["queue"])
+}
+
+func TestGetBatchFetchMaxAge(t *testing.T) {
+	assert.Equal(t, defaultBatchFetchMaxAge, getBatchFetchMaxAge(&scalersconfig.ScalerConfig{}))
+	assert.Equal(t, time.Minute, getBatchFetchMaxAge(&scalersconfig.ScalerConfig{ScalableObjectPollingInterval: time.Minute}))
+	assert.Equal(t, 10*time.Second, getBatchFetchMaxAge(&scalersconfig.ScalerConfig{ScalableObjectPollingInterval: time.Minute, TriggerPollingInterval: 10 * time.Second}))
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/kedacore/keda/v2/pkg/scalers/scalersconfig"
 	kedautil "github.com/kedacore/keda/v2/pkg/util"
+	"github.com/kedacore/keda/v2/pkg/util/cronschedule"
 )
 
 const (
@@ -36,12 +37,11 @@ type cronMetadata struct {
 }
 
 func (m *cronMetadata) Validate() error {
-	parser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
-	if _, err := parser.Parse(m.Start); err != nil {
+	if _, err := cronschedule.Parse(m.Start); err != nil {
 		return fmt.Errorf("error parsing start schedule: %w", err)
 	}
 
-	if _, err := parser.Parse(m.End); err != nil {
+	if _, err := cronschedule.Parse(m.End); err != nil {
 		return fmt.Errorf("error parsing end schedule: %w", err)
 	}
 
@@ -67,10 +67,8 @@ func NewCronScaler(config *scalersconfig.ScalerConfig) (Scaler, error) {
 		return nil, fmt.Errorf("error parsing cron metadata: %w", err)
 	}
 
-	parser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
-
-	startSchedule, _ := parser.Parse(meta.Start)
-	endSchedule, _ := parser.Parse(meta.End)
+	startSchedule, _ := cronschedule.Parse(meta.Start)
+	endSchedule, _ := cronschedule.Parse(meta.End)
 
 	return &cronScaler{
 		metricType:    metricType,
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"regexp"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -307,7 +308,9 @@ func (s *datadogScaler) getQueryResult(ctx context.Context) (float64, error) {
 			rateLimitReset := r.Header.Get("X-Ratelimit-Reset")
 			rateLimitPeriod := r.Header.Get("X-Ratelimit-Period")
 
-			return -1, fmt.Errorf("your Datadog account reached the %s queries per %s seconds rate limit, next limit reset will happen in %s seconds", rateLimit, rateLimitPeriod, rateLimitReset)
+			retryAfter, _ := strconv.Atoi(rateLimitReset)
+			return -1, NewRateLimitError(time.Duration(retryAfter)*time.Second,
+				fmt.Errorf("your Datadog account reached the %s queries per %s seconds rate limit, next limit reset will happen in %s seconds", rateLimit, rateLimitPeriod, rateLimitReset))
 		}
 
 		if r.StatusCode != 200 {
@@ -507,7 +507,8 @@ func (s *githubRunnerScaler) getGithubRequest(ctx context.Context, url string, m
 
 			if githubAPIRemaining == 0 {
 				resetTime, _ := strconv.ParseInt(r.Header.Get("X-RateLimit-Reset"), 10, 64)
-				return []byte{}, r.StatusCode, fmt.Errorf("GitHub API rate limit exceeded, resets at %s", time.Unix(resetTime, 0))
+				return []byte{}, r.StatusCode, NewRateLimitError(time.Until(time.Unix(resetTime, 0)),
+					fmt.Errorf("GitHub API rate limit exceeded, resets at %s", time.Unix(resetTime, 0)))
 			}
 		}
 
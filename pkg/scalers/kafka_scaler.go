@@ -652,6 +652,29 @@ func getKafkaClientConfig(ctx context.Context, metadata kafkaMetadata) (*sarama.
 	config := sarama.NewConfig()
 	config.Version = metadata.version
 
+	if err := setKafkaAuthConfig(ctx, config, metadata); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// NewKafkaAuthConfig returns a sarama config with the TLS and SASL authentication of the kafka scaler,
+// parsed from the trigger metadata and the auth params of config
+func NewKafkaAuthConfig(ctx context.Context, config *scalersconfig.ScalerConfig) (*sarama.Config, error) {
+	meta := kafkaMetadata{}
+	if err := parseKafkaAuthParams(config, &meta); err != nil {
+		return nil, err
+	}
+
+	saramaConfig := sarama.NewConfig()
+	if err := setKafkaAuthConfig(ctx, saramaConfig, meta); err != nil {
+		return nil, err
+	}
+	return saramaConfig, nil
+}
+
+// setKafkaAuthConfig sets the TLS and SASL authentication of metadata to config
+func setKafkaAuthConfig(ctx context.Context, config *sarama.Config, metadata kafkaMetadata) error {
 	if metadata.saslType != KafkaSASLTypeNone && metadata.saslType != KafkaSASLTypeGSSAPI {
 		config.Net.SASL.Enable = true
 		config.Net.SASL.User = metadata.username
@@ -662,7 +685,7 @@ func getKafkaClientConfig(ctx context.Context, metadata kafkaMetadata) (*sarama.
 		config.Net.TLS.Enable = true
 		tlsConfig, err := kedautil.NewTLSConfigWithPassword(metadata.cert, metadata.key, metadata.keyPassword, metadata.ca, metadata.unsafeSsl)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		config.Net.TLS.Config = tlsConfig
 	}
@@ -689,12 +712,12 @@ func getKafkaClientConfig(ctx context.Context, metadata kafkaMetadata) (*sarama.
 		case KafkaSASLOAuthTokenProviderAWSMSKIAM:
 			awsAuth, err := awsutils.GetAwsConfig(ctx, metadata.awsAuthorization)
 			if err != nil {
-				return nil, fmt.Errorf("error getting AWS config: %w", err)
+				return fmt.Errorf("error getting AWS config: %w", err)
 			}
 
 			config.Net.SASL.TokenProvider = kafka.OAuthMSKTokenProvider(awsAuth)
 		default:
-			return nil, fmt.Errorf("err SASL OAuth token provider %s given but not supported", metadata.tokenProvider)
+			return fmt.Errorf("err SASL OAuth token provider %s given but not supported", metadata.tokenProvider)
 		}
 	}
 
@@ -722,7 +745,7 @@ func getKafkaClientConfig(ctx context.Context, metadata kafkaMetadata) (*sarama.
 		}
 	}
 
-	return config, nil
+	return nil
 }
 
 func (s *kafkaScaler) getTopicPartitions() (map[string][]int32, error) {
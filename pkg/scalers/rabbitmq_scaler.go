@@ -3,6 +3,7 @@ package scalers
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -10,6 +11,7 @@ import (
 	"path"
 	"regexp"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -26,6 +28,9 @@ import (
 
 var rabbitMQAnonymizePattern *regexp.Regexp
 
+// rabbitMQQueueListings shares the queues of a vhost across the triggers using batchFetch
+var rabbitMQQueueListings = newBatchListingCache[queueInfo]()
+
 func init() {
 	rabbitMQAnonymizePattern = regexp.MustCompile(`([^ \/:]+):([^\/:]+)\@`)
 }
@@ -46,6 +51,8 @@ const (
 	rabbitRootVhostPath                    = "/%2F"
 	rmqTLSEnable                           = "enable"
 	rmqTLSDisable                          = "disable"
+	// rabbitMQBatchPageSize is the maximum page size allowed by the management API
+	rabbitMQBatchPageSize = 500
 )
 
 const (
@@ -70,6 +77,8 @@ type rabbitMQScaler struct {
 	httpClient *http.Client
 	azureOAuth *azure.ADWorkloadIdentityTokenProvider
 	logger     logr.Logger
+
+	batchFetchMaxAge time.Duration
 }
 
 type rabbitMQMetadata struct {
@@ -97,6 +106,8 @@ type rabbitMQMetadata struct {
 	ExcludeUnacknowledged bool `keda:"name=excludeUnacknowledged, order=triggerMetadata, optional"`
 	// specify the page size if useRegex is enabled
 	PageSize int64 `keda:"name=pageSize,                          order=triggerMetadata, default=100"`
+	// specify if the queue should be read from a listing of the vhost shared with the other triggers
+	BatchFetch bool `keda:"name=batchFetch,                       order=triggerMetadata, optional"`
 	// specify the operation to apply in case of multiples queues
 	Operation string `keda:"name=operation,                       order=triggerMetadata, default=sum"`
 	// custom http timeout for a specific trigger
@@ -172,6 +183,14 @@ func (r *rabbitMQMetadata) Validate() error {
 		return fmt.Errorf("configure excludeUnacknowledged=true only with HTTP protocol")
 	}
 
+	if r.BatchFetch && r.Protocol != httpProtocol {
+		return fmt.Errorf("configure batchFetch=true only with HTTP protocol")
+	}
+
+	if r.BatchFetch && r.UseRegex {
+		return fmt.Errorf("batchFetch and useRegex can't be used together")
+	}
+
 	if err := r.validateTrigger(); err != nil {
 		return err
 	}
@@ -286,6 +305,7 @@ func NewRabbitMQScaler(config *scalersconfig.ScalerConfig) (Scaler, error) {
 	}
 
 	s.metadata = meta
+	s.batchFetchMaxAge = getBatchFetchMaxAge(config)
 
 	timeout := config.GlobalHTTPTimeout
 	if s.metadata.Timeout != 0 {
@@ -424,12 +444,19 @@ func (s *rabbitMQScaler) getQueueStatus(ctx context.Context) (int64, float64, fl
 	return int64(items.Messages), 0, 0, nil
 }
 
-func getJSON(ctx context.Context, s *rabbitMQScaler, url string) (queueInfo, error) {
-	var result queueInfo
+// get sends an authenticated GET request to the management API
+func (s *rabbitMQScaler) get(ctx context.Context, url string) (*http.Response, error) {
+	return s.send(ctx, http.MethodGet, url, nil)
+}
 
-	request, err := http.NewRequest("GET", url, nil)
+// send sends an authenticated request to the management API
+func (s *rabbitMQScaler) send(ctx context.Context, method, url string, body io.Reader) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
-		return result, err
+		return nil, err
+	}
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
 	}
 
 	if s.metadata.WorkloadIdentityResource != "" {
@@ -439,13 +466,19 @@ func getJSON(ctx context.Context, s *rabbitMQScaler, url string) (queueInfo, err
 
 		err = s.azureOAuth.Refresh()
 		if err != nil {
-			return result, err
+			return nil, err
 		}
 
 		request.Header.Set("Authorization", "Bearer "+s.azureOAuth.OAuthToken())
 	}
 
-	r, err := s.httpClient.Do(request)
+	return s.httpClient.Do(request)
+}
+
+func getJSON(ctx context.Context, s *rabbitMQScaler, url string) (queueInfo, error) {
+	var result queueInfo
+
+	r, err := s.get(ctx, url)
 	if err != nil {
 		return result, err
 	}
@@ -492,11 +525,12 @@ func getVhostAndPathFromURL(rawPath, vhostName string) (resolvedVhostPath, resol
 	return
 }
 
-func (s *rabbitMQScaler) getQueueInfoViaHTTP(ctx context.Context) (*queueInfo, error) {
+// getManagementURL returns the URL of the management API and the vhost path of the queue
+func (s *rabbitMQScaler) getManagementURL() (string, string, error) {
 	parsedURL, err := url.Parse(s.metadata.Host)
 
 	if err != nil {
-		return nil, err
+		return "", "", err
 	}
 
 	path := parsedURL.RawPath
@@ -511,11 +545,24 @@ func (s *rabbitMQScaler) getQueueInfoViaHTTP(ctx context.Context) (*queueInfo, e
 		parsedURL.User = url.UserPassword(s.metadata.Username, s.metadata.Password)
 	}
 
+	return parsedURL.String(), vhost, nil
+}
+
+func (s *rabbitMQScaler) getQueueInfoViaHTTP(ctx context.Context) (*queueInfo, error) {
+	managementURL, vhost, err := s.getManagementURL()
+	if err != nil {
+		return nil, err
+	}
+
+	if s.metadata.BatchFetch {
+		return s.getQueueInfoFromListing(ctx, managementURL, vhost)
+	}
+
 	var getQueueInfoManagementURI string
 	if s.metadata.UseRegex {
-		getQueueInfoManagementURI = fmt.Sprintf("%s/api/queues%s?page=1&use_regex=true&pagination=false&name=%s&page_size=%d", parsedURL.String(), vhost, url.QueryEscape(s.metadata.QueueName), s.metadata.PageSize)
+		getQueueInfoManagementURI = fmt.Sprintf("%s/api/queues%s?page=1&use_regex=true&pagination=false&name=%s&page_size=%d", managementURL, vhost, url.QueryEscape(s.metadata.QueueName), s.metadata.PageSize)
 	} else {
-		getQueueInfoManagementURI = fmt.Sprintf("%s/api/queues%s/%s", parsedURL.String(), vhost, url.QueryEscape(s.metadata.QueueName))
+		getQueueInfoManagementURI = fmt.Sprintf("%s/api/queues%s/%s", managementURL, vhost, url.QueryEscape(s.metadata.QueueName))
 	}
 
 	var info queueInfo
@@ -528,6 +575,117 @@ func (s *rabbitMQScaler) getQueueInfoViaHTTP(ctx context.Context) (*queueInfo, e
 	return &info, nil
 }
 
+// getQueueInfoFromListing returns the queue from the listing of the vhost shared with the other triggers targeting
+// the same vhost with the same credentials, the vhost is listed at most once per polling interval
+func (s *rabbitMQScaler) getQueueInfoFromListing(ctx context.Context, managementURL, vhost string) (*queueInfo, error) {
+	key := batchListingKey(managementURL, vhost, s.metadata.WorkloadIdentityResource, s.metadata.workloadIdentityClientID, s.metadata.Cert)
+	queues, err := rabbitMQQueueListings.get(ctx, key, s.batchFetchMaxAge, func(ctx context.Context) (map[string]queueInfo, error) {
+		return s.listQueues(ctx, fmt.Sprintf("%s/api/queues%s", managementURL, vhost))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	info, found := queues[s.metadata.QueueName]
+	if !found {
+		return nil, fmt.Errorf("queue %s not found in vhost %s", s.metadata.QueueName, vhost)
+	}
+	return &info, nil
+}
+
+// listQueues returns all the queues of the vhost, browsing all the pages of the listing
+func (s *rabbitMQScaler) listQueues(ctx context.Context, listURL string) (map[string]queueInfo, error) {
+	queues := map[string]queueInfo{}
+	for page := 1; ; page++ {
+		pageURL := fmt.Sprintf("%s?page=%d&page_size=%d", listURL, page, rabbitMQBatchPageSize)
+		r, err := s.get(ctx, pageURL)
+		if err != nil {
+			return nil, err
+		}
+
+		var result regexQueueInfo
+		if r.StatusCode == http.StatusOK {
+			err = json.NewDecoder(r.Body).Decode(&result)
+		} else {
+			body, _ := io.ReadAll(r.Body)
+			err = fmt.Errorf("error requesting RabbitMQ API status: %s, response: %s, from: %s", r.Status, body, pageURL)
+		}
+		r.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, queue := range result.Queues {
+			queues[queue.Name] = queue
+		}
+		if page >= result.TotalPages {
+			return queues, nil
+		}
+	}
+}
+
+// rabbitMQMessage is a message returned by the management API
+// ClaimMessages consumes up to count messages from the queue with AMQP, the messages are left unacknowledged
+// until they are acked or released, their handle being the delivery tag on the channel of the scaler.
+// The management API acknowledges the messages it returns, so they can't be claimed with HTTP
+func (s *rabbitMQScaler) ClaimMessages(_ context.Context, count int) ([]ClaimedMessage, error) {
+	if s.metadata.UseRegex {
+		return nil, fmt.Errorf("messages can't be claimed from the queues matching a regex")
+	}
+	if s.metadata.Protocol == httpProtocol {
+		return nil, fmt.Errorf("messages can't be claimed with the %s protocol, use %s", httpProtocol, amqpProtocol)
+	}
+
+	var messages []ClaimedMessage
+	for len(messages) < count {
+		delivery, ok, err := s.channel.Get(s.metadata.QueueName, false)
+		if err != nil {
+			return messages, err
+		}
+		if !ok {
+			break
+		}
+		messages = append(messages, ClaimedMessage{
+			ID:      delivery.MessageId,
+			Handle:  strconv.FormatUint(delivery.DeliveryTag, 10),
+			Payload: delivery.Body,
+		})
+	}
+	return messages, nil
+}
+
+// AckMessages acknowledges the claimed messages
+func (s *rabbitMQScaler) AckMessages(_ context.Context, messages []ClaimedMessage) error {
+	var errs []error
+	for _, message := range messages {
+		tag, err := strconv.ParseUint(message.Handle, 10, 64)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error parsing delivery tag %q: %w", message.Handle, err))
+			continue
+		}
+		if err := s.channel.Ack(tag, false); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ReleaseMessages requeues the claimed messages
+func (s *rabbitMQScaler) ReleaseMessages(_ context.Context, messages []ClaimedMessage) error {
+	var errs []error
+	for _, message := range messages {
+		tag, err := strconv.ParseUint(message.Handle, 10, 64)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error parsing delivery tag %q: %w", message.Handle, err))
+			continue
+		}
+		if err := s.channel.Nack(tag, false, true); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
 // GetMetricSpecForScaling returns the MetricSpec for the Horizontal Pod Autoscaler
 func (s *rabbitMQScaler) GetMetricSpecForScaling(context.Context) []v2.MetricSpec {
 	externalMetric := &v2.ExternalMetricSource{
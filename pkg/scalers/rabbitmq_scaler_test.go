@@ -830,3 +830,76 @@ func TestConnectionName(t *testing.T) {
 		t.Error("Expected connection name to be keda-test-namespace-test-name but got", connectionName)
 	}
 }
+
+func TestRabbitMQBatchFetch(t *testing.T) {
+	requests := 0
+	var apiStub = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "/api/queues/%2F?page=1&page_size=500", r.RequestURI)
+		_, err := w.Write([]byte(`{"items":[{"name":"queue-a","messages":4},{"name":"queue-b","messages":7}],"page_count":1}`))
+		assert.NoError(t, err)
+	}))
+	defer apiStub.Close()
+
+	getMessages := func(queueName string) (float64, error) {
+		s, err := NewRabbitMQScaler(&scalersconfig.ScalerConfig{
+			TriggerMetadata: map[string]string{
+				"queueName":  queueName,
+				"host":       apiStub.URL,
+				"protocol":   "http",
+				"batchFetch": "true",
+			},
+			AuthParams:                    map[string]string{},
+			GlobalHTTPTimeout:             time.Second,
+			ScalableObjectPollingInterval: time.Minute,
+		})
+		if err != nil {
+			return 0, err
+		}
+		metrics, _, err := s.GetMetricsAndActivity(context.TODO(), "Metric")
+		if err != nil {
+			return 0, err
+		}
+		return metrics[0].Value.AsApproximateFloat64(), nil
+	}
+
+	messages, err := getMessages("queue-a")
+	assert.NoError(t, err)
+	assert.Equal(t, float64(4), messages)
+	messages, err = getMessages("queue-b")
+	assert.NoError(t, err)
+	assert.Equal(t, float64(7), messages)
+	_, err = getMessages("queue-c")
+	assert.ErrorContains(t, err, "queue queue-c not found")
+	// all the triggers are served by a single listing of the vhost
+	assert.Equal(t, 1, requests)
+
+	_, err = parseRabbitMQMetadata(&scalersconfig.ScalerConfig{
+		TriggerMetadata: map[string]string{"queueName": "queue-a", "host": "amqp://localhost:5672", "batchFetch": "true"},
+		AuthParams:      map[string]string{},
+	})
+	assert.ErrorContains(t, err, "configure batchFetch=true only with HTTP protocol")
+	_, err = parseRabbitMQMetadata(&scalersconfig.ScalerConfig{
+		TriggerMetadata: map[string]string{"queueName": "queue-.*", "host": apiStub.URL, "batchFetch": "true", "useRegex": "true"},
+		AuthParams:      map[string]string{},
+	})
+	assert.ErrorContains(t, err, "batchFetch and useRegex can't be used together")
+}
+
+func TestRabbitMQClaimMessagesViaHTTP(t *testing.T) {
+	var apiStub = httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.RequestURI)
+	}))
+	defer apiStub.Close()
+
+	s, err := NewRabbitMQScaler(&scalersconfig.ScalerConfig{
+		TriggerMetadata:   map[string]string{"queueName": "orders", "host": apiStub.URL, "protocol": "http"},
+		AuthParams:        map[string]string{},
+		GlobalHTTPTimeout: time.Second,
+	})
+	assert.NoError(t, err)
+
+	// the management API acknowledges the messages it returns, they would be lost if the Job isn't created
+	_, err = s.(MessageClaimer).ClaimMessages(context.TODO(), 2)
+	assert.ErrorContains(t, err, "messages can't be claimed with the http protocol")
+}
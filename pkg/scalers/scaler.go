@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	metrics "github.com/rcrowley/go-metrics"
@@ -62,6 +63,27 @@ type PushScaler interface {
 	Run(ctx context.Context, active chan<- bool)
 }
 
+// MessageClaimer is implemented by the scalers able to claim messages from the queue they monitor,
+// so each Job created by a ScaledJob can be bound to a single message
+type MessageClaimer interface {
+	// ClaimMessages claims up to count messages, the claimed messages aren't delivered to other consumers
+	// but stay in the queue until they are acknowledged or released
+	ClaimMessages(ctx context.Context, count int) ([]ClaimedMessage, error)
+	// AckMessages removes the claimed messages from the queue once they are bound to a Job
+	AckMessages(ctx context.Context, messages []ClaimedMessage) error
+	// ReleaseMessages gives the claimed messages back to the queue when no Job could be bound to them
+	ReleaseMessages(ctx context.Context, messages []ClaimedMessage) error
+}
+
+// ClaimedMessage is a message claimed by a MessageClaimer
+type ClaimedMessage struct {
+	// ID identifies the message in the queue, it's empty if the queue doesn't identify its messages
+	ID string
+	// Handle is used to acknowledge or delete the message, e.g. the SQS receipt handle, it's empty if not needed
+	Handle  string
+	Payload []byte
+}
+
 var (
 	// ErrScalerUnsupportedUtilizationMetricType is returned when v2.UtilizationMetricType
 	// is provided as the metric target type for scaler.
@@ -71,6 +93,26 @@ var (
 	ErrScalerConfigMissingField = errors.New("missing required field in scaler config")
 )
 
+// RateLimitError is returned by scalers when the metric source has rate limited the request,
+// RetryAfter is the time to wait before querying it again, zero if unknown
+type RateLimitError struct {
+	RetryAfter time.Duration
+	Err        error
+}
+
+// NewRateLimitError returns a RateLimitError wrapping err
+func NewRateLimitError(retryAfter time.Duration, err error) *RateLimitError {
+	return &RateLimitError{RetryAfter: retryAfter, Err: err}
+}
+
+func (e *RateLimitError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RateLimitError) Unwrap() error {
+	return e.Err
+}
+
 // GetFromAuthOrMeta helps to get a field from Auth or Meta sections
 func GetFromAuthOrMeta(config *scalersconfig.ScalerConfig, field string) (string, error) {
 	var result string
@@ -50,6 +50,21 @@ type ScalerConfig struct {
 	// Any requests for metrics in between are read from the cache
 	TriggerUseCachedMetrics bool
 
+	// The maximum age of a cached metric, older metrics are treated as errors. Zero means no limit
+	TriggerMaxMetricAge time.Duration
+
+	// The interval at which the trigger is polled by the scale loop. Zero means the polling interval of the scalable object
+	TriggerPollingInterval time.Duration
+
+	// The adaptive polling settings of the trigger, nil if the trigger is polled at a fixed interval
+	TriggerAdaptivePolling *kedav1alpha1.AdaptivePolling
+
+	// The activation hysteresis of the trigger, nil if the activity of the trigger is the one of the scaler
+	TriggerActivationHysteresis *kedav1alpha1.ActivationHysteresis
+
+	// The polling interval of the ScaledObject/ScaledJob that owns this scaler
+	ScalableObjectPollingInterval time.Duration
+
 	// TriggerMetadata
 	TriggerMetadata map[string]string
 
@@ -0,0 +1,108 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package activation
+
+import (
+	"sync"
+	"time"
+)
+
+type objectState struct {
+	// metrics is the activity of the metrics with an activation hysteresis
+	metrics map[string]bool
+	// stabilized is whether the activity of the object has been stabilized yet
+	stabilized bool
+	active     bool
+	// pendingSince is the time the activity of the triggers started to differ from active, zero if it doesn't
+	pendingSince time.Time
+}
+
+// Store keeps the activity of the scalable objects across the iterations of the scale loop,
+// to apply the activation hysteresis of their triggers and their activation stabilization window
+type Store struct {
+	objects map[string]*objectState
+	lock    *sync.Mutex
+}
+
+// NewStore returns an empty Store
+func NewStore() *Store {
+	return &Store{
+		objects: map[string]*objectState{},
+		lock:    &sync.Mutex{},
+	}
+}
+
+func (s *Store) getObjectState(scalableObjectIdentifier string) *objectState {
+	state, found := s.objects[scalableObjectIdentifier]
+	if !found {
+		state = &objectState{metrics: map[string]bool{}}
+		s.objects[scalableObjectIdentifier] = state
+	}
+	return state
+}
+
+// IsMetricActive returns whether the metric is active given its value: an inactive metric is activated
+// once the value is above activateThreshold and an active one is deactivated once it's at or below deactivateThreshold
+func (s *Store) IsMetricActive(scalableObjectIdentifier, metricName string, value, activateThreshold, deactivateThreshold float64) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	state := s.getObjectState(scalableObjectIdentifier)
+	active := value > activateThreshold
+	if state.metrics[metricName] {
+		active = value > deactivateThreshold
+	}
+	state.metrics[metricName] = active
+	return active
+}
+
+// Stabilize returns the activity of the scalable object given the activity of its triggers, which has to
+// stay the same for the window before the object is activated or deactivated. The first time, the object
+// starts from initialActive, the activity it had before the scale loop started, if known.
+func (s *Store) Stabilize(scalableObjectIdentifier string, isActive bool, initialActive *bool, window time.Duration, now time.Time) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	state := s.getObjectState(scalableObjectIdentifier)
+	if !state.stabilized {
+		state.stabilized = true
+		state.active = isActive
+		if initialActive != nil {
+			state.active = *initialActive
+		}
+	}
+
+	if isActive == state.active {
+		state.pendingSince = time.Time{}
+		return state.active
+	}
+	if state.pendingSince.IsZero() {
+		state.pendingSince = now
+	}
+	if !now.Before(state.pendingSince.Add(window)) {
+		state.active = isActive
+		state.pendingSince = time.Time{}
+	}
+	return state.active
+}
+
+// Delete removes the activity of the scalable object
+func (s *Store) Delete(scalableObjectIdentifier string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.objects, scalableObjectIdentifier)
+}
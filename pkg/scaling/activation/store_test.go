@@ -0,0 +1,74 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package activation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testIdentifier = "scaledobject.default.test"
+	testMetricName = "s0-metric"
+)
+
+func TestStoreIsMetricActive(t *testing.T) {
+	store := NewStore()
+
+	// the metric is activated above the high watermark only
+	assert.False(t, store.IsMetricActive(testIdentifier, testMetricName, 7, 10, 5))
+	assert.True(t, store.IsMetricActive(testIdentifier, testMetricName, 11, 10, 5))
+
+	// and deactivated at or below the low watermark only
+	assert.True(t, store.IsMetricActive(testIdentifier, testMetricName, 7, 10, 5))
+	assert.False(t, store.IsMetricActive(testIdentifier, testMetricName, 5, 10, 5))
+	assert.False(t, store.IsMetricActive(testIdentifier, testMetricName, 7, 10, 5))
+
+	// the metrics are tracked separately
+	assert.True(t, store.IsMetricActive(testIdentifier, "s1-metric", 11, 10, 5))
+	assert.False(t, store.IsMetricActive(testIdentifier, testMetricName, 7, 10, 5))
+
+	store.Delete(testIdentifier)
+	assert.False(t, store.IsMetricActive(testIdentifier, "s1-metric", 7, 10, 5))
+}
+
+func TestStoreStabilize(t *testing.T) {
+	store := NewStore()
+	window := time.Minute
+	start := time.Now()
+
+	// the object starts from its previous activity
+	inactive := false
+	assert.False(t, store.Stabilize(testIdentifier, true, &inactive, window, start))
+
+	// a flap doesn't activate the object
+	assert.False(t, store.Stabilize(testIdentifier, false, nil, window, start.Add(10*time.Second)))
+	assert.False(t, store.Stabilize(testIdentifier, true, nil, window, start.Add(20*time.Second)))
+	assert.False(t, store.Stabilize(testIdentifier, true, nil, window, start.Add(70*time.Second)))
+
+	// the object is activated once the triggers are active for the window
+	assert.True(t, store.Stabilize(testIdentifier, true, nil, window, start.Add(80*time.Second)))
+
+	// and deactivated once they are inactive for the window
+	assert.True(t, store.Stabilize(testIdentifier, false, nil, window, start.Add(90*time.Second)))
+	assert.False(t, store.Stabilize(testIdentifier, false, nil, window, start.Add(150*time.Second)))
+
+	// without previous activity, the object starts from the activity of the triggers
+	assert.True(t, store.Stabilize("scaledobject.default.other", true, nil, window, start))
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metricscache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/metrics/pkg/apis/external_metrics"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// +kubebuilder:rbac:groups="",namespace=keda,resources=configmaps,verbs=get;create;update
+
+var log = logf.Log.WithName("metricscache")
+
+// persistedRecord is the serialized form of MetricsRecord, errors are stored as their message
+type persistedRecord struct {
+	IsActive    bool                                   `json:"isActive"`
+	Metric      []external_metrics.ExternalMetricValue `json:"metric,omitempty"`
+	ScalerError string                                 `json:"scalerError,omitempty"`
+	Timestamp   time.Time                              `json:"timestamp"`
+}
+
+// ConfigMapStorage keeps the metrics records in memory and periodically persists them to a ConfigMap,
+// so they survive operator restarts and leader changes. Each ScaledObject is stored under its own key.
+// The records are loaded and persisted only when the storage is running (see Start), which happens on
+// the leader only. As the whole ConfigMap is limited to 1MiB, it's suited for a moderate number of ScaledObjects.
+type ConfigMapStorage struct {
+	*MemoryStorage
+
+	reader       client.Reader
+	writer       client.Writer
+	namespace    string
+	name         string
+	syncInterval time.Duration
+
+	dirty     bool
+	dirtyLock *sync.Mutex
+}
+
+// NewConfigMapStorage returns a ConfigMapStorage persisting the records to the ConfigMap namespace/name every syncInterval
+func NewConfigMapStorage(reader client.Reader, writer client.Writer, namespace, name string, syncInterval time.Duration) *ConfigMapStorage {
+	return &ConfigMapStorage{
+		MemoryStorage: NewMemoryStorage(),
+		reader:        reader,
+		writer:        writer,
+		namespace:     namespace,
+		name:          name,
+		syncInterval:  syncInterval,
+		dirtyLock:     &sync.Mutex{},
+	}
+}
+
+func (cs *ConfigMapStorage) StoreRecords(scaledObjectIdentifier string, metricsRecords map[string]MetricsRecord) {
+	cs.MemoryStorage.StoreRecords(scaledObjectIdentifier, metricsRecords)
+	cs.setDirty(true)
+}
+
+func (cs *ConfigMapStorage) Delete(scaledObjectIdentifier string) {
+	cs.MemoryStorage.Delete(scaledObjectIdentifier)
+	cs.setDirty(true)
+}
+
+// Start implements manager.Runnable, it restores the persisted records and then
+// persists the records every syncInterval, until the context is canceled
+func (cs *ConfigMapStorage) Start(ctx context.Context) error {
+	logger := log.WithValues("configMap.Namespace", cs.namespace, "configMap.Name", cs.name)
+
+	if err := cs.load(ctx); err != nil {
+		// not being able to restore the records isn't fatal, the cache will be filled by the scale loops
+		logger.Error(err, "error restoring metrics records from ConfigMap")
+	}
+
+	ticker := time.NewTicker(cs.syncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := cs.persist(ctx); err != nil {
+				logger.Error(err, "error persisting metrics records to ConfigMap")
+			}
+		case <-ctx.Done():
+			// persist the latest records before shutting down, the context is already canceled
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			if err := cs.persist(shutdownCtx); err != nil {
+				logger.Error(err, "error persisting metrics records to ConfigMap")
+			}
+			cancel()
+			return nil
+		}
+	}
+}
+
+// load restores the records persisted in the ConfigMap, records already present in memory are kept
+func (cs *ConfigMapStorage) load(ctx context.Context) error {
+	configMap := &corev1.ConfigMap{}
+	err := cs.reader.Get(ctx, types.NamespacedName{Namespace: cs.namespace, Name: cs.name}, configMap)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for scaledObjectIdentifier, data := range configMap.Data {
+		persistedRecords := map[string]persistedRecord{}
+		if err := json.Unmarshal([]byte(data), &persistedRecords); err != nil {
+			log.Error(err, "error decoding persisted metrics records, skipping", "scaledObject", scaledObjectIdentifier)
+			continue
+		}
+		metricsRecords := make(map[string]MetricsRecord, len(persistedRecords))
+		for metricName, record := range persistedRecords {
+			metricsRecord := MetricsRecord{
+				IsActive:  record.IsActive,
+				Metric:    record.Metric,
+				Timestamp: record.Timestamp,
+			}
+			if record.ScalerError != "" {
+				metricsRecord.ScalerError = errors.New(record.ScalerError)
+			}
+			metricsRecords[metricName] = metricsRecord
+		}
+		cs.storeIfAbsent(scaledObjectIdentifier, metricsRecords)
+	}
+	log.V(1).Info("Restored metrics records from ConfigMap", "count", len(configMap.Data))
+	return nil
+}
+
+// persist writes all the records to the ConfigMap if they have changed since the last time
+func (cs *ConfigMapStorage) persist(ctx context.Context) error {
+	if !cs.setDirty(false) {
+		return nil
+	}
+
+	data := map[string]string{}
+	for scaledObjectIdentifier, metricsRecords := range cs.snapshot() {
+		persistedRecords := make(map[string]persistedRecord, len(metricsRecords))
+		for metricName, record := range metricsRecords {
+			persisted := persistedRecord{
+				IsActive:  record.IsActive,
+				Metric:    record.Metric,
+				Timestamp: record.Timestamp,
+			}
+			if record.ScalerError != nil {
+				persisted.ScalerError = record.ScalerError.Error()
+			}
+			persistedRecords[metricName] = persisted
+		}
+		encoded, err := json.Marshal(persistedRecords)
+		if err != nil {
+			cs.setDirty(true)
+			return err
+		}
+		data[scaledObjectIdentifier] = string(encoded)
+	}
+
+	err := cs.write(ctx, data)
+	if err != nil {
+		// try again on the next sync
+		cs.setDirty(true)
+	}
+	return err
+}
+
+func (cs *ConfigMapStorage) write(ctx context.Context, data map[string]string) error {
+	configMap := &corev1.ConfigMap{}
+	err := cs.reader.Get(ctx, types.NamespacedName{Namespace: cs.namespace, Name: cs.name}, configMap)
+	if apierrors.IsNotFound(err) {
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: cs.namespace,
+				Name:      cs.name,
+				Labels: map[string]string{
+					"app.kubernetes.io/managed-by": "keda-operator",
+				},
+			},
+			Data: data,
+		}
+		return cs.writer.Create(ctx, configMap)
+	}
+	if err != nil {
+		return err
+	}
+	configMap.Data = data
+	return cs.writer.Update(ctx, configMap)
+}
+
+// setDirty sets the dirty flag and returns its previous value
+func (cs *ConfigMapStorage) setDirty(dirty bool) bool {
+	cs.dirtyLock.Lock()
+	defer cs.dirtyLock.Unlock()
+	previous := cs.dirty
+	cs.dirty = dirty
+	return previous
+}
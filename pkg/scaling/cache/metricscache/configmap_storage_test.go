@@ -0,0 +1,97 @@
+package metricscache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/metrics/pkg/apis/external_metrics"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestConfigMapStoragePersistAndLoad(t *testing.T) {
+	ctx := context.Background()
+	client := fake.NewClientBuilder().Build()
+
+	collectedAt := time.Now().Add(-time.Minute).Truncate(time.Second)
+	storage := NewConfigMapStorage(client, client, "keda", "metrics-cache", 0)
+	storage.StoreRecords("scaledobject.default.app", map[string]MetricsRecord{
+		"s0-metric": {
+			IsActive:  true,
+			Timestamp: collectedAt,
+			Metric: []external_metrics.ExternalMetricValue{
+				{MetricName: "s0-metric", Value: *resource.NewQuantity(42, resource.DecimalSI), Timestamp: metav1.Now()},
+			},
+		},
+		"s1-metric": {
+			ScalerError: errors.New("connection refused"),
+		},
+	})
+	assert.NoError(t, storage.persist(ctx))
+
+	configMap := &corev1.ConfigMap{}
+	assert.NoError(t, client.Get(ctx, types.NamespacedName{Namespace: "keda", Name: "metrics-cache"}, configMap))
+	assert.Contains(t, configMap.Data, "scaledobject.default.app")
+
+	// a new storage, e.g. after a restart, restores the records
+	restored := NewConfigMapStorage(client, client, "keda", "metrics-cache", 0)
+	assert.NoError(t, restored.load(ctx))
+
+	record, found := restored.ReadRecord("scaledobject.default.app", "s0-metric")
+	assert.True(t, found)
+	assert.True(t, record.IsActive)
+	assert.Len(t, record.Metric, 1)
+	assert.Equal(t, int64(42), record.Metric[0].Value.Value())
+	assert.NoError(t, record.ScalerError)
+	assert.True(t, collectedAt.Equal(record.Timestamp))
+
+	record, found = restored.ReadRecord("scaledobject.default.app", "s1-metric")
+	assert.True(t, found)
+	assert.EqualError(t, record.ScalerError, "connection refused")
+
+	// deletion is persisted as well
+	restored.Delete("scaledobject.default.app")
+	assert.NoError(t, restored.persist(ctx))
+	assert.NoError(t, client.Get(ctx, types.NamespacedName{Namespace: "keda", Name: "metrics-cache"}, configMap))
+	assert.NotContains(t, configMap.Data, "scaledobject.default.app")
+}
+
+func TestConfigMapStorageLoadKeepsFresherRecords(t *testing.T) {
+	ctx := context.Background()
+	client := fake.NewClientBuilder().WithObjects(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "keda", Name: "metrics-cache"},
+		Data: map[string]string{
+			"scaledobject.default.app": `{"s0-metric":{"isActive":false}}`,
+			"scaledobject.default.bad": `not-json`,
+		},
+	}).Build()
+
+	storage := NewConfigMapStorage(client, client, "keda", "metrics-cache", 0)
+	storage.StoreRecords("scaledobject.default.app", map[string]MetricsRecord{"s0-metric": {IsActive: true}})
+	assert.NoError(t, storage.load(ctx))
+
+	record, found := storage.ReadRecord("scaledobject.default.app", "s0-metric")
+	assert.True(t, found)
+	assert.True(t, record.IsActive)
+
+	_, found = storage.ReadRecord("scaledobject.default.bad", "s0-metric")
+	assert.False(t, found)
+}
+
+func TestConfigMapStorageSkipsPersistWhenUnchanged(t *testing.T) {
+	ctx := context.Background()
+	client := fake.NewClientBuilder().Build()
+
+	storage := NewConfigMapStorage(client, client, "keda", "metrics-cache", 0)
+	assert.NoError(t, storage.persist(ctx))
+
+	configMap := &corev1.ConfigMap{}
+	err := client.Get(ctx, types.NamespacedName{Namespace: "keda", Name: "metrics-cache"}, configMap)
+	assert.Error(t, err)
+}
@@ -1,7 +1,7 @@
 package metricscache
 
 import (
-	"sync"
+	"time"
 
 	"k8s.io/metrics/pkg/apis/external_metrics"
 )
@@ -10,36 +10,40 @@ type MetricsRecord struct {
 	IsActive    bool
 	Metric      []external_metrics.ExternalMetricValue
 	ScalerError error
+	// Timestamp is the time the record was collected from the scaler
+	Timestamp time.Time
 }
 
+// Age returns how long ago the record was collected from the scaler
+func (mr MetricsRecord) Age() time.Duration {
+	return time.Since(mr.Timestamp)
+}
+
+// MetricsCache keeps the last metrics records of ScaledObjects in the underlying Storage
 type MetricsCache struct {
-	metricRecords map[string]map[string]MetricsRecord
-	lock          *sync.RWMutex
+	storage Storage
 }
 
+// NewMetricsCache returns a MetricsCache backed by an in-memory Storage
 func NewMetricsCache() MetricsCache {
+	return NewMetricsCacheWithStorage(NewMemoryStorage())
+}
+
+// NewMetricsCacheWithStorage returns a MetricsCache backed by the given Storage
+func NewMetricsCacheWithStorage(storage Storage) MetricsCache {
 	return MetricsCache{
-		metricRecords: map[string]map[string]MetricsRecord{},
-		lock:          &sync.RWMutex{},
+		storage: storage,
 	}
 }
 
 func (mc *MetricsCache) ReadRecord(scaledObjectIdentifier, metricName string) (MetricsRecord, bool) {
-	mc.lock.RLock()
-	defer mc.lock.RUnlock()
-	record, ok := mc.metricRecords[scaledObjectIdentifier][metricName]
-
-	return record, ok
+	return mc.storage.ReadRecord(scaledObjectIdentifier, metricName)
 }
 
 func (mc *MetricsCache) StoreRecords(scaledObjectIdentifier string, metricsRecords map[string]MetricsRecord) {
-	mc.lock.Lock()
-	defer mc.lock.Unlock()
-	mc.metricRecords[scaledObjectIdentifier] = metricsRecords
+	mc.storage.StoreRecords(scaledObjectIdentifier, metricsRecords)
 }
 
 func (mc *MetricsCache) Delete(scaledObjectIdentifier string) {
-	mc.lock.Lock()
-	defer mc.lock.Unlock()
-	delete(mc.metricRecords, scaledObjectIdentifier)
+	mc.storage.Delete(scaledObjectIdentifier)
 }
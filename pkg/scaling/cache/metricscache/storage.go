@@ -0,0 +1,83 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metricscache
+
+import (
+	"sync"
+)
+
+// Storage is the backend used by MetricsCache to keep the metrics records,
+// records are grouped by ScaledObject identifier and then by metric name
+type Storage interface {
+	ReadRecord(scaledObjectIdentifier, metricName string) (MetricsRecord, bool)
+	StoreRecords(scaledObjectIdentifier string, metricsRecords map[string]MetricsRecord)
+	Delete(scaledObjectIdentifier string)
+}
+
+// MemoryStorage keeps the metrics records in memory, they are lost on operator restart
+type MemoryStorage struct {
+	metricRecords map[string]map[string]MetricsRecord
+	lock          *sync.RWMutex
+}
+
+func NewMemoryStorage() *MemoryStorage {
+	return &MemoryStorage{
+		metricRecords: map[string]map[string]MetricsRecord{},
+		lock:          &sync.RWMutex{},
+	}
+}
+
+func (ms *MemoryStorage) ReadRecord(scaledObjectIdentifier, metricName string) (MetricsRecord, bool) {
+	ms.lock.RLock()
+	defer ms.lock.RUnlock()
+	record, ok := ms.metricRecords[scaledObjectIdentifier][metricName]
+
+	return record, ok
+}
+
+func (ms *MemoryStorage) StoreRecords(scaledObjectIdentifier string, metricsRecords map[string]MetricsRecord) {
+	ms.lock.Lock()
+	defer ms.lock.Unlock()
+	ms.metricRecords[scaledObjectIdentifier] = metricsRecords
+}
+
+func (ms *MemoryStorage) Delete(scaledObjectIdentifier string) {
+	ms.lock.Lock()
+	defer ms.lock.Unlock()
+	delete(ms.metricRecords, scaledObjectIdentifier)
+}
+
+// snapshot returns a shallow copy of all the records
+func (ms *MemoryStorage) snapshot() map[string]map[string]MetricsRecord {
+	ms.lock.RLock()
+	defer ms.lock.RUnlock()
+	records := make(map[string]map[string]MetricsRecord, len(ms.metricRecords))
+	for identifier, metricsRecords := range ms.metricRecords {
+		records[identifier] = metricsRecords
+	}
+	return records
+}
+
+// storeIfAbsent stores the records of a ScaledObject only if there aren't any already,
+// it's used to not override fresher records with the ones restored from a persistent storage
+func (ms *MemoryStorage) storeIfAbsent(scaledObjectIdentifier string, metricsRecords map[string]MetricsRecord) {
+	ms.lock.Lock()
+	defer ms.lock.Unlock()
+	if _, ok := ms.metricRecords[scaledObjectIdentifier]; !ok {
+		ms.metricRecords[scaledObjectIdentifier] = metricsRecords
+	}
+}
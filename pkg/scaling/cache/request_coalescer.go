@@ -0,0 +1,338 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+	"golang.org/x/time/rate"
+	"k8s.io/metrics/pkg/apis/external_metrics"
+
+	"github.com/kedacore/keda/v2/pkg/scalers"
+	"github.com/kedacore/keda/v2/pkg/scalers/scalersconfig"
+)
+
+// DefaultEndpointLimitKey is the key of the limit applied to every endpoint without a specific limit
+const DefaultEndpointLimitKey = "*"
+
+// maxRateLimitWait is the longest a request waits for the rate limiter of its endpoint,
+// requests that would have to wait longer fail with a scalers.RateLimitError
+const maxRateLimitWait = time.Second
+
+// endpointMetadataKeys are the trigger metadata keys identifying the backend queried by the scalers,
+// in order of precedence. Each of them can be also provided through an environment variable (<key>FromEnv)
+var endpointMetadataKeys = []string{"serverAddress", "host", "url", "endpoint", "address", "apiURL", "bootstrapServers"}
+
+// objectScopedTriggerTypes are the triggers whose metrics depend on the ScaledObject or ScaledJob they belong to,
+// e.g. the pods counted in its namespace or the ScaledObjectRef sent to an external scaler, so their requests are
+// coalesced only for the same object
+var objectScopedTriggerTypes = []string{"kubernetes-workload", "external", "external-push"}
+
+// EndpointLimit is the token bucket configuration of an endpoint
+type EndpointLimit struct {
+	// QPS is the number of requests per second allowed to the endpoint
+	QPS float64
+	// Burst is the maximum number of requests allowed at once
+	Burst int
+}
+
+// RequestCoalescerConfig is the configuration of the RequestCoalescer
+type RequestCoalescerConfig struct {
+	// Coalesce collapses identical concurrent requests into a single request to the backend
+	Coalesce bool
+	// ResultTTL is the duration the result of a coalesced request is reused for identical requests, zero means results aren't reused
+	ResultTTL time.Duration
+	// EndpointLimits are the limits per endpoint, DefaultEndpointLimitKey sets the limit of the other endpoints
+	EndpointLimits map[string]EndpointLimit
+}
+
+type coalescedResult struct {
+	metrics  []external_metrics.ExternalMetricValue
+	isActive bool
+	latency  time.Duration
+}
+
+type cachedResult struct {
+	coalescedResult
+	expiresAt time.Time
+}
+
+// RequestCoalescer is shared by the ScalersCaches of all the ScaledObjects and ScaledJobs. It collapses
+// identical metric requests, i.e. same trigger type, metadata, authentication and metric name, issued by
+// different scalable objects into a single request, and limits the rate of requests sent to each backend endpoint.
+// A nil RequestCoalescer sends all the requests straight to the scalers.
+type RequestCoalescer struct {
+	config RequestCoalescerConfig
+	group  singleflight.Group
+
+	results     map[string]cachedResult
+	resultsLock *sync.Mutex
+
+	limiters     map[string]*rate.Limiter
+	limitersLock *sync.Mutex
+}
+
+// NewRequestCoalescer returns a RequestCoalescer with the given configuration
+func NewRequestCoalescer(config RequestCoalescerConfig) *RequestCoalescer {
+	return &RequestCoalescer{
+		config:       config,
+		results:      map[string]cachedResult{},
+		resultsLock:  &sync.Mutex{},
+		limiters:     map[string]*rate.Limiter{},
+		limitersLock: &sync.Mutex{},
+	}
+}
+
+// ParseEndpointLimits parses a comma separated list of limits formatted as `endpoint=qps[:burst]`,
+// the burst defaults to the QPS rounded up. Use DefaultEndpointLimitKey as endpoint to limit all the other endpoints.
+func ParseEndpointLimits(value string) (map[string]EndpointLimit, error) {
+	limits := map[string]EndpointLimit{}
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		endpoint, limit, found := strings.Cut(entry, "=")
+		endpoint = strings.TrimSpace(endpoint)
+		if !found || endpoint == "" {
+			return nil, fmt.Errorf("invalid endpoint limit %q, expected endpoint=qps[:burst]", entry)
+		}
+		qpsValue, burstValue, hasBurst := strings.Cut(limit, ":")
+		qps, err := strconv.ParseFloat(strings.TrimSpace(qpsValue), 64)
+		if err != nil || qps <= 0 {
+			return nil, fmt.Errorf("invalid qps %q for endpoint %s, it must be a number greater than 0", qpsValue, endpoint)
+		}
+		burst := int(qps + 0.999999)
+		if hasBurst {
+			burst, err = strconv.Atoi(strings.TrimSpace(burstValue))
+			if err != nil || burst <= 0 {
+				return nil, fmt.Errorf("invalid burst %q for endpoint %s, it must be an integer greater than 0", burstValue, endpoint)
+			}
+		}
+		limits[normalizeEndpoint(endpoint)] = EndpointLimit{QPS: qps, Burst: burst}
+	}
+	return limits, nil
+}
+
+// GetMetricsAndActivity returns the result of getMetricsAndActivity, sharing it with the identical requests in flight
+// and reusing it for ResultTTL. The request to the backend is subject to the limit of its endpoint.
+func (rc *RequestCoalescer) GetMetricsAndActivity(ctx context.Context, scalerConfig scalersconfig.ScalerConfig, metricName string,
+	getMetricsAndActivity func(ctx context.Context) ([]external_metrics.ExternalMetricValue, bool, time.Duration, error)) ([]external_metrics.ExternalMetricValue, bool, time.Duration, error) {
+	if rc == nil {
+		return getMetricsAndActivity(ctx)
+	}
+
+	limitedGetMetricsAndActivity := func() (coalescedResult, error) {
+		if err := rc.Wait(ctx, scalerConfig); err != nil {
+			return coalescedResult{latency: -1}, err
+		}
+		metrics, isActive, latency, err := getMetricsAndActivity(ctx)
+		return coalescedResult{metrics: metrics, isActive: isActive, latency: latency}, err
+	}
+
+	if !rc.config.Coalesce {
+		result, err := limitedGetMetricsAndActivity()
+		return result.metrics, result.isActive, result.latency, err
+	}
+
+	key := getRequestKey(scalerConfig, metricName)
+	if result, found := rc.getCachedResult(key, time.Now()); found {
+		return slices.Clone(result.metrics), result.isActive, -1, nil
+	}
+
+	value, err, shared := rc.group.Do(key, func() (interface{}, error) {
+		result, err := limitedGetMetricsAndActivity()
+		if err == nil {
+			rc.storeResult(key, result, time.Now())
+		}
+		return result, err
+	})
+	result := value.(coalescedResult)
+	if shared {
+		log.V(1).Info("Coalesced request to scaler", "type", scalerConfig.TriggerType, "metricName", metricName)
+		// the latency has been recorded by the request that actually hit the backend
+		result.latency = -1
+	}
+	return slices.Clone(result.metrics), result.isActive, result.latency, err
+}
+
+// Wait blocks until the endpoint queried by the scaler accepts one more request. If it can't be sent
+// within maxRateLimitWait, it returns a scalers.RateLimitError without waiting
+func (rc *RequestCoalescer) Wait(ctx context.Context, scalerConfig scalersconfig.ScalerConfig) error {
+	if rc == nil {
+		return nil
+	}
+	endpoint := getEndpoint(scalerConfig)
+	limiter := rc.getLimiter(endpoint)
+	if limiter == nil {
+		return nil
+	}
+
+	reservation := limiter.Reserve()
+	delay := reservation.Delay()
+	if delay > maxRateLimitWait {
+		reservation.Cancel()
+		return scalers.NewRateLimitError(delay, fmt.Errorf("rate limit of endpoint %s exceeded", endpoint))
+	}
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		reservation.Cancel()
+		return ctx.Err()
+	}
+}
+
+func (rc *RequestCoalescer) getLimiter(endpoint string) *rate.Limiter {
+	if endpoint == "" || len(rc.config.EndpointLimits) == 0 {
+		return nil
+	}
+
+	rc.limitersLock.Lock()
+	defer rc.limitersLock.Unlock()
+	if limiter, found := rc.limiters[endpoint]; found {
+		return limiter
+	}
+
+	limit, found := rc.config.EndpointLimits[endpoint]
+	if !found {
+		limit, found = rc.config.EndpointLimits[DefaultEndpointLimitKey]
+	}
+	var limiter *rate.Limiter
+	if found {
+		limiter = rate.NewLimiter(rate.Limit(limit.QPS), limit.Burst)
+	}
+	// endpoints without limit are stored as well, to not look them up again
+	rc.limiters[endpoint] = limiter
+	return limiter
+}
+
+func (rc *RequestCoalescer) getCachedResult(key string, now time.Time) (coalescedResult, bool) {
+	if rc.config.ResultTTL <= 0 {
+		return coalescedResult{}, false
+	}
+	rc.resultsLock.Lock()
+	defer rc.resultsLock.Unlock()
+	result, found := rc.results[key]
+	if !found || !now.Before(result.expiresAt) {
+		return coalescedResult{}, false
+	}
+	return result.coalescedResult, true
+}
+
+func (rc *RequestCoalescer) storeResult(key string, result coalescedResult, now time.Time) {
+	if rc.config.ResultTTL <= 0 {
+		return
+	}
+	rc.resultsLock.Lock()
+	defer rc.resultsLock.Unlock()
+	// the results are short-lived, so the expired ones are removed when a new one is stored
+	for k, v := range rc.results {
+		if !now.Before(v.expiresAt) {
+			delete(rc.results, k)
+		}
+	}
+	rc.results[key] = cachedResult{coalescedResult: result, expiresAt: now.Add(rc.config.ResultTTL)}
+}
+
+// getRequestKey identifies the requests returning the same metrics: same trigger type, metadata, authentication
+// and metric name, and same object for objectScopedTriggerTypes. The key is hashed, so the credentials
+// aren't kept in memory in plain text
+func getRequestKey(scalerConfig scalersconfig.ScalerConfig, metricName string) string {
+	hash := sha256.New()
+	write := func(values ...string) {
+		for _, value := range values {
+			hash.Write([]byte(value))
+			hash.Write([]byte{0})
+		}
+	}
+	writeMap := func(m map[string]string) {
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			write(k, m[k])
+		}
+	}
+
+	write(scalerConfig.ScalableObjectType, scalerConfig.TriggerType, string(scalerConfig.MetricType), metricName)
+	if slices.Contains(objectScopedTriggerTypes, scalerConfig.TriggerType) {
+		write(scalerConfig.ScalableObjectNamespace, scalerConfig.ScalableObjectName)
+	}
+	writeMap(scalerConfig.TriggerMetadata)
+	writeMap(scalerConfig.AuthParams)
+	// only the environment variables referenced by the metadata change the query
+	fromEnv := map[string]string{}
+	for k, v := range scalerConfig.TriggerMetadata {
+		if strings.HasSuffix(k, "FromEnv") {
+			fromEnv[k] = scalerConfig.ResolvedEnv[v]
+		}
+	}
+	writeMap(fromEnv)
+	write(string(scalerConfig.PodIdentity.Provider), scalerConfig.PodIdentity.GetIdentityID(), scalerConfig.PodIdentity.GetIdentityTenantID())
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// getEndpoint returns the backend endpoint queried by the scaler, it's empty if it can't be determined
+func getEndpoint(scalerConfig scalersconfig.ScalerConfig) string {
+	for _, key := range endpointMetadataKeys {
+		value := scalerConfig.TriggerMetadata[key]
+		if value == "" {
+			if envName := scalerConfig.TriggerMetadata[key+"FromEnv"]; envName != "" {
+				value = scalerConfig.ResolvedEnv[envName]
+			}
+		}
+		if value == "" {
+			value = scalerConfig.AuthParams[key]
+		}
+		if value != "" {
+			return normalizeEndpoint(value)
+		}
+	}
+	return ""
+}
+
+// normalizeEndpoint strips the scheme, the credentials and the path of URLs, so the endpoints are identified by their host
+func normalizeEndpoint(endpoint string) string {
+	endpoint = strings.TrimSpace(endpoint)
+	if u, err := url.Parse(endpoint); err == nil && u.Host != "" {
+		return strings.ToLower(u.Host)
+	}
+	// e.g. comma separated list of brokers or connection strings
+	if before, _, found := strings.Cut(endpoint, ","); found {
+		endpoint = before
+	}
+	return strings.ToLower(endpoint)
+}
@@ -18,6 +18,7 @@ package cache
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -41,7 +42,9 @@ type ScalersCache struct {
 	ScalableObjectGeneration int64
 	Recorder                 record.EventRecorder
 	CompiledFormula          *vm.Program
-	mutex                    sync.RWMutex
+	// RequestCoalescer is shared across the ScalersCaches, nil disables coalescing and rate limiting
+	RequestCoalescer *RequestCoalescer
+	mutex            sync.RWMutex
 }
 
 type ScalerBuilder struct {
@@ -90,6 +93,28 @@ func (c *ScalersCache) GetPushScalers() []scalers.PushScaler {
 	return result
 }
 
+// GetMessageClaimer returns the scaler of the trigger named triggerName able to claim messages,
+// or the first scaler able to claim messages if triggerName is empty
+func (c *ScalersCache) GetMessageClaimer(triggerName string) (scalers.MessageClaimer, error) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	for _, s := range c.Scalers {
+		if triggerName != "" && s.ScalerConfig.TriggerName != triggerName {
+			continue
+		}
+		if claimer, ok := s.Scaler.(scalers.MessageClaimer); ok {
+			return claimer, nil
+		}
+		if triggerName != "" {
+			return nil, fmt.Errorf("trigger %s of type %s can't claim messages", triggerName, s.ScalerConfig.TriggerType)
+		}
+	}
+	if triggerName != "" {
+		return nil, fmt.Errorf("trigger %s not found", triggerName)
+	}
+	return nil, fmt.Errorf("no trigger can claim messages")
+}
+
 // Close closes all scalers in the cache
 func (c *ScalersCache) Close(ctx context.Context) {
 	c.mutex.Lock()
@@ -150,17 +175,29 @@ func (c *ScalersCache) GetMetricsAndActivityForScaler(ctx context.Context, index
 	if err != nil {
 		return nil, false, -1, err
 	}
-	startTime := time.Now()
-	metric, activity, err := sb.Scaler.GetMetricsAndActivity(ctx, metricName)
+	metric, activity, latency, err := c.RequestCoalescer.GetMetricsAndActivity(ctx, sb.ScalerConfig, metricName,
+		func(ctx context.Context) ([]external_metrics.ExternalMetricValue, bool, time.Duration, error) {
+			startTime := time.Now()
+			metric, activity, err := sb.Scaler.GetMetricsAndActivity(ctx, metricName)
+			return metric, activity, time.Since(startTime), err
+		})
 	if err == nil {
-		return metric, activity, time.Since(startTime), nil
+		return metric, activity, latency, nil
+	}
+	// retrying right away with a refreshed scaler would only hit the rate limit again
+	var rateLimitErr *scalers.RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		return nil, false, latency, err
 	}
 
 	ns, err := c.refreshScaler(ctx, index)
 	if err != nil {
 		return nil, false, -1, err
 	}
-	startTime = time.Now()
+	if err := c.RequestCoalescer.Wait(ctx, sb.ScalerConfig); err != nil {
+		return nil, false, -1, err
+	}
+	startTime := time.Now()
 	metric, activity, err = ns.GetMetricsAndActivity(ctx, metricName)
 	return metric, activity, time.Since(startTime), err
 }
@@ -0,0 +1,220 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	v2 "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/metrics/pkg/apis/external_metrics"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+	"github.com/kedacore/keda/v2/pkg/scalers/authentication"
+	"github.com/kedacore/keda/v2/pkg/scaling/cache"
+	"github.com/kedacore/keda/v2/pkg/scaling/cache/metricscache"
+	"github.com/kedacore/keda/v2/pkg/scaling/modifiers"
+	"github.com/kedacore/keda/v2/pkg/scaling/scaledjob"
+)
+
+// Evaluator evaluates the triggers of ScaledObjects and ScaledJobs once, outside of any scale loop and without
+// scaling their targets. The scalers are built exactly like in the operator, so it can be used to test
+// trigger definitions before deploying them.
+type Evaluator struct {
+	handler *scaleHandler
+}
+
+// TriggerEvaluation is the result of the evaluation of a trigger
+type TriggerEvaluation struct {
+	Index       int                                    `json:"index"`
+	Name        string                                 `json:"name,omitempty"`
+	Type        string                                 `json:"type"`
+	MetricSpecs []v2.MetricSpec                        `json:"metricSpecs"`
+	Metrics     []external_metrics.ExternalMetricValue `json:"metrics,omitempty"`
+	IsActive    bool                                   `json:"isActive"`
+	Error       string                                 `json:"error,omitempty"`
+}
+
+// Evaluation is the result of the evaluation of a ScaledObject or a ScaledJob. For a ScaledObject,
+// DesiredReplicas is the number of replicas KEDA and the HPA would request, for a ScaledJob it's
+// the number of jobs that should be running.
+type Evaluation struct {
+	Kind            string              `json:"kind"`
+	Namespace       string              `json:"namespace"`
+	Name            string              `json:"name"`
+	Triggers        []TriggerEvaluation `json:"triggers"`
+	IsActive        bool                `json:"isActive"`
+	IsError         bool                `json:"isError"`
+	CurrentReplicas int32               `json:"currentReplicas"`
+	DesiredReplicas int64               `json:"desiredReplicas"`
+	EvaluatedAt     metav1.Time         `json:"evaluatedAt"`
+}
+
+// NewEvaluator returns an Evaluator resolving the referenced objects (scale targets, TriggerAuthentications,
+// Secrets...) through kubeClient and authClientSet
+func NewEvaluator(kubeClient client.Client, authClientSet *authentication.AuthClientSet, globalHTTPTimeout time.Duration) *Evaluator {
+	return &Evaluator{
+		handler: &scaleHandler{
+			client:                   kubeClient,
+			globalHTTPTimeout:        globalHTTPTimeout,
+			recorder:                 &record.FakeRecorder{},
+			scalerCaches:             map[string]*cache.ScalersCache{},
+			scalerCachesLock:         &sync.RWMutex{},
+			scaledObjectsMetricCache: metricscache.NewMetricsCache(),
+			authClientSet:            authClientSet,
+		},
+	}
+}
+
+// Close closes the scalers built by the Evaluator
+func (e *Evaluator) Close(ctx context.Context) {
+	e.handler.scalerCachesLock.Lock()
+	defer e.handler.scalerCachesLock.Unlock()
+	for key, scalersCache := range e.handler.scalerCaches {
+		scalersCache.Close(ctx)
+		delete(e.handler.scalerCaches, key)
+	}
+}
+
+// Evaluate queries the triggers of the ScaledObject or ScaledJob and computes the resulting replicas,
+// currentReplicas is the number of replicas (or running jobs) of the scale target.
+// The scalers are kept between calls, like in the scale loop.
+func (e *Evaluator) Evaluate(ctx context.Context, scalableObject interface{}, currentReplicas int32) (*Evaluation, error) {
+	withTriggers, err := kedav1alpha1.AsDuckWithTriggers(scalableObject)
+	if err != nil {
+		return nil, err
+	}
+	scalersCache, err := e.handler.GetScalersCache(ctx, scalableObject)
+	if err != nil {
+		return nil, fmt.Errorf("error building scalers: %w", err)
+	}
+
+	evaluation := &Evaluation{
+		Namespace:       withTriggers.Namespace,
+		Name:            withTriggers.Name,
+		CurrentReplicas: currentReplicas,
+		EvaluatedAt:     metav1.Now(),
+	}
+	_, scalerConfigs := scalersCache.GetScalers()
+	for index, scalerConfig := range scalerConfigs {
+		trigger := TriggerEvaluation{
+			Index: index,
+			Name:  scalerConfig.TriggerName,
+			Type:  scalerConfig.TriggerType,
+		}
+		trigger.MetricSpecs, err = scalersCache.GetMetricSpecForScalingForScaler(ctx, index)
+		if err != nil {
+			trigger.Error = err.Error()
+			evaluation.IsError = true
+		}
+		for _, spec := range trigger.MetricSpecs {
+			// cpu and memory triggers are evaluated by the HPA
+			if spec.External == nil {
+				continue
+			}
+			metrics, isActive, _, err := scalersCache.GetMetricsAndActivityForScaler(ctx, index, spec.External.Metric.Name)
+			if err != nil {
+				trigger.Error = err.Error()
+				evaluation.IsError = true
+				continue
+			}
+			trigger.Metrics = append(trigger.Metrics, metrics...)
+			trigger.IsActive = trigger.IsActive || isActive
+		}
+		evaluation.Triggers = append(evaluation.Triggers, trigger)
+	}
+
+	switch obj := scalableObject.(type) {
+	case *kedav1alpha1.ScaledObject:
+		evaluation.Kind = "ScaledObject"
+		err = evaluateScaledObject(ctx, obj, scalersCache, evaluation)
+	case *kedav1alpha1.ScaledJob:
+		evaluation.Kind = "ScaledJob"
+		evaluateScaledJob(obj, scalersCache, evaluation)
+	}
+	return evaluation, err
+}
+
+// evaluateScaledObject computes the activity and the desired replicas of a ScaledObject like the scale loop and the HPA would do
+func evaluateScaledObject(ctx context.Context, scaledObject *kedav1alpha1.ScaledObject, scalersCache *cache.ScalersCache, evaluation *Evaluation) error {
+	var metrics []external_metrics.ExternalMetricValue
+	metricTriggerPairList := map[string]string{}
+	cpuMemCount := 0
+	for _, trigger := range evaluation.Triggers {
+		if trigger.Type == "cpu" || trigger.Type == "memory" {
+			cpuMemCount++
+		}
+		evaluation.IsActive = evaluation.IsActive || trigger.IsActive
+		metrics = append(metrics, trigger.Metrics...)
+		for _, metric := range trigger.Metrics {
+			metricTriggerPairList[metric.MetricName] = trigger.Name
+		}
+	}
+
+	if scaledObject.IsUsingModifiers() {
+		metrics = modifiers.HandleScalingModifiers(scaledObject, metrics, metricTriggerPairList, false, nil, scalersCache, log)
+		evaluation.IsActive = false
+		activationValue := float64(0)
+		if scaledObject.Spec.Advanced.ScalingModifiers.ActivationTarget != "" {
+			value, err := strconv.ParseFloat(scaledObject.Spec.Advanced.ScalingModifiers.ActivationTarget, 64)
+			if err != nil {
+				return fmt.Errorf("scalingModifiers.ActivationTarget parsing error %w", err)
+			}
+			activationValue = value
+		}
+		for _, metric := range metrics {
+			evaluation.IsActive = evaluation.IsActive || metric.Value.AsApproximateFloat64() > activationValue
+		}
+	}
+	if len(scaledObject.Spec.Triggers) <= cpuMemCount && !evaluation.IsError {
+		evaluation.IsActive = true
+	}
+
+	targets, err := getMetricTargets(ctx, scaledObject, scalersCache)
+	if err != nil {
+		return err
+	}
+	evaluation.DesiredReplicas = int64(getDryRunDesiredReplicas(scaledObject, evaluation.IsActive, evaluation.CurrentReplicas, metrics, targets))
+	return nil
+}
+
+// evaluateScaledJob computes the activity and the number of jobs of a ScaledJob like the scale loop would do
+func evaluateScaledJob(scaledJob *kedav1alpha1.ScaledJob, scalersCache *cache.ScalersCache, evaluation *Evaluation) {
+	var scalersMetrics []scaledjob.ScalerMetrics
+	for _, trigger := range evaluation.Triggers {
+		if len(trigger.Metrics) == 0 {
+			continue
+		}
+		queueLength, maxValue, _ := scaledjob.CalculateQueueLengthAndMaxValue(trigger.Metrics, trigger.MetricSpecs, scaledJob.MaxReplicaCount())
+		scalersMetrics = append(scalersMetrics, scaledjob.ScalerMetrics{
+			QueueLength: queueLength,
+			MaxValue:    maxValue,
+			IsActive:    trigger.IsActive,
+			TriggerName: trigger.Name,
+		})
+	}
+	scalersMetrics = modifiers.HandleScaledJobScalingModifiers(scaledJob, scalersMetrics, scalersCache, log)
+	isActive, _, maxValue, _ := scaledjob.IsScaledJobActive(scalersMetrics, scaledJob.Spec.ScalingStrategy.MultipleScalersCalculation, scaledJob.MinReplicaCount(), scaledJob.MaxReplicaCount())
+	evaluation.IsActive = isActive
+	evaluation.DesiredReplicas = maxValue
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+	"github.com/kedacore/keda/v2/pkg/scalers/authentication"
+)
+
+func TestEvaluator(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"value": 42}`))
+	}))
+	defer server.Close()
+
+	triggers := []kedav1alpha1.ScaleTriggers{
+		{
+			Type: "metrics-api",
+			Name: "api",
+			Metadata: map[string]string{
+				"url":           server.URL,
+				"valueLocation": "value",
+				"targetValue":   "5",
+			},
+		},
+	}
+	scaledObject := &kedav1alpha1.ScaledObject{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test"},
+		Spec: kedav1alpha1.ScaledObjectSpec{
+			ScaleTargetRef:  &kedav1alpha1.ScaleTarget{Name: "app"},
+			MaxReplicaCount: ptr.To[int32](20),
+			Triggers:        triggers,
+		},
+		Status: kedav1alpha1.ScaledObjectStatus{
+			ScaleTargetGVKR: &kedav1alpha1.GroupVersionKindResource{Group: "apps", Version: "v1", Kind: "Deployment", Resource: "deployments"},
+		},
+	}
+	scaledJob := &kedav1alpha1.ScaledJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test"},
+		Spec: kedav1alpha1.ScaledJobSpec{
+			JobTargetRef: &batchv1.JobSpec{
+				Template: v1.PodTemplateSpec{Spec: v1.PodSpec{Containers: []v1.Container{{Name: "job"}}}},
+			},
+			MaxReplicaCount: ptr.To[int32](20),
+			Triggers:        triggers,
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, kedav1alpha1.AddToScheme(scheme))
+	kubeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "test"},
+	}).Build()
+
+	evaluator := NewEvaluator(kubeClient, &authentication.AuthClientSet{}, time.Second)
+	defer evaluator.Close(context.Background())
+
+	evaluation, err := evaluator.Evaluate(context.Background(), scaledObject, 2)
+	require.NoError(t, err)
+	assert.False(t, evaluation.IsError)
+	assert.True(t, evaluation.IsActive)
+	require.Len(t, evaluation.Triggers, 1)
+	assert.Equal(t, "metrics-api", evaluation.Triggers[0].Type)
+	require.Len(t, evaluation.Triggers[0].Metrics, 1)
+	assert.Equal(t, float64(42), evaluation.Triggers[0].Metrics[0].Value.AsApproximateFloat64())
+	assert.Equal(t, int64(9), evaluation.DesiredReplicas)
+
+	evaluation, err = evaluator.Evaluate(context.Background(), scaledJob, 0)
+	require.NoError(t, err)
+	assert.True(t, evaluation.IsActive)
+	assert.Equal(t, "ScaledJob", evaluation.Kind)
+	assert.Equal(t, int64(9), evaluation.DesiredReplicas)
+}
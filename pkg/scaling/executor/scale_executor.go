@@ -25,10 +25,16 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/scale"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/metrics/pkg/apis/external_metrics"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
+	eventingv1alpha1 "github.com/kedacore/keda/v2/apis/eventing/v1alpha1"
 	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+	"github.com/kedacore/keda/v2/pkg/eventemitter"
+	"github.com/kedacore/keda/v2/pkg/eventemitter/eventdata"
+	"github.com/kedacore/keda/v2/pkg/scalers"
+	"github.com/kedacore/keda/v2/pkg/scaling/history"
 	kedastatus "github.com/kedacore/keda/v2/pkg/status"
 )
 
@@ -40,13 +46,17 @@ const (
 
 // ScaleExecutor contains methods RequestJobScale and RequestScale
 type ScaleExecutor interface {
-	RequestJobScale(ctx context.Context, scaledJob *kedav1alpha1.ScaledJob, isActive bool, isError bool, scaleTo int64, maxScale int64)
+	RequestJobScale(ctx context.Context, scaledJob *kedav1alpha1.ScaledJob, isActive bool, isError bool, scaleTo int64, maxScale int64, options *ScaleExecutorOptions)
 	RequestScale(ctx context.Context, scaledObject *kedav1alpha1.ScaledObject, isActive bool, isError bool, options *ScaleExecutorOptions)
 }
 
-// ScaleExecutorOptions contains the optional parameters for the RequestScale method.
+// ScaleExecutorOptions contains the optional parameters for the RequestScale and RequestJobScale methods.
 type ScaleExecutorOptions struct {
 	ActiveTriggers []string
+	// Metrics are the values of the metrics of the scalers, they are recorded in the scaling history
+	Metrics []external_metrics.ExternalMetricValue
+	// GetMessageClaimer returns the scaler claiming the messages bound to the Jobs created by RequestJobScale
+	GetMessageClaimer func(ctx context.Context) (scalers.MessageClaimer, error)
 }
 
 type scaleExecutor struct {
@@ -55,16 +65,21 @@ type scaleExecutor struct {
 	reconcilerScheme *runtime.Scheme
 	logger           logr.Logger
 	recorder         record.EventRecorder
+	eventEmitter     eventemitter.EventHandler
+	scalingHistory   *history.Store
 }
 
-// NewScaleExecutor creates a ScaleExecutor object
-func NewScaleExecutor(client runtimeclient.Client, scaleClient scale.ScalesGetter, reconcilerScheme *runtime.Scheme, recorder record.EventRecorder) ScaleExecutor {
+// NewScaleExecutor creates a ScaleExecutor object, the scale transitions are recorded in scalingHistory, which can be nil.
+// The CloudEvents are emitted through eventEmitter, only the events are recorded if it is nil
+func NewScaleExecutor(client runtimeclient.Client, scaleClient scale.ScalesGetter, reconcilerScheme *runtime.Scheme, recorder record.EventRecorder, eventEmitter eventemitter.EventHandler, scalingHistory *history.Store) ScaleExecutor {
 	return &scaleExecutor{
 		client:           client,
 		scaleClient:      scaleClient,
 		reconcilerScheme: reconcilerScheme,
 		logger:           logf.Log.WithName("scaleexecutor"),
 		recorder:         recorder,
+		eventEmitter:     eventEmitter,
+		scalingHistory:   scalingHistory,
 	}
 }
 
@@ -87,6 +102,45 @@ func (e *scaleExecutor) updateLastActiveTime(ctx context.Context, logger logr.Lo
 	return kedastatus.TransformObject(ctx, e.client, logger, object, now, transform)
 }
 
+// emit records the event and emits the CloudEvent with the scaling data, if any,
+// the event is only recorded when no EventHandler was provided
+func (e *scaleExecutor) emit(object runtimeclient.Object, eventType string, cloudeventType eventingv1alpha1.CloudEventType, reason string, message string, scalingData ...eventdata.ScalingData) {
+	if e.eventEmitter == nil {
+		e.recorder.Event(object, eventType, reason, message)
+		return
+	}
+	e.eventEmitter.Emit(object, object.GetNamespace(), eventType, cloudeventType, reason, message, scalingData...)
+}
+
+// newTriggersData returns the scaling data of the CloudEvents of a ScaledObject with the active triggers and the metric values of options
+func newTriggersData(options *ScaleExecutorOptions) eventdata.ScalingData {
+	data := eventdata.ScalingData{MetricValues: getMetricValues(options)}
+	if options != nil {
+		data.ActiveTriggers = options.ActiveTriggers
+	}
+	return data
+}
+
+// newScalingData returns the scaling data of the CloudEvents of a ScaledObject scaled from fromReplicas to toReplicas
+func newScalingData(fromReplicas, toReplicas int32, options *ScaleExecutorOptions) eventdata.ScalingData {
+	data := newTriggersData(options)
+	data.FromReplicas = &fromReplicas
+	data.ToReplicas = &toReplicas
+	return data
+}
+
+// getMetricValues returns the values of the metrics of options by metric name, nil if there are none
+func getMetricValues(options *ScaleExecutorOptions) map[string]float64 {
+	if options == nil || len(options.Metrics) == 0 {
+		return nil
+	}
+	metricValues := make(map[string]float64, len(options.Metrics))
+	for _, metric := range options.Metrics {
+		metricValues[metric.MetricName] = metric.Value.AsApproximateFloat64()
+	}
+	return metricValues
+}
+
 func (e *scaleExecutor) setCondition(ctx context.Context, logger logr.Logger, object interface{}, status metav1.ConditionStatus, reason string, message string, setCondition func(kedav1alpha1.Conditions, metav1.ConditionStatus, string, string)) error {
 	type transformStruct struct {
 		status  metav1.ConditionStatus
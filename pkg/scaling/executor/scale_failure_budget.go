@@ -0,0 +1,167 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	eventingv1alpha1 "github.com/kedacore/keda/v2/apis/eventing/v1alpha1"
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+	"github.com/kedacore/keda/v2/pkg/eventreason"
+	kedastatus "github.com/kedacore/keda/v2/pkg/status"
+)
+
+// isFailureBudgetExhausted returns true when the creation of Jobs is paused because too many Jobs of the ScaledJob failed recently.
+// Once the backoff elapsed, only the Jobs failed after it are counted, the backoff is reset when the budget
+// isn't exhausted again within a whole window after resuming.
+func (e *scaleExecutor) isFailureBudgetExhausted(ctx context.Context, logger logr.Logger, scaledJob *kedav1alpha1.ScaledJob) bool {
+	budget := scaledJob.Spec.FailureBudget
+	status := scaledJob.Status.FailureBudget
+	degradedCondition := scaledJob.Status.Conditions.GetDegradedCondition()
+
+	if budget == nil {
+		if status != nil || degradedCondition.IsTrue() {
+			if err := e.setFailureBudgetStatus(ctx, logger, scaledJob, nil, metav1.ConditionFalse, "FailureBudgetDisabled", "ScaledJob has no failure budget"); err != nil {
+				logger.Error(err, "Error resetting the failure budget status")
+			}
+		}
+		return false
+	}
+
+	now := time.Now()
+	if status != nil && status.PausedUntil != nil && now.Before(status.PausedUntil.Time) {
+		return true
+	}
+
+	window := budget.GetWindow()
+	since := now.Add(-window)
+	if status != nil && status.PausedUntil != nil && status.PausedUntil.After(since) {
+		since = status.PausedUntil.Time
+	}
+	failures := e.getFailedJobCountSince(ctx, scaledJob, since)
+
+	if failures >= int64(budget.MaxFailures) {
+		exhaustions := int32(1)
+		if status != nil {
+			exhaustions = status.Exhaustions + 1
+		}
+		backoff := budget.GetBackoff(exhaustions)
+		pausedUntil := metav1.NewTime(now.Add(backoff))
+		msg := fmt.Sprintf("%d jobs failed within %s, the creation of jobs is paused for %s", failures, window, backoff)
+		logger.Info("Failure budget exhausted", "failedJobs", failures, "backoff", backoff)
+		newStatus := &kedav1alpha1.ScaledJobFailureBudgetStatus{Exhaustions: exhaustions, PausedUntil: &pausedUntil}
+		if err := e.setFailureBudgetStatus(ctx, logger, scaledJob, newStatus, metav1.ConditionTrue, kedav1alpha1.ScaledJobConditionDegradedReason, msg); err != nil {
+			logger.Error(err, "Error setting the failure budget status")
+		}
+		e.emit(scaledJob, corev1.EventTypeWarning, eventingv1alpha1.ScaledJobDegradedType, eventreason.KEDAJobsFailureBudgetExhausted, msg)
+		return true
+	}
+
+	switch {
+	case degradedCondition.IsTrue():
+		msg := "Failure budget backoff elapsed, the creation of jobs is resumed"
+		logger.Info(msg)
+		if err := e.setFailureBudgetStatus(ctx, logger, scaledJob, status, metav1.ConditionFalse, kedav1alpha1.ScaledJobConditionRecoveredReason, msg); err != nil {
+			logger.Error(err, "Error setting the failure budget status")
+		}
+		e.recorder.Event(scaledJob, corev1.EventTypeNormal, eventreason.KEDAJobsFailureBudgetRecovered, msg)
+	case status != nil && (status.PausedUntil == nil || now.After(status.PausedUntil.Add(window))):
+		msg := "Failure budget wasn't exhausted within a window since the creation of jobs resumed"
+		if err := e.setFailureBudgetStatus(ctx, logger, scaledJob, nil, metav1.ConditionFalse, kedav1alpha1.ScaledJobConditionRecoveredReason, msg); err != nil {
+			logger.Error(err, "Error resetting the failure budget status")
+		}
+	}
+	return false
+}
+
+// getFailedJobCountSince returns the number of Jobs of the ScaledJob which failed after since,
+// the failure time is the last transition time of the Failed condition of the Jobs
+func (e *scaleExecutor) getFailedJobCountSince(ctx context.Context, scaledJob *kedav1alpha1.ScaledJob, since time.Time) int64 {
+	logger := e.logger.WithValues("scaledJob.Name", scaledJob.Name, "scaledJob.Namespace", scaledJob.Namespace)
+
+	if scaledJob.Spec.WorkloadTemplate != nil {
+		evaluator, err := newWorkloadStatusEvaluator(scaledJob.Spec.WorkloadTemplate)
+		if err != nil {
+			logger.Error(err, "Failed to evaluate the state of the workloads")
+			return 0
+		}
+		workloads, err := e.listWorkloads(ctx, scaledJob)
+		if err != nil {
+			logger.Error(err, "Failed to list the workloads")
+			return 0
+		}
+		var count int64
+		for i := range workloads {
+			if evaluator.state(&workloads[i]) == workloadFailed && evaluator.failureTime(&workloads[i]).After(since) {
+				count++
+			}
+		}
+		return count
+	}
+
+	opts := []runtimeclient.ListOption{
+		runtimeclient.InNamespace(scaledJob.GetNamespace()),
+		runtimeclient.MatchingLabels(map[string]string{"scaledjob.keda.sh/name": scaledJob.GetName()}),
+	}
+	jobs := &batchv1.JobList{}
+	if err := e.client.List(ctx, jobs, opts...); err != nil {
+		logger.Error(err, "Failed to list the jobs")
+		return 0
+	}
+
+	var count int64
+	for i := range jobs.Items {
+		if failedAt := e.getJobFailureTime(&jobs.Items[i]); failedAt != nil && failedAt.After(since) {
+			count++
+		}
+	}
+	return count
+}
+
+// getJobFailureTime returns the time the Job failed at, or nil if the Job didn't fail
+func (e *scaleExecutor) getJobFailureTime(j *batchv1.Job) *metav1.Time {
+	if e.getFinishedJobConditionType(j) != batchv1.JobFailed {
+		return nil
+	}
+	for _, c := range j.Status.Conditions {
+		if c.Type == batchv1.JobFailed && c.Status == corev1.ConditionTrue {
+			return &c.LastTransitionTime
+		}
+	}
+	return nil
+}
+
+func (e *scaleExecutor) setFailureBudgetStatus(ctx context.Context, logger logr.Logger, scaledJob *kedav1alpha1.ScaledJob, status *kedav1alpha1.ScaledJobFailureBudgetStatus, conditionStatus metav1.ConditionStatus, reason string, message string) error {
+	transform := func(runtimeObj runtimeclient.Object, target interface{}) error {
+		obj, ok := runtimeObj.(*kedav1alpha1.ScaledJob)
+		if !ok {
+			return fmt.Errorf("transform object is not a ScaledJob %v", runtimeObj)
+		}
+		obj.Status.FailureBudget = target.(*kedav1alpha1.ScaledJobFailureBudgetStatus)
+		obj.Status.Conditions.SetDegradedCondition(conditionStatus, reason, message)
+		return nil
+	}
+	return kedastatus.TransformObject(ctx, e.client, logger, scaledJob, status, transform)
+}
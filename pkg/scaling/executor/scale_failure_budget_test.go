@@ -0,0 +1,134 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+)
+
+func TestFailureBudget(t *testing.T) {
+	ctx := context.Background()
+	logger := logf.Log.WithName("FailureBudgetTest")
+
+	scaledJob := getMockScaledJobWithDefaultStrategyAndMeta("test")
+	scaledJob.Status.Conditions = *kedav1alpha1.GetInitializedConditions()
+	scaledJob.Spec.FailureBudget = &kedav1alpha1.ScaledJobFailureBudget{MaxFailures: 2}
+
+	scaleExecutor := getMockScaleExecutor(nil)
+	client := fake.NewClientBuilder().WithScheme(scaleExecutor.reconcilerScheme).
+		WithObjects(scaledJob).WithStatusSubresource(scaledJob).Build()
+	scaleExecutor.client = client
+	recorder := record.NewFakeRecorder(10)
+	scaleExecutor.recorder = recorder
+
+	failJob := func(name string, failedAt time.Time) {
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: scaledJob.Namespace,
+				Labels:    map[string]string{"scaledjob.keda.sh/name": scaledJob.Name},
+			},
+			Status: batchv1.JobStatus{
+				Conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, LastTransitionTime: metav1.NewTime(failedAt)}},
+			},
+		}
+		assert.NoError(t, client.Create(ctx, job))
+	}
+
+	// failures out of the window are ignored
+	failJob("old", time.Now().Add(-time.Hour))
+	failJob("failed-1", time.Now().Add(-time.Minute))
+	assert.False(t, scaleExecutor.isFailureBudgetExhausted(ctx, logger, scaledJob))
+	assert.Equal(t, kedav1alpha1.ConditionType(""), scaledJob.Status.Conditions.GetDegradedCondition().Type)
+
+	failJob("failed-2", time.Now().Add(-time.Minute))
+	assert.True(t, scaleExecutor.isFailureBudgetExhausted(ctx, logger, scaledJob))
+	degradedCondition := scaledJob.Status.Conditions.GetDegradedCondition()
+	assert.True(t, degradedCondition.IsTrue())
+	assert.Equal(t, int32(1), scaledJob.Status.FailureBudget.Exhaustions)
+	assert.Contains(t, <-recorder.Events, "KEDAJobsFailureBudgetExhausted")
+	assert.WithinDuration(t, time.Now().Add(time.Minute), scaledJob.Status.FailureBudget.PausedUntil.Time, 5*time.Second)
+
+	stored := &kedav1alpha1.ScaledJob{}
+	assert.NoError(t, client.Get(ctx, runtimeclient.ObjectKeyFromObject(scaledJob), stored))
+	degradedCondition = stored.Status.Conditions.GetDegradedCondition()
+	assert.True(t, degradedCondition.IsTrue())
+
+	// still paused during the backoff
+	assert.True(t, scaleExecutor.isFailureBudgetExhausted(ctx, logger, scaledJob))
+
+	// the failures before the end of the backoff aren't counted once it elapsed
+	resumedAt := metav1.NewTime(time.Now().Add(-2 * time.Second))
+	scaledJob.Status.FailureBudget.PausedUntil = &resumedAt
+	assert.NoError(t, client.Status().Update(ctx, scaledJob))
+	assert.False(t, scaleExecutor.isFailureBudgetExhausted(ctx, logger, scaledJob))
+	degradedCondition = scaledJob.Status.Conditions.GetDegradedCondition()
+	assert.True(t, degradedCondition.IsFalse())
+	assert.Equal(t, kedav1alpha1.ScaledJobConditionRecoveredReason, degradedCondition.Reason)
+
+	// the backoff doubles when the budget is exhausted again
+	for i := 3; i <= 4; i++ {
+		failJob(fmt.Sprintf("failed-%d", i), time.Now())
+	}
+	assert.True(t, scaleExecutor.isFailureBudgetExhausted(ctx, logger, scaledJob))
+	assert.Equal(t, int32(2), scaledJob.Status.FailureBudget.Exhaustions)
+	assert.WithinDuration(t, time.Now().Add(2*time.Minute), scaledJob.Status.FailureBudget.PausedUntil.Time, 5*time.Second)
+
+	// the status is reset when the budget is removed
+	scaledJob.Spec.FailureBudget = nil
+	assert.False(t, scaleExecutor.isFailureBudgetExhausted(ctx, logger, scaledJob))
+	assert.Nil(t, scaledJob.Status.FailureBudget)
+	degradedCondition = scaledJob.Status.Conditions.GetDegradedCondition()
+	assert.True(t, degradedCondition.IsFalse())
+}
+
+func TestFailureBudgetRecoversWhileInactive(t *testing.T) {
+	ctx := context.Background()
+
+	scaledJob := getMockScaledJobWithDefaultStrategyAndMeta("test")
+	scaledJob.Status.Conditions = *kedav1alpha1.GetInitializedConditions()
+	scaledJob.Spec.FailureBudget = &kedav1alpha1.ScaledJobFailureBudget{MaxFailures: 2}
+	pausedUntil := metav1.NewTime(time.Now().Add(-time.Second))
+	scaledJob.Status.FailureBudget = &kedav1alpha1.ScaledJobFailureBudgetStatus{Exhaustions: 1, PausedUntil: &pausedUntil}
+	scaledJob.Status.Conditions.SetDegradedCondition(metav1.ConditionTrue, kedav1alpha1.ScaledJobConditionDegradedReason, "paused")
+
+	scaleExecutor := getMockScaleExecutor(nil)
+	scaleExecutor.client = fake.NewClientBuilder().WithScheme(scaleExecutor.reconcilerScheme).
+		WithObjects(scaledJob).WithStatusSubresource(scaledJob).Build()
+	scaleExecutor.recorder = record.NewFakeRecorder(10)
+
+	// the budget is evaluated even if the triggers aren't active
+	scaleExecutor.RequestJobScale(ctx, scaledJob, false, false, 0, 0, nil)
+
+	degradedCondition := scaledJob.Status.Conditions.GetDegradedCondition()
+	assert.True(t, degradedCondition.IsFalse())
+	assert.Equal(t, kedav1alpha1.ScaledJobConditionRecoveredReason, degradedCondition.Reason)
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"context"
+	"sort"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=keda.sh,resources=scaledjobgroups,verbs=get;list;watch
+
+// groupMember is a ScaledJob member of a ScaledJobGroup
+type groupMember struct {
+	name     string
+	weight   int64
+	priority int32
+	active   bool
+	running  int64
+}
+
+// groupScalingStrategy caps the effective max scale of a ScalingStrategy with the capacity
+// left to the ScaledJob in its ScaledJobGroup
+type groupScalingStrategy struct {
+	ScalingStrategy
+	capacity int64
+}
+
+func (s groupScalingStrategy) GetEffectiveMaxScale(maxScale, runningJobCount, pendingJobCount, maxReplicaCount, scaleTo int64) (int64, int64) {
+	effectiveMaxScale, scaleTo := s.ScalingStrategy.GetEffectiveMaxScale(maxScale, runningJobCount, pendingJobCount, maxReplicaCount, scaleTo)
+	return min(effectiveMaxScale, s.capacity), scaleTo
+}
+
+// getGroupCapacity returns the number of Jobs the ScaledJob can create within its share of the capacity of its ScaledJobGroup,
+// the second value is false when the ScaledJob isn't member of an existing group
+func (e *scaleExecutor) getGroupCapacity(ctx context.Context, logger logr.Logger, scaledJob *kedav1alpha1.ScaledJob, runningJobCount int64) (int64, bool) {
+	if scaledJob.Spec.Group == nil {
+		return 0, false
+	}
+
+	group := &kedav1alpha1.ScaledJobGroup{}
+	if err := e.client.Get(ctx, types.NamespacedName{Namespace: scaledJob.Namespace, Name: scaledJob.Spec.Group.Name}, group); err != nil {
+		logger.Error(err, "Failed to get the ScaledJobGroup, the running jobs aren't capped by the group", "group", scaledJob.Spec.Group.Name)
+		return 0, false
+	}
+
+	scaledJobs := &kedav1alpha1.ScaledJobList{}
+	if err := e.client.List(ctx, scaledJobs, client.InNamespace(scaledJob.Namespace)); err != nil {
+		logger.Error(err, "Failed to list the members of the ScaledJobGroup", "group", group.Name)
+		return 0, true
+	}
+
+	members := []groupMember{{
+		name:     scaledJob.Name,
+		weight:   int64(scaledJob.Spec.Group.GetWeight()),
+		priority: scaledJob.Spec.Group.Priority,
+		active:   true,
+		running:  runningJobCount,
+	}}
+	for i := range scaledJobs.Items {
+		member := &scaledJobs.Items[i]
+		if member.Name == scaledJob.Name || member.Spec.Group == nil || member.Spec.Group.Name != group.Name {
+			continue
+		}
+		activeCondition := member.Status.Conditions.GetActiveCondition()
+		members = append(members, groupMember{
+			name:     member.Name,
+			weight:   int64(member.Spec.Group.GetWeight()),
+			priority: member.Spec.Group.Priority,
+			active:   activeCondition.IsTrue(),
+			running:  e.getRunningJobCount(ctx, member),
+		})
+	}
+
+	var groupRunningJobCount int64
+	for _, member := range members {
+		groupRunningJobCount += member.running
+	}
+	shares := shareGroupCapacity(int64(group.Spec.MaxRunningJobs), members)
+	capacity := max(0, min(int64(group.Spec.MaxRunningJobs)-groupRunningJobCount, shares[scaledJob.Name]-runningJobCount))
+	logger.V(1).Info("ScaledJobGroup capacity", "group", group.Name, "groupRunningJobs", groupRunningJobCount, "share", shares[scaledJob.Name], "capacity", capacity)
+	return capacity, true
+}
+
+// shareGroupCapacity returns the number of running Jobs each member of a group is entitled to.
+// The inactive members keep their running Jobs, the rest of the capacity is granted to the active members
+// from the highest priority to the lowest one and shared by weight between the members with the same priority.
+// An active member running more Jobs than its share keeps them, reducing the capacity left to the lower priorities.
+func shareGroupCapacity(capacity int64, members []groupMember) map[string]int64 {
+	shares := make(map[string]int64, len(members))
+	left := capacity
+
+	tiers := map[int32][]groupMember{}
+	for _, member := range members {
+		if !member.active {
+			shares[member.name] = member.running
+			left -= member.running
+			continue
+		}
+		tiers[member.priority] = append(tiers[member.priority], member)
+	}
+	priorities := make([]int32, 0, len(tiers))
+	for priority := range tiers {
+		priorities = append(priorities, priority)
+	}
+	sort.Slice(priorities, func(i, j int) bool { return priorities[i] > priorities[j] })
+
+	for _, priority := range priorities {
+		tier := tiers[priority]
+		// the units left by the rounding go to the heaviest members first
+		sort.Slice(tier, func(i, j int) bool {
+			if tier[i].weight != tier[j].weight {
+				return tier[i].weight > tier[j].weight
+			}
+			return tier[i].name < tier[j].name
+		})
+
+		tierCapacity := max(0, left)
+		var totalWeight, granted int64
+		for _, member := range tier {
+			totalWeight += member.weight
+		}
+		for _, member := range tier {
+			shares[member.name] = tierCapacity * member.weight / totalWeight
+			granted += shares[member.name]
+		}
+		for i := 0; granted < tierCapacity; i = (i + 1) % len(tier) {
+			shares[tier[i].name]++
+			granted++
+		}
+
+		for _, member := range tier {
+			left -= max(shares[member.name], member.running)
+		}
+	}
+	return shares
+}
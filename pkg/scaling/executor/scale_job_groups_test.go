@@ -0,0 +1,145 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+)
+
+func TestShareGroupCapacity(t *testing.T) {
+	tests := []struct {
+		name     string
+		capacity int64
+		members  []groupMember
+		expected map[string]int64
+	}{
+		{
+			name:     "shared by weight",
+			capacity: 10,
+			members: []groupMember{
+				{name: "a", weight: 3, active: true},
+				{name: "b", weight: 1, active: true},
+			},
+			expected: map[string]int64{"a": 8, "b": 2},
+		},
+		{
+			name:     "rounding units go to the heaviest members",
+			capacity: 5,
+			members: []groupMember{
+				{name: "a", weight: 1, active: true},
+				{name: "b", weight: 2, active: true},
+				{name: "c", weight: 1, active: true},
+			},
+			expected: map[string]int64{"a": 1, "b": 3, "c": 1},
+		},
+		{
+			name:     "inactive members keep their running jobs",
+			capacity: 10,
+			members: []groupMember{
+				{name: "a", weight: 1, active: true},
+				{name: "b", weight: 1, active: false, running: 4},
+			},
+			expected: map[string]int64{"a": 6, "b": 4},
+		},
+		{
+			name:     "higher priority first",
+			capacity: 10,
+			members: []groupMember{
+				{name: "high", weight: 1, priority: 10, active: true},
+				{name: "low", weight: 1, active: true},
+			},
+			expected: map[string]int64{"high": 10, "low": 0},
+		},
+		{
+			name:     "lower priority gets what the higher one runs above its share",
+			capacity: 10,
+			members: []groupMember{
+				{name: "high-1", weight: 1, priority: 10, active: true, running: 2},
+				{name: "high-2", weight: 1, priority: 10, active: false, running: 2},
+				{name: "low", weight: 1, active: true, running: 3},
+			},
+			expected: map[string]int64{"high-1": 8, "high-2": 2, "low": 0},
+		},
+		{
+			name:     "members running above their share reduce the lower priorities capacity",
+			capacity: 10,
+			members: []groupMember{
+				{name: "high-1", weight: 1, priority: 10, active: true, running: 1},
+				{name: "high-2", weight: 1, priority: 10, active: true, running: 7},
+				{name: "mid", weight: 1, priority: 5, active: true},
+				{name: "low", weight: 1, active: true},
+			},
+			expected: map[string]int64{"high-1": 5, "high-2": 5, "mid": 0, "low": 0},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, shareGroupCapacity(test.capacity, test.members))
+		})
+	}
+}
+
+func TestGetScalingDecisionWithGroup(t *testing.T) {
+	ctx := context.Background()
+	logger := logf.Log.WithName("ScaledJobGroupTest")
+	scaleExecutor := getMockScaleExecutor(nil)
+
+	group := &kedav1alpha1.ScaledJobGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenants", Namespace: "test"},
+		Spec:       kedav1alpha1.ScaledJobGroupSpec{MaxRunningJobs: 6},
+	}
+	newMember := func(name string, weight int32, active bool) *kedav1alpha1.ScaledJob {
+		scaledJob := getMockScaledJobWithDefaultStrategyAndMeta(name)
+		scaledJob.Spec.Group = &kedav1alpha1.ScaledJobGroupReference{Name: group.Name, Weight: weight}
+		scaledJob.Status.Conditions = *kedav1alpha1.GetInitializedConditions()
+		if active {
+			scaledJob.Status.Conditions.SetActiveCondition(metav1.ConditionTrue, "ScalerActive", "")
+		}
+		return scaledJob
+	}
+	tenantA := newMember("tenant-a", 1, true)
+	tenantB := newMember("tenant-b", 2, true)
+	idle := newMember("idle", 1, false)
+	runningJob := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{
+		Name:      "idle-job",
+		Namespace: "test",
+		Labels:    map[string]string{"scaledjob.keda.sh/name": idle.Name},
+	}}
+	scaleExecutor.client = fake.NewClientBuilder().WithScheme(scaleExecutor.reconcilerScheme).
+		WithObjects(group, tenantA, tenantB, idle, runningJob).Build()
+
+	// the idle member keeps its running job, tenant-a is entitled to a third of the 5 jobs left
+	effectiveMaxScale, _ := scaleExecutor.getScalingDecision(ctx, tenantA, 0, 10, 10, 0, 0, logger)
+	assert.Equal(t, int64(1), effectiveMaxScale)
+	effectiveMaxScale, _ = scaleExecutor.getScalingDecision(ctx, tenantB, 0, 10, 10, 0, 0, logger)
+	assert.Equal(t, int64(4), effectiveMaxScale)
+
+	// the members of a missing group aren't capped
+	tenantA.Spec.Group.Name = "missing"
+	effectiveMaxScale, _ = scaleExecutor.getScalingDecision(ctx, tenantA, 0, 10, 10, 0, 0, logger)
+	assert.Equal(t, int64(10), effectiveMaxScale)
+}
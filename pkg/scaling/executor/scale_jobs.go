@@ -18,19 +18,27 @@ package executor
 
 import (
 	"context"
+	"fmt"
+	"maps"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
+	"github.com/tidwall/gjson"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
+	eventingv1alpha1 "github.com/kedacore/keda/v2/apis/eventing/v1alpha1"
 	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+	"github.com/kedacore/keda/v2/pkg/eventemitter/eventdata"
 	"github.com/kedacore/keda/v2/pkg/eventreason"
+	"github.com/kedacore/keda/v2/pkg/scalers"
+	"github.com/kedacore/keda/v2/pkg/scaling/history"
 	version "github.com/kedacore/keda/v2/version"
 )
 
@@ -39,20 +47,34 @@ const (
 	defaultFailedJobsHistoryLimit     = int32(100)
 )
 
-func (e *scaleExecutor) RequestJobScale(ctx context.Context, scaledJob *kedav1alpha1.ScaledJob, isActive, isError bool, scaleTo int64, maxScale int64) {
+func (e *scaleExecutor) RequestJobScale(ctx context.Context, scaledJob *kedav1alpha1.ScaledJob, isActive, isError bool, scaleTo int64, maxScale int64, options *ScaleExecutorOptions) {
 	logger := e.logger.WithValues("scaledJob.Name", scaledJob.Name, "scaledJob.Namespace", scaledJob.Namespace)
 
 	runningJobCount := e.getRunningJobCount(ctx, scaledJob)
 	pendingJobCount := e.getPendingJobCount(ctx, scaledJob)
 	logger.Info("Scaling Jobs", "Number of running Jobs", runningJobCount)
 	logger.Info("Scaling Jobs", "Number of pending Jobs", pendingJobCount)
+	inFlightJobCount := e.updateInFlightReservations(ctx, logger, scaledJob)
+	e.updateRollout(ctx, logger, scaledJob)
 
-	effectiveMaxScale, scaleTo := e.getScalingDecision(scaledJob, runningJobCount, scaleTo, maxScale, pendingJobCount, logger)
+	queueLength := scaleTo
+	effectiveMaxScale, scaleTo := e.getScalingDecision(ctx, scaledJob, runningJobCount, scaleTo, maxScale, pendingJobCount, inFlightJobCount, logger)
 
 	if effectiveMaxScale < 0 {
 		effectiveMaxScale = 0
 	}
 
+	e.updateStatistics(ctx, logger, scaledJob, runningJobCount, pendingJobCount, kedav1alpha1.ScaledJobScaleDecision{
+		QueueLength:       queueLength,
+		MaxValue:          maxScale,
+		EffectiveMaxScale: effectiveMaxScale,
+		ScalingStrategy:   scaledJob.Spec.ScalingStrategy.Strategy,
+	})
+
+	withheld := e.isJobCreationWithheld(ctx, logger, scaledJob, queueLength)
+	// the failure budget is evaluated on every poll cycle, so it recovers while the triggers aren't active
+	exhausted := e.isFailureBudgetExhausted(ctx, logger, scaledJob)
+
 	if isActive {
 		logger.V(1).Info("At least one scaler is active")
 		now := metav1.Now()
@@ -61,7 +83,23 @@ func (e *scaleExecutor) RequestJobScale(ctx context.Context, scaledJob *kedav1al
 		if err != nil {
 			logger.Error(err, "Failed to update last active time")
 		}
-		e.createJobs(ctx, logger, scaledJob, scaleTo, effectiveMaxScale)
+		if withheld {
+			logger.V(1).Info("Not creating jobs, the time windows withhold the creation", "reason", scaledJob.Status.TimeWindows.Reason)
+		} else if exhausted {
+			logger.V(1).Info("Not creating jobs, the failure budget is exhausted")
+		} else if createdJobs := e.createJobs(ctx, logger, scaledJob, scaleTo, effectiveMaxScale, options); createdJobs > 0 {
+			pausedCondition := scaledJob.Status.Conditions.GetPausedCondition()
+			fallbackCondition := scaledJob.Status.Conditions.GetFallbackCondition()
+			e.scalingHistory.Record(ctx, scaledJob, "ScaledJob", history.Transition{
+				FromReplicas: int32(runningJobCount),
+				ToReplicas:   int32(runningJobCount + createdJobs),
+				Reason:       history.ReasonJobsCreated,
+				Message:      fmt.Sprintf("Created %d jobs, %d jobs were pending", createdJobs, pendingJobCount),
+				MetricValues: map[string]float64{"queueLength": float64(queueLength), "maxValue": float64(maxScale)},
+				Paused:       pausedCondition.IsTrue(),
+				Fallback:     fallbackCondition.IsTrue(),
+			})
+		}
 	} else {
 		logger.V(1).Info("No change in activity")
 	}
@@ -109,50 +147,190 @@ func (e *scaleExecutor) RequestJobScale(ctx context.Context, scaledJob *kedav1al
 	}
 }
 
-func (e *scaleExecutor) getScalingDecision(scaledJob *kedav1alpha1.ScaledJob, runningJobCount int64, scaleTo int64, maxScale int64, pendingJobCount int64, logger logr.Logger) (int64, int64) {
+// getScalingDecision returns the effective max scale and the number of jobs to create.
+// The in-flight jobs of the earlier poll cycles are reserved for the messages they were created for, so whatever
+// the scaling strategy is, they're subtracted once from the input of the strategy: from the jobs requested by the
+// triggers and from the max replica count, and they aren't counted again as running or pending jobs
+func (e *scaleExecutor) getScalingDecision(ctx context.Context, scaledJob *kedav1alpha1.ScaledJob, runningJobCount int64, scaleTo int64, maxScale int64, pendingJobCount int64, inFlightJobCount int64, logger logr.Logger) (int64, int64) {
 	var effectiveMaxScale int64
 	minReplicaCount := scaledJob.MinReplicaCount()
+	groupCapacity, inGroup := e.getGroupCapacity(ctx, logger, scaledJob, runningJobCount)
 
 	if runningJobCount < minReplicaCount {
+		// the in-flight jobs are running jobs, they count towards the min replica count
 		scaleToMinReplica := minReplicaCount - runningJobCount
 		scaleTo = scaleToMinReplica
 		effectiveMaxScale = scaleToMinReplica
+		if inGroup {
+			effectiveMaxScale = min(effectiveMaxScale, groupCapacity)
+		}
 	} else {
-		effectiveMaxScale, scaleTo = NewScalingStrategy(logger, scaledJob).GetEffectiveMaxScale(maxScale, runningJobCount-minReplicaCount, pendingJobCount, scaledJob.MaxReplicaCount(), scaleTo)
+		strategy := NewScalingStrategy(logger, scaledJob)
+		if inGroup {
+			strategy = groupScalingStrategy{ScalingStrategy: strategy, capacity: groupCapacity}
+		}
+		effectiveMaxScale, scaleTo = strategy.GetEffectiveMaxScale(
+			max(maxScale-inFlightJobCount, 0),
+			max(runningJobCount-minReplicaCount-inFlightJobCount, 0),
+			max(pendingJobCount-inFlightJobCount, 0),
+			max(scaledJob.MaxReplicaCount()-inFlightJobCount, 0),
+			scaleTo,
+		)
 	}
 	return effectiveMaxScale, scaleTo
 }
 
-func (e *scaleExecutor) createJobs(ctx context.Context, logger logr.Logger, scaledJob *kedav1alpha1.ScaledJob, scaleTo int64, maxScale int64) {
+// createJobs creates up to maxScale jobs and returns the number of jobs created,
+// with a message binding a job is created for each message claimed
+func (e *scaleExecutor) createJobs(ctx context.Context, logger logr.Logger, scaledJob *kedav1alpha1.ScaledJob, scaleTo int64, maxScale int64, options *ScaleExecutorOptions) int64 {
 	if maxScale <= 0 {
 		logger.Info("No need to create jobs - all requested jobs already exist", "jobs", maxScale)
-		return
+		return 0
 	}
 	logger.Info("Creating jobs", "Effective number of max jobs", maxScale)
 	if scaleTo > maxScale {
 		scaleTo = maxScale
 	}
+
+	var claimer scalers.MessageClaimer
+	var messages []scalers.ClaimedMessage
+	if scaledJob.Spec.MessageBinding != nil {
+		if options == nil || options.GetMessageClaimer == nil {
+			logger.Error(fmt.Errorf("messages can't be claimed"), "Failed to claim the messages bound to the jobs")
+			return 0
+		}
+		var err error
+		claimer, err = options.GetMessageClaimer(ctx)
+		if err != nil {
+			logger.Error(err, "Failed to claim the messages bound to the jobs")
+			return 0
+		}
+		messages, err = claimer.ClaimMessages(ctx, int(scaleTo))
+		if err != nil {
+			logger.Error(err, "Failed to claim the messages bound to the jobs")
+			e.recorder.Eventf(scaledJob, corev1.EventTypeWarning, eventreason.KEDAScalerFailed, "Failed to claim the messages bound to the jobs: %s", err)
+		}
+		// the messages claimed before a failure are still bound to jobs
+		scaleTo = int64(len(messages))
+		if scaleTo == 0 {
+			logger.Info("No message claimed, no job created")
+			return 0
+		}
+	}
 	logger.Info("Creating jobs", "Number of jobs", scaleTo)
 
-	jobs := e.generateJobs(logger, scaledJob, scaleTo)
-	for _, job := range jobs {
+	var jobs []client.Object
+	if scaledJob.Spec.WorkloadTemplate != nil {
+		workloads, err := e.generateWorkloads(logger, scaledJob, scaleTo, messages)
+		if err != nil {
+			logger.Error(err, "Failed to generate the workloads from the workloadTemplate")
+			releaseMessages(ctx, logger, claimer, messages)
+			return 0
+		}
+		for _, workload := range workloads {
+			jobs = append(jobs, workload)
+		}
+	} else {
+		for _, job := range e.generateRolloutJobs(logger, scaledJob, scaleTo, messages) {
+			jobs = append(jobs, job)
+		}
+	}
+
+	reserve(jobs, time.Now().UnixMilli())
+
+	// the message bound to a job is acknowledged once the job is created, or released if it can't be created
+	var created []client.Object
+	var boundMessages, unboundMessages []scalers.ClaimedMessage
+	for i, job := range jobs {
 		err := e.client.Create(ctx, job)
 		if err != nil {
-			logger.Error(err, "Failed to create a new Job")
+			logger.Error(err, "Failed to create a new Job", "messageID", job.GetAnnotations()[kedav1alpha1.ScaledJobMessageIDAnnotation])
+			if i < len(messages) {
+				unboundMessages = append(unboundMessages, messages[i])
+			}
+			continue
+		}
+		created = append(created, job)
+		if i < len(messages) {
+			boundMessages = append(boundMessages, messages[i])
+		}
+	}
+	if len(boundMessages) > 0 {
+		if err := claimer.AckMessages(ctx, boundMessages); err != nil {
+			logger.Error(err, "Failed to acknowledge the messages bound to the jobs")
 		}
 	}
+	releaseMessages(ctx, logger, claimer, unboundMessages)
+	createdJobs := int64(len(created))
+	e.recordRolloutJobs(ctx, logger, scaledJob, created)
 
-	logger.Info("Created jobs", "Number of jobs", scaleTo)
-	e.recorder.Eventf(scaledJob, corev1.EventTypeNormal, eventreason.KEDAJobsCreated, "Created %d jobs", scaleTo)
+	if createdJobs == 0 {
+		return 0
+	}
+	logger.Info("Created jobs", "Number of jobs", createdJobs)
+	e.emit(scaledJob, corev1.EventTypeNormal, eventingv1alpha1.ScaledJobJobsCreatedType, eventreason.KEDAJobsCreated,
+		fmt.Sprintf("Created %d jobs", createdJobs), eventdata.ScalingData{Jobs: &createdJobs})
+	return createdJobs
 }
 
-func (e *scaleExecutor) generateJobs(logger logr.Logger, scaledJob *kedav1alpha1.ScaledJob, scaleTo int64) []*batchv1.Job {
+// releaseMessages gives back to the queue the claimed messages no job is bound to
+func releaseMessages(ctx context.Context, logger logr.Logger, claimer scalers.MessageClaimer, messages []scalers.ClaimedMessage) {
+	if len(messages) == 0 {
+		return
+	}
+	if err := claimer.ReleaseMessages(ctx, messages); err != nil {
+		logger.Error(err, "Failed to release the messages not bound to a job", "messages", len(messages))
+		return
+	}
+	logger.Info("Released the messages not bound to a job", "messages", len(messages))
+}
+
+// generateJobs generates scaleTo jobs, the messages are bound to the first jobs
+func (e *scaleExecutor) generateJobs(logger logr.Logger, scaledJob *kedav1alpha1.ScaledJob, scaleTo int64, messages []scalers.ClaimedMessage) []*batchv1.Job {
 	scaledJob.Spec.JobTargetRef.Template.GenerateName = scaledJob.GetName() + "-"
 	if scaledJob.Spec.JobTargetRef.Template.Labels == nil {
 		scaledJob.Spec.JobTargetRef.Template.Labels = map[string]string{}
 	}
 	scaledJob.Spec.JobTargetRef.Template.Labels["scaledjob.keda.sh/name"] = scaledJob.GetName()
 
+	labels, annotations := getJobLabelsAndAnnotations(scaledJob)
+
+	jobs := make([]*batchv1.Job, int(scaleTo))
+	for i := 0; i < int(scaleTo); i++ {
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: scaledJob.GetName() + "-",
+				Namespace:    scaledJob.GetNamespace(),
+				Labels:       labels,
+				Annotations:  annotations,
+			},
+			Spec: *scaledJob.Spec.JobTargetRef.DeepCopy(),
+		}
+
+		if i < len(messages) {
+			bindMessage(logger, job, scaledJob.Spec.MessageBinding, messages[i])
+		}
+
+		// Job doesn't allow RestartPolicyAlways, it seems like this value is set by the client as a default one,
+		// we should set this property to allowed value in that case
+		if job.Spec.Template.Spec.RestartPolicy == "" {
+			logger.V(1).Info("Job RestartPolicy is not set, setting it to 'OnFailure', to avoid setting it to the client's default value 'Always'")
+			job.Spec.Template.Spec.RestartPolicy = corev1.RestartPolicyOnFailure
+		}
+
+		// Set ScaledJob instance as the owner and controller
+		err := controllerutil.SetControllerReference(scaledJob, job, e.reconcilerScheme)
+		if err != nil {
+			logger.Error(err, "Failed to set ScaledJob as the owner of the new Job")
+		}
+
+		jobs[i] = job
+	}
+	return jobs
+}
+
+// getJobLabelsAndAnnotations returns the labels and annotations of the jobs created by the ScaledJob
+func getJobLabelsAndAnnotations(scaledJob *kedav1alpha1.ScaledJob) (map[string]string, map[string]string) {
 	labels := map[string]string{
 		"app.kubernetes.io/name":       scaledJob.GetName(),
 		"app.kubernetes.io/version":    version.Version,
@@ -183,38 +361,65 @@ func (e *scaleExecutor) generateJobs(logger logr.Logger, scaledJob *kedav1alpha1
 	for key, value := range scaledJob.Annotations {
 		annotations[key] = value
 	}
+	return labels, annotations
+}
 
-	jobs := make([]*batchv1.Job, int(scaleTo))
-	for i := 0; i < int(scaleTo); i++ {
-		job := &batchv1.Job{
-			ObjectMeta: metav1.ObjectMeta{
-				GenerateName: scaledJob.GetName() + "-",
-				Namespace:    scaledJob.GetNamespace(),
-				Labels:       labels,
-				Annotations:  annotations,
-			},
-			Spec: *scaledJob.Spec.JobTargetRef.DeepCopy(),
-		}
+// bindMessage injects the message in the job as environment variables or annotations,
+// the job is always annotated with the message ID for traceability
+func bindMessage(logger logr.Logger, job *batchv1.Job, binding *kedav1alpha1.ScaledJobMessageBinding, message scalers.ClaimedMessage) {
+	value := getBoundMessageValue(logger, binding, message)
+	job.Annotations = bindMessageAnnotations(job.Annotations, binding, message, value)
+	if binding.InjectAs == kedav1alpha1.MessageBindingInjectAsAnnotation {
+		return
+	}
 
-		// Job doesn't allow RestartPolicyAlways, it seems like this value is set by the client as a default one,
-		// we should set this property to allowed value in that case
-		if job.Spec.Template.Spec.RestartPolicy == "" {
-			logger.V(1).Info("Job RestartPolicy is not set, setting it to 'OnFailure', to avoid setting it to the client's default value 'Always'")
-			job.Spec.Template.Spec.RestartPolicy = corev1.RestartPolicyOnFailure
-		}
+	envName := binding.GetEnvName()
+	env := []corev1.EnvVar{{Name: envName, Value: value}}
+	if message.ID != "" {
+		env = append(env, corev1.EnvVar{Name: envName + "_ID", Value: message.ID})
+	}
+	if message.Handle != "" {
+		env = append(env, corev1.EnvVar{Name: envName + "_HANDLE", Value: message.Handle})
+	}
+	for i := range job.Spec.Template.Spec.Containers {
+		job.Spec.Template.Spec.Containers[i].Env = append(job.Spec.Template.Spec.Containers[i].Env, env...)
+	}
+}
 
-		// Set ScaledJob instance as the owner and controller
-		err := controllerutil.SetControllerReference(scaledJob, job, e.reconcilerScheme)
-		if err != nil {
-			logger.Error(err, "Failed to set ScaledJob as the owner of the new Job")
+// getBoundMessageValue returns the field of the payload selected by the binding, the message ID,
+// or the whole payload if the message doesn't have an ID
+func getBoundMessageValue(logger logr.Logger, binding *kedav1alpha1.ScaledJobMessageBinding, message scalers.ClaimedMessage) string {
+	value := message.ID
+	if binding.PayloadPath != "" {
+		if r := gjson.GetBytes(message.Payload, binding.PayloadPath); r.Exists() {
+			value = r.String()
+		} else {
+			logger.Info("Payload path not found in the message, the whole payload is bound", "payloadPath", binding.PayloadPath, "messageID", message.ID)
+			value = string(message.Payload)
 		}
+	} else if value == "" {
+		value = string(message.Payload)
+	}
+	return value
+}
 
-		jobs[i] = job
+// bindMessageAnnotations returns a copy of the annotations with the message ID and,
+// when the message is injected as annotation, the message value and handle
+func bindMessageAnnotations(annotations map[string]string, binding *kedav1alpha1.ScaledJobMessageBinding, message scalers.ClaimedMessage, value string) map[string]string {
+	annotations = maps.Clone(annotations)
+	if message.ID != "" {
+		annotations[kedav1alpha1.ScaledJobMessageIDAnnotation] = message.ID
+	}
+	if binding.InjectAs == kedav1alpha1.MessageBindingInjectAsAnnotation {
+		annotations[kedav1alpha1.ScaledJobMessageAnnotation] = value
+		if message.Handle != "" {
+			annotations[kedav1alpha1.ScaledJobMessageHandleAnnotation] = message.Handle
+		}
 	}
-	return jobs
+	return annotations
 }
 
-func (e *scaleExecutor) isJobFinished(j *batchv1.Job) bool {
+func isJobFinished(j *batchv1.Job) bool {
 	for _, c := range j.Status.Conditions {
 		if (c.Type == batchv1.JobComplete || c.Type == batchv1.JobFailed) && c.Status == corev1.ConditionTrue {
 			return true
@@ -224,6 +429,21 @@ func (e *scaleExecutor) isJobFinished(j *batchv1.Job) bool {
 }
 
 func (e *scaleExecutor) getRunningJobCount(ctx context.Context, scaledJob *kedav1alpha1.ScaledJob) int64 {
+	runningJobs, err := GetRunningJobCount(ctx, e.client, scaledJob)
+	if err != nil {
+		e.logger.Error(err, "Failed to count the running jobs", "scaledJob.Name", scaledJob.Name, "scaledJob.Namespace", scaledJob.Namespace)
+		return 0
+	}
+	return runningJobs
+}
+
+// GetRunningJobCount returns the number of unfinished Jobs of the ScaledJob, or of unfinished workloads
+// evaluated with the status rules of its WorkloadTemplate
+func GetRunningJobCount(ctx context.Context, c client.Client, scaledJob *kedav1alpha1.ScaledJob) (int64, error) {
+	if scaledJob.Spec.WorkloadTemplate != nil {
+		return countWorkloads(ctx, c, scaledJob, workloadRunning, workloadPending)
+	}
+
 	var runningJobs int64
 
 	opts := []client.ListOption{
@@ -232,19 +452,19 @@ func (e *scaleExecutor) getRunningJobCount(ctx context.Context, scaledJob *kedav
 	}
 
 	jobs := &batchv1.JobList{}
-	err := e.client.List(ctx, jobs, opts...)
+	err := c.List(ctx, jobs, opts...)
 
 	if err != nil {
-		return 0
+		return 0, err
 	}
 
 	for _, job := range jobs.Items {
-		if !e.isJobFinished(&job) {
+		if !isJobFinished(&job) {
 			runningJobs++
 		}
 	}
 
-	return runningJobs
+	return runningJobs, nil
 }
 
 func (e *scaleExecutor) isAnyPodRunningOrCompleted(ctx context.Context, j *batchv1.Job) bool {
@@ -307,6 +527,10 @@ func (e *scaleExecutor) areAllPendingPodConditionsFulfilled(ctx context.Context,
 }
 
 func (e *scaleExecutor) getPendingJobCount(ctx context.Context, scaledJob *kedav1alpha1.ScaledJob) int64 {
+	if scaledJob.Spec.WorkloadTemplate != nil {
+		return e.getWorkloadCount(ctx, scaledJob, workloadPending)
+	}
+
 	var pendingJobs int64
 
 	opts := []client.ListOption{
@@ -322,7 +546,7 @@ func (e *scaleExecutor) getPendingJobCount(ctx context.Context, scaledJob *kedav
 	}
 
 	for _, job := range jobs.Items {
-		if !e.isJobFinished(&job) {
+		if !isJobFinished(&job) {
 			if len(scaledJob.Spec.ScalingStrategy.PendingPodConditions) > 0 {
 				if !e.areAllPendingPodConditionsFulfilled(ctx, &job, scaledJob.Spec.ScalingStrategy.PendingPodConditions) {
 					pendingJobs++
@@ -342,6 +566,10 @@ func (e *scaleExecutor) getPendingJobCount(ctx context.Context, scaledJob *kedav
 func (e *scaleExecutor) cleanUp(ctx context.Context, scaledJob *kedav1alpha1.ScaledJob) error {
 	logger := e.logger.WithValues("scaledJob.Name", scaledJob.Name, "scaledJob.Namespace", scaledJob.Namespace)
 
+	if scaledJob.Spec.WorkloadTemplate != nil {
+		return e.cleanUpWorkloads(ctx, logger, scaledJob)
+	}
+
 	opts := []client.ListOption{
 		client.InNamespace(scaledJob.GetNamespace()),
 		client.MatchingLabels(map[string]string{"scaledjob.keda.sh/name": scaledJob.GetName()}),
@@ -369,6 +597,17 @@ func (e *scaleExecutor) cleanUp(ctx context.Context, scaledJob *kedav1alpha1.Sca
 	sort.Sort(byCompletedTime(completedJobs))
 	sort.Sort(byCompletedTime(failedJobs))
 
+	successfulJobsHistoryLimit, failedJobsHistoryLimit := getJobsHistoryLimits(scaledJob)
+
+	err = e.deleteJobsWithHistoryLimit(ctx, logger, completedJobs, successfulJobsHistoryLimit)
+	if err != nil {
+		return err
+	}
+	return e.deleteJobsWithHistoryLimit(ctx, logger, failedJobs, failedJobsHistoryLimit)
+}
+
+// getJobsHistoryLimits returns the number of successful and failed jobs to keep
+func getJobsHistoryLimits(scaledJob *kedav1alpha1.ScaledJob) (int32, int32) {
 	successfulJobsHistoryLimit := defaultSuccessfulJobsHistoryLimit
 	failedJobsHistoryLimit := defaultFailedJobsHistoryLimit
 
@@ -379,12 +618,7 @@ func (e *scaleExecutor) cleanUp(ctx context.Context, scaledJob *kedav1alpha1.Sca
 	if scaledJob.Spec.FailedJobsHistoryLimit != nil {
 		failedJobsHistoryLimit = *scaledJob.Spec.FailedJobsHistoryLimit
 	}
-
-	err = e.deleteJobsWithHistoryLimit(ctx, logger, completedJobs, successfulJobsHistoryLimit)
-	if err != nil {
-		return err
-	}
-	return e.deleteJobsWithHistoryLimit(ctx, logger, failedJobs, failedJobsHistoryLimit)
+	return successfulJobsHistoryLimit, failedJobsHistoryLimit
 }
 
 func (e *scaleExecutor) deleteJobsWithHistoryLimit(ctx context.Context, logger logr.Logger, jobs []batchv1.Job, historyLimit int32) error {
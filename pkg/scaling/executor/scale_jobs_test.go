@@ -18,6 +18,7 @@ package executor
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -36,6 +37,7 @@ import (
 
 	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
 	"github.com/kedacore/keda/v2/pkg/mock/mock_client"
+	"github.com/kedacore/keda/v2/pkg/scalers"
 )
 
 func TestCleanUpNormalCase(t *testing.T) {
@@ -210,7 +212,7 @@ func TestRunningJobCountSmallerMinReplicaCount(t *testing.T) {
 	var maxScale int64
 	var pendingJobCount int64
 
-	effectiveMaxScale, scaleTo := scaleExecutor.getScalingDecision(scaledJob, runningJobCount, scaleTo, maxScale, pendingJobCount, scaleExecutor.logger)
+	effectiveMaxScale, scaleTo := scaleExecutor.getScalingDecision(context.TODO(), scaledJob, runningJobCount, scaleTo, maxScale, pendingJobCount, 0, scaleExecutor.logger)
 	assert.Equal(t, int64(2), effectiveMaxScale)
 	assert.Equal(t, int64(2), scaleTo)
 }
@@ -224,7 +226,7 @@ func TestRunningJobCountIsDeductedFromMinReplicaCount(t *testing.T) {
 	var maxScale int64
 	var pendingJobCount int64
 
-	effectiveMaxScale, scaleTo := scaleExecutor.getScalingDecision(scaledJob, runningJobCount, scaleTo, maxScale, pendingJobCount, scaleExecutor.logger)
+	effectiveMaxScale, scaleTo := scaleExecutor.getScalingDecision(context.TODO(), scaledJob, runningJobCount, scaleTo, maxScale, pendingJobCount, 0, scaleExecutor.logger)
 	assert.Equal(t, int64(1), effectiveMaxScale)
 	assert.Equal(t, int64(1), scaleTo)
 }
@@ -238,11 +240,44 @@ func TestRunningJobCountGreaterOrEqualMinReplicaCountExecutesScalingStrategy(t *
 	var maxScale int64 = 2
 	var pendingJobCount int64
 
-	effectiveMaxScale, scaleTo := scaleExecutor.getScalingDecision(scaledJob, runningJobCount, scaleTo, maxScale, pendingJobCount, scaleExecutor.logger)
+	effectiveMaxScale, scaleTo := scaleExecutor.getScalingDecision(context.TODO(), scaledJob, runningJobCount, scaleTo, maxScale, pendingJobCount, 0, scaleExecutor.logger)
 	assert.Equal(t, int64(2), effectiveMaxScale)
 	assert.Equal(t, int64(2), scaleTo)
 }
 
+func TestGetScalingDecisionWithMinReplicaCountAndInFlightJobs(t *testing.T) {
+	scaleExecutor := getMockScaleExecutor(nil)
+	minReplicaCount := int32(2)
+	maxReplicaCount := int32(12)
+
+	tests := []struct {
+		strategy         string
+		runningJobCount  int64
+		expectedMaxScale int64
+		expectedScaleTo  int64
+	}{
+		{strategy: "default", runningJobCount: 6, expectedMaxScale: 4, expectedScaleTo: 8},
+		{strategy: "custom", runningJobCount: 6, expectedMaxScale: 4, expectedScaleTo: 8},
+		{strategy: "accurate", runningJobCount: 6, expectedMaxScale: 5, expectedScaleTo: 8},
+		{strategy: "eager", runningJobCount: 6, expectedMaxScale: 5, expectedScaleTo: 8},
+		// the in-flight jobs count towards the min replica count
+		{strategy: "default", runningJobCount: 1, expectedMaxScale: 1, expectedScaleTo: 1},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%s with %d running jobs", test.strategy, test.runningJobCount), func(t *testing.T) {
+			scaledJob := getMockScaledJobWithStrategy("test", test.strategy, 1, "0.5")
+			scaledJob.Spec.MinReplicaCount = &minReplicaCount
+			scaledJob.Spec.MaxReplicaCount = &maxReplicaCount
+
+			// 2 of the running and pending jobs are in-flight
+			effectiveMaxScale, scaleTo := scaleExecutor.getScalingDecision(context.TODO(), scaledJob, test.runningJobCount, 8, 8, 3, 2, scaleExecutor.logger)
+			assert.Equal(t, test.expectedMaxScale, effectiveMaxScale)
+			assert.Equal(t, test.expectedScaleTo, scaleTo)
+		})
+	}
+}
+
 func TestCleanUpDefaultValue(t *testing.T) {
 	ctx := context.Background()
 	ctrl := gomock.NewController(t)
@@ -430,7 +465,28 @@ func TestCreateJobs(t *testing.T) {
 		Return(nil)
 
 	scaledJob := getMockScaledJobWithDefaultStrategyAndMeta("test")
-	scaleExecutor.createJobs(ctx, logger, scaledJob, 2, 2)
+	scaleExecutor.createJobs(ctx, logger, scaledJob, 2, 2, nil)
+}
+
+func TestCreateJobsReportsCreatedJobs(t *testing.T) {
+	ctx := context.Background()
+	logger := logf.Log.WithName("CreateJobsReportsCreatedJobsTest")
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock_client.NewMockClient(ctrl)
+	scaleExecutor := getMockScaleExecutor(client)
+	recorder := scaleExecutor.recorder.(*record.FakeRecorder)
+	scaledJob := getMockScaledJobWithDefaultStrategyAndMeta("test")
+
+	client.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	client.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("quota exceeded"))
+	assert.Equal(t, int64(1), scaleExecutor.createJobs(ctx, logger, scaledJob, 2, 2, nil))
+	assert.Equal(t, "Normal KEDAJobsCreated Created 1 jobs", <-recorder.Events)
+
+	// no event is emitted if none of the jobs is created
+	client.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Times(2).Return(errors.New("quota exceeded"))
+	assert.Equal(t, int64(0), scaleExecutor.createJobs(ctx, logger, scaledJob, 2, 2, nil))
+	assert.Empty(t, recorder.Events)
 }
 
 func TestGenerateJobs(t *testing.T) {
@@ -456,7 +512,7 @@ func TestGenerateJobs(t *testing.T) {
 	scaleExecutor := getMockScaleExecutor(client)
 	scaledJob := getMockScaledJobWithDefaultStrategyAndMeta("test")
 
-	jobs := scaleExecutor.generateJobs(logger, scaledJob, 2)
+	jobs := scaleExecutor.generateJobs(logger, scaledJob, 2, nil)
 
 	assert.Equal(t, 2, len(jobs))
 	for _, j := range jobs {
@@ -466,6 +522,89 @@ func TestGenerateJobs(t *testing.T) {
 	}
 }
 
+func TestCreateJobsWithMessageBinding(t *testing.T) {
+	ctx := context.Background()
+	logger := logf.Log.WithName("CreateJobsWithMessageBindingTest")
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock_client.NewMockClient(ctrl)
+	scaleExecutor := getMockScaleExecutor(client)
+
+	var jobs []*batchv1.Job
+	client.EXPECT().
+		Create(gomock.Any(), gomock.Any(), gomock.Any()).Do(func(_ context.Context, obj runtime.Object, _ ...runtimeclient.CreateOption) {
+		jobs = append(jobs, obj.(*batchv1.Job))
+	}).Times(2).
+		Return(nil)
+	client.EXPECT().
+		Create(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(errors.New("quota exceeded"))
+
+	scaledJob := getMockScaledJobWithDefaultStrategyAndMeta("test")
+	scaledJob.Spec.JobTargetRef.Template.Spec.Containers = []v1.Container{{Name: "worker"}}
+	scaledJob.Spec.MessageBinding = &kedav1alpha1.ScaledJobMessageBinding{PayloadPath: "order.id"}
+	claimer := &fakeMessageClaimer{messages: []scalers.ClaimedMessage{
+		{ID: "id-1", Handle: "handle-1", Payload: []byte(`{"order":{"id":"order-1"}}`)},
+		{Payload: []byte("not json")},
+		{ID: "id-3", Payload: []byte("unbound")},
+	}}
+	options := &ScaleExecutorOptions{
+		GetMessageClaimer: func(context.Context) (scalers.MessageClaimer, error) {
+			return claimer, nil
+		},
+	}
+
+	// 4 jobs are requested but only 3 messages are left in the queue, the last job can't be created
+	assert.Equal(t, int64(2), scaleExecutor.createJobs(ctx, logger, scaledJob, 4, 4, options))
+	assert.Equal(t, []string{"id-1", ""}, claimer.acked)
+	assert.Equal(t, []string{"id-3"}, claimer.released)
+	assert.Equal(t, "id-1", jobs[0].Annotations[kedav1alpha1.ScaledJobMessageIDAnnotation])
+	assert.Equal(t, []v1.EnvVar{
+		{Name: "KEDA_MESSAGE", Value: "order-1"},
+		{Name: "KEDA_MESSAGE_ID", Value: "id-1"},
+		{Name: "KEDA_MESSAGE_HANDLE", Value: "handle-1"},
+	}, jobs[0].Spec.Template.Spec.Containers[0].Env)
+	assert.NotContains(t, jobs[1].Annotations, kedav1alpha1.ScaledJobMessageIDAnnotation)
+	assert.Equal(t, []v1.EnvVar{{Name: "KEDA_MESSAGE", Value: "not json"}}, jobs[1].Spec.Template.Spec.Containers[0].Env)
+	assert.Empty(t, scaledJob.Spec.JobTargetRef.Template.Spec.Containers[0].Env)
+
+	scaledJob.Spec.MessageBinding = &kedav1alpha1.ScaledJobMessageBinding{InjectAs: kedav1alpha1.MessageBindingInjectAsAnnotation}
+	generated := scaleExecutor.generateJobs(logger, scaledJob, 2, []scalers.ClaimedMessage{{ID: "id-2", Handle: "handle-2"}})
+	assert.Equal(t, "id-2", generated[0].Annotations[kedav1alpha1.ScaledJobMessageAnnotation])
+	assert.Equal(t, "handle-2", generated[0].Annotations[kedav1alpha1.ScaledJobMessageHandleAnnotation])
+	assert.Empty(t, generated[0].Spec.Template.Spec.Containers[0].Env)
+	// the jobs without message share the annotations of the ScaledJob
+	assert.NotContains(t, generated[1].Annotations, kedav1alpha1.ScaledJobMessageIDAnnotation)
+}
+
+// fakeMessageClaimer claims the messages it holds and records the IDs of the messages acknowledged and released
+type fakeMessageClaimer struct {
+	messages []scalers.ClaimedMessage
+	acked    []string
+	released []string
+}
+
+func (c *fakeMessageClaimer) ClaimMessages(_ context.Context, count int) ([]scalers.ClaimedMessage, error) {
+	count = min(count, len(c.messages))
+	claimed := c.messages[:count]
+	c.messages = c.messages[count:]
+	return claimed, nil
+}
+
+func (c *fakeMessageClaimer) AckMessages(_ context.Context, messages []scalers.ClaimedMessage) error {
+	for _, message := range messages {
+		c.acked = append(c.acked, message.ID)
+	}
+	return nil
+}
+
+func (c *fakeMessageClaimer) ReleaseMessages(_ context.Context, messages []scalers.ClaimedMessage) error {
+	for _, message := range messages {
+		c.released = append(c.released, message.ID)
+	}
+	return nil
+}
+
 type mockJobParameter struct {
 	Name             string
 	CompletionTime   string
@@ -0,0 +1,155 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sort"
+	"strconv"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+	kedastatus "github.com/kedacore/keda/v2/pkg/status"
+)
+
+const scaledJobGenerationAnnotation = "scaledjob.keda.sh/generation"
+
+// reservationKey identifies the Jobs created in a poll cycle from a generation of a ScaledJob
+type reservationKey struct {
+	generation int64
+	cycle      int64
+}
+
+// reserve annotates the jobs with the poll cycle creating them
+func reserve(jobs []runtimeclient.Object, cycle int64) {
+	for _, job := range jobs {
+		// the annotations of the generated jobs can be shared
+		annotations := maps.Clone(job.GetAnnotations())
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[kedav1alpha1.ScaledJobPollCycleAnnotation] = strconv.FormatInt(cycle, 10)
+		job.SetAnnotations(annotations)
+	}
+}
+
+// getInFlightReservations returns the reservation ledger of the ScaledJob: the Jobs created in the earlier poll cycles,
+// which are neither finished nor have a running or completed pod, grouped by generation and poll cycle
+func (e *scaleExecutor) getInFlightReservations(ctx context.Context, logger logr.Logger, scaledJob *kedav1alpha1.ScaledJob) []kedav1alpha1.ScaledJobReservation {
+	var inFlight []runtimeclient.Object
+	if scaledJob.Spec.WorkloadTemplate != nil {
+		evaluator, err := newWorkloadStatusEvaluator(scaledJob.Spec.WorkloadTemplate)
+		if err != nil {
+			logger.Error(err, "Failed to evaluate the state of the workloads")
+			return nil
+		}
+		workloads, err := e.listWorkloads(ctx, scaledJob)
+		if err != nil {
+			logger.Error(err, "Failed to list the workloads")
+			return nil
+		}
+		for i := range workloads {
+			if evaluator.state(&workloads[i]) == workloadPending {
+				inFlight = append(inFlight, &workloads[i])
+			}
+		}
+	} else {
+		opts := []runtimeclient.ListOption{
+			runtimeclient.InNamespace(scaledJob.GetNamespace()),
+			runtimeclient.MatchingLabels(map[string]string{"scaledjob.keda.sh/name": scaledJob.GetName()}),
+		}
+		jobs := &batchv1.JobList{}
+		if err := e.client.List(ctx, jobs, opts...); err != nil {
+			logger.Error(err, "Failed to list the jobs")
+			return nil
+		}
+		for i := range jobs.Items {
+			job := &jobs.Items[i]
+			if _, ok := job.Annotations[kedav1alpha1.ScaledJobPollCycleAnnotation]; ok && !isJobFinished(job) && !e.isAnyPodRunningOrCompleted(ctx, job) {
+				inFlight = append(inFlight, job)
+			}
+		}
+	}
+
+	counts := map[reservationKey]int64{}
+	for _, job := range inFlight {
+		key, err := getReservationKey(job)
+		if err != nil {
+			continue
+		}
+		counts[key]++
+	}
+
+	reservations := make([]kedav1alpha1.ScaledJobReservation, 0, len(counts))
+	for key, count := range counts {
+		reservations = append(reservations, kedav1alpha1.ScaledJobReservation{Generation: key.generation, Cycle: key.cycle, Jobs: count})
+	}
+	sort.Slice(reservations, func(i, j int) bool {
+		if reservations[i].Generation != reservations[j].Generation {
+			return reservations[i].Generation < reservations[j].Generation
+		}
+		return reservations[i].Cycle < reservations[j].Cycle
+	})
+	return reservations
+}
+
+func getReservationKey(job runtimeclient.Object) (reservationKey, error) {
+	annotations := job.GetAnnotations()
+	cycle, err := strconv.ParseInt(annotations[kedav1alpha1.ScaledJobPollCycleAnnotation], 10, 64)
+	if err != nil {
+		return reservationKey{}, err
+	}
+	generation, err := strconv.ParseInt(annotations[scaledJobGenerationAnnotation], 10, 64)
+	if err != nil {
+		return reservationKey{}, err
+	}
+	return reservationKey{generation: generation, cycle: cycle}, nil
+}
+
+// updateInFlightReservations updates the reservation ledger in the status of the ScaledJob and returns the number of in-flight Jobs
+func (e *scaleExecutor) updateInFlightReservations(ctx context.Context, logger logr.Logger, scaledJob *kedav1alpha1.ScaledJob) int64 {
+	reservations := e.getInFlightReservations(ctx, logger, scaledJob)
+
+	var inFlightJobCount int64
+	for _, reservation := range reservations {
+		inFlightJobCount += reservation.Jobs
+	}
+
+	if len(reservations) == 0 {
+		reservations = nil
+	}
+	if !equality.Semantic.DeepEqual(reservations, scaledJob.Status.InFlightReservations) {
+		transform := func(runtimeObj runtimeclient.Object, target interface{}) error {
+			obj, ok := runtimeObj.(*kedav1alpha1.ScaledJob)
+			if !ok {
+				return fmt.Errorf("transform object is not a ScaledJob %v", runtimeObj)
+			}
+			obj.Status.InFlightReservations = target.([]kedav1alpha1.ScaledJobReservation)
+			return nil
+		}
+		if err := kedastatus.TransformObject(ctx, e.client, logger, scaledJob, reservations, transform); err != nil {
+			logger.Error(err, "Error setting the in-flight reservations")
+		}
+	}
+	return inFlightJobCount
+}
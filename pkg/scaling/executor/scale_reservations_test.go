@@ -0,0 +1,102 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+)
+
+func TestInFlightReservations(t *testing.T) {
+	ctx := context.Background()
+	logger := logf.Log.WithName("InFlightReservationsTest")
+
+	scaledJob := getMockScaledJobWithDefaultStrategyAndMeta("test")
+	newJob := func(name, generation, cycle string, conditions ...batchv1.JobCondition) *batchv1.Job {
+		annotations := map[string]string{scaledJobGenerationAnnotation: generation}
+		if cycle != "" {
+			annotations[kedav1alpha1.ScaledJobPollCycleAnnotation] = cycle
+		}
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   scaledJob.Namespace,
+				Labels:      map[string]string{"scaledjob.keda.sh/name": scaledJob.Name},
+				Annotations: annotations,
+			},
+			Status: batchv1.JobStatus{Conditions: conditions},
+		}
+	}
+	runningPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "started-pod", Namespace: scaledJob.Namespace, Labels: map[string]string{"job-name": "started"}},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+
+	scaleExecutor := getMockScaleExecutor(nil)
+	scaleExecutor.client = fake.NewClientBuilder().WithScheme(scaleExecutor.reconcilerScheme).
+		WithObjects(
+			scaledJob,
+			newJob("first-1", "1", "1000"),
+			newJob("first-2", "1", "1000"),
+			newJob("second", "1", "2000"),
+			newJob("updated", "2", "3000"),
+			newJob("started", "2", "3000"),
+			newJob("failed", "2", "3000", batchv1.JobCondition{Type: batchv1.JobFailed, Status: corev1.ConditionTrue}),
+			newJob("unreserved", "1", ""),
+			runningPod,
+		).WithStatusSubresource(scaledJob).Build()
+
+	assert.Equal(t, int64(4), scaleExecutor.updateInFlightReservations(ctx, logger, scaledJob))
+	assert.Equal(t, []kedav1alpha1.ScaledJobReservation{
+		{Generation: 1, Cycle: 1000, Jobs: 2},
+		{Generation: 1, Cycle: 2000, Jobs: 1},
+		{Generation: 2, Cycle: 3000, Jobs: 1},
+	}, scaledJob.Status.InFlightReservations)
+
+	// the in-flight jobs are subtracted whatever the scaling strategy is
+	effectiveMaxScale, _ := scaleExecutor.getScalingDecision(ctx, scaledJob, 4, 10, 10, 0, 4, logger)
+	assert.Equal(t, int64(6), effectiveMaxScale)
+	scaledJob.Spec.ScalingStrategy.Strategy = "eager"
+	effectiveMaxScale, _ = scaleExecutor.getScalingDecision(ctx, scaledJob, 4, 10, 10, 0, 4, logger)
+	assert.Equal(t, int64(6), effectiveMaxScale)
+}
+
+func TestReserve(t *testing.T) {
+	shared := map[string]string{"scaledjob.keda.sh/generation": "3"}
+	jobs := []*batchv1.Job{
+		{ObjectMeta: metav1.ObjectMeta{Annotations: shared}},
+		{ObjectMeta: metav1.ObjectMeta{Annotations: shared}},
+	}
+	reserve([]client.Object{jobs[0], jobs[1]}, 42)
+
+	for _, job := range jobs {
+		key, err := getReservationKey(job)
+		assert.NoError(t, err)
+		assert.Equal(t, reservationKey{generation: 3, cycle: 42}, key)
+	}
+	assert.NotContains(t, shared, kedav1alpha1.ScaledJobPollCycleAnnotation)
+}
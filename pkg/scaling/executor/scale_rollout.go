@@ -0,0 +1,189 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+	"github.com/kedacore/keda/v2/pkg/eventreason"
+	"github.com/kedacore/keda/v2/pkg/scalers"
+	kedastatus "github.com/kedacore/keda/v2/pkg/status"
+)
+
+// newStableRolloutStatus returns the rollout status with the current generation of the ScaledJob as the stable one
+func newStableRolloutStatus(scaledJob *kedav1alpha1.ScaledJob) *kedav1alpha1.ScaledJobRolloutStatus {
+	return &kedav1alpha1.ScaledJobRolloutStatus{
+		Phase:              kedav1alpha1.RolloutPhaseStable,
+		StableGeneration:   scaledJob.Generation,
+		StableJobTargetRef: scaledJob.Spec.JobTargetRef.DeepCopy(),
+	}
+}
+
+// updateRollout tracks the canary rollout of the ScaledJob: a new generation starts a canary,
+// which is promoted or rolled back once enough canary Jobs are finished
+func (e *scaleExecutor) updateRollout(ctx context.Context, logger logr.Logger, scaledJob *kedav1alpha1.ScaledJob) {
+	var status *kedav1alpha1.ScaledJobRolloutStatus
+	if scaledJob.GetRolloutStrategy() == kedav1alpha1.RolloutStrategyCanary && scaledJob.Spec.JobTargetRef != nil {
+		status = scaledJob.Status.Rollout.DeepCopy()
+		switch {
+		case status == nil, scaledJob.Generation == status.StableGeneration:
+			status = newStableRolloutStatus(scaledJob)
+		case scaledJob.Generation != status.CanaryGeneration:
+			logger.Info("Starting the canary rollout", "stableGeneration", status.StableGeneration, "canaryGeneration", scaledJob.Generation)
+			status = &kedav1alpha1.ScaledJobRolloutStatus{
+				Phase:              kedav1alpha1.RolloutPhaseProgressing,
+				StableGeneration:   status.StableGeneration,
+				StableJobTargetRef: status.StableJobTargetRef,
+				CanaryGeneration:   scaledJob.Generation,
+			}
+		case status.Phase == kedav1alpha1.RolloutPhaseProgressing:
+			status.CanarySucceededJobs, status.CanaryFailedJobs = e.getFinishedCanaryJobCounts(ctx, logger, scaledJob, status.CanaryGeneration)
+			canary := scaledJob.Spec.Rollout.Canary
+			finished := status.CanarySucceededJobs + status.CanaryFailedJobs
+			if finished >= canary.GetMinFinishedJobs() {
+				if status.CanarySucceededJobs*100 >= canary.GetSuccessRatio()*finished {
+					msg := fmt.Sprintf("Canary generation %d promoted, %d of %d canary jobs succeeded", status.CanaryGeneration, status.CanarySucceededJobs, finished)
+					logger.Info(msg)
+					e.recorder.Event(scaledJob, corev1.EventTypeNormal, eventreason.KEDAJobsRolloutPromoted, msg)
+					status = newStableRolloutStatus(scaledJob)
+				} else {
+					msg := fmt.Sprintf("Canary generation %d rolled back, %d of %d canary jobs failed", status.CanaryGeneration, status.CanaryFailedJobs, finished)
+					logger.Info(msg)
+					e.recorder.Event(scaledJob, corev1.EventTypeWarning, eventreason.KEDAJobsRolloutRolledBack, msg)
+					status.Phase = kedav1alpha1.RolloutPhaseRolledBack
+				}
+			}
+		}
+	}
+
+	if err := e.setRolloutStatus(ctx, logger, scaledJob, status); err != nil {
+		logger.Error(err, "Error setting the rollout status")
+	}
+}
+
+// getFinishedCanaryJobCounts returns the number of succeeded and failed Jobs of the canary generation
+func (e *scaleExecutor) getFinishedCanaryJobCounts(ctx context.Context, logger logr.Logger, scaledJob *kedav1alpha1.ScaledJob, canaryGeneration int64) (int64, int64) {
+	opts := []runtimeclient.ListOption{
+		runtimeclient.InNamespace(scaledJob.GetNamespace()),
+		runtimeclient.MatchingLabels(map[string]string{"scaledjob.keda.sh/name": scaledJob.GetName()}),
+	}
+	jobs := &batchv1.JobList{}
+	if err := e.client.List(ctx, jobs, opts...); err != nil {
+		logger.Error(err, "Failed to list the jobs")
+		return 0, 0
+	}
+
+	generation := strconv.FormatInt(canaryGeneration, 10)
+	var succeeded, failed int64
+	for i := range jobs.Items {
+		job := &jobs.Items[i]
+		if job.Annotations[scaledJobGenerationAnnotation] != generation {
+			continue
+		}
+		switch e.getFinishedJobConditionType(job) {
+		case batchv1.JobComplete:
+			succeeded++
+		case batchv1.JobFailed:
+			failed++
+		}
+	}
+	return succeeded, failed
+}
+
+// generateRolloutJobs generates scaleTo jobs, during a canary rollout a percentage of them is generated
+// from the canary generation and the rest from the stable one, the messages are bound to the canary jobs first
+func (e *scaleExecutor) generateRolloutJobs(logger logr.Logger, scaledJob *kedav1alpha1.ScaledJob, scaleTo int64, messages []scalers.ClaimedMessage) []*batchv1.Job {
+	status := scaledJob.Status.Rollout
+	if scaledJob.GetRolloutStrategy() != kedav1alpha1.RolloutStrategyCanary || status == nil ||
+		status.Phase == kedav1alpha1.RolloutPhaseStable || status.StableJobTargetRef == nil {
+		return e.generateJobs(logger, scaledJob, scaleTo, messages)
+	}
+
+	var canaryJobCount int64
+	if status.Phase == kedav1alpha1.RolloutPhaseProgressing {
+		canaryJobCount = getCanaryJobCount(status, scaleTo, scaledJob.Spec.Rollout.Canary.GetPercentage())
+	}
+	canaryMessages, stableMessages := messages, []scalers.ClaimedMessage(nil)
+	if int64(len(messages)) > canaryJobCount {
+		canaryMessages, stableMessages = messages[:canaryJobCount], messages[canaryJobCount:]
+	}
+
+	stable := scaledJob.DeepCopy()
+	stable.Generation = status.StableGeneration
+	stable.Spec.JobTargetRef = status.StableJobTargetRef.DeepCopy()
+
+	logger.V(1).Info("Generating jobs of the canary rollout", "canaryJobs", canaryJobCount, "stableJobs", scaleTo-canaryJobCount)
+	jobs := e.generateJobs(logger, scaledJob, canaryJobCount, canaryMessages)
+	return append(jobs, e.generateJobs(logger, stable, scaleTo-canaryJobCount, stableMessages)...)
+}
+
+// getCanaryJobCount returns how many of the scaleTo jobs are generated from the canary generation,
+// for the canary jobs to be the percentage of the jobs created since the canary started
+func getCanaryJobCount(status *kedav1alpha1.ScaledJobRolloutStatus, scaleTo int64, percentage int64) int64 {
+	var canaryJobCount int64
+	created := status.CanaryJobs + status.StableJobs
+	for i := int64(1); i <= scaleTo; i++ {
+		if (status.CanaryJobs+canaryJobCount)*100 < (created+i)*percentage {
+			canaryJobCount++
+		}
+	}
+	return canaryJobCount
+}
+
+// recordRolloutJobs counts the jobs created from each generation during a canary rollout
+func (e *scaleExecutor) recordRolloutJobs(ctx context.Context, logger logr.Logger, scaledJob *kedav1alpha1.ScaledJob, jobs []runtimeclient.Object) {
+	if scaledJob.Status.Rollout == nil || scaledJob.Status.Rollout.Phase != kedav1alpha1.RolloutPhaseProgressing || len(jobs) == 0 {
+		return
+	}
+
+	status := scaledJob.Status.Rollout.DeepCopy()
+	canaryGeneration := strconv.FormatInt(status.CanaryGeneration, 10)
+	for _, job := range jobs {
+		if job.GetAnnotations()[scaledJobGenerationAnnotation] == canaryGeneration {
+			status.CanaryJobs++
+		} else {
+			status.StableJobs++
+		}
+	}
+	if err := e.setRolloutStatus(ctx, logger, scaledJob, status); err != nil {
+		logger.Error(err, "Error setting the rollout status")
+	}
+}
+
+func (e *scaleExecutor) setRolloutStatus(ctx context.Context, logger logr.Logger, scaledJob *kedav1alpha1.ScaledJob, status *kedav1alpha1.ScaledJobRolloutStatus) error {
+	if equality.Semantic.DeepEqual(status, scaledJob.Status.Rollout) {
+		return nil
+	}
+	transform := func(runtimeObj runtimeclient.Object, target interface{}) error {
+		obj, ok := runtimeObj.(*kedav1alpha1.ScaledJob)
+		if !ok {
+			return fmt.Errorf("transform object is not a ScaledJob %v", runtimeObj)
+		}
+		obj.Status.Rollout = target.(*kedav1alpha1.ScaledJobRolloutStatus)
+		return nil
+	}
+	return kedastatus.TransformObject(ctx, e.client, logger, scaledJob, status, transform)
+}
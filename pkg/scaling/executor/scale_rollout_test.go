@@ -0,0 +1,134 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+)
+
+func TestGetCanaryJobCount(t *testing.T) {
+	status := &kedav1alpha1.ScaledJobRolloutStatus{}
+	assert.Equal(t, int64(1), getCanaryJobCount(status, 1, 10))
+	assert.Equal(t, int64(2), getCanaryJobCount(status, 20, 10))
+
+	// the jobs created since the canary started are taken into account
+	status = &kedav1alpha1.ScaledJobRolloutStatus{CanaryJobs: 1, StableJobs: 4}
+	assert.Equal(t, int64(0), getCanaryJobCount(status, 4, 10))
+	assert.Equal(t, int64(1), getCanaryJobCount(status, 6, 10))
+	assert.Equal(t, int64(5), getCanaryJobCount(status, 5, 100))
+}
+
+func TestUpdateRollout(t *testing.T) {
+	ctx := context.Background()
+	logger := logf.Log.WithName("RolloutTest")
+
+	scaledJob := getMockScaledJobWithDefaultStrategyAndMeta("test")
+	scaledJob.Generation = 1
+	scaledJob.Spec.Rollout = kedav1alpha1.Rollout{Strategy: kedav1alpha1.RolloutStrategyCanary, Canary: &kedav1alpha1.RolloutCanary{MinFinishedJobs: 2}}
+	scaledJob.Spec.JobTargetRef.Template.Spec.Containers = []corev1.Container{{Name: "job", Image: "job:v1"}}
+
+	newJob := func(name, generation string, conditionType batchv1.JobConditionType) *batchv1.Job {
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   scaledJob.Namespace,
+				Labels:      map[string]string{"scaledjob.keda.sh/name": scaledJob.Name},
+				Annotations: map[string]string{scaledJobGenerationAnnotation: generation},
+			},
+			Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{{Type: conditionType, Status: corev1.ConditionTrue}}},
+		}
+	}
+
+	scaleExecutor := getMockScaleExecutor(nil)
+	scaleExecutor.recorder = record.NewFakeRecorder(10)
+	scaleExecutor.client = fake.NewClientBuilder().WithScheme(scaleExecutor.reconcilerScheme).
+		WithObjects(scaledJob).WithStatusSubresource(scaledJob).Build()
+
+	// the status updates return the stored ScaledJob, the spec changes must be stored
+	updateSpec := func(generation int64, image string) {
+		scaledJob.Generation = generation
+		scaledJob.Spec.JobTargetRef.Template.Spec.Containers[0].Image = image
+		assert.NoError(t, scaleExecutor.client.Update(ctx, scaledJob))
+	}
+
+	// the first generation is stable
+	scaleExecutor.updateRollout(ctx, logger, scaledJob)
+	assert.Equal(t, kedav1alpha1.RolloutPhaseStable, scaledJob.Status.Rollout.Phase)
+	assert.Equal(t, int64(1), scaledJob.Status.Rollout.StableGeneration)
+
+	// a new generation starts a canary
+	updateSpec(2, "job:v2")
+	scaleExecutor.updateRollout(ctx, logger, scaledJob)
+	assert.Equal(t, kedav1alpha1.RolloutPhaseProgressing, scaledJob.Status.Rollout.Phase)
+	assert.Equal(t, int64(2), scaledJob.Status.Rollout.CanaryGeneration)
+	assert.Equal(t, "job:v1", scaledJob.Status.Rollout.StableJobTargetRef.Template.Spec.Containers[0].Image)
+
+	// the jobs are split between the generations
+	jobs := scaleExecutor.generateRolloutJobs(logger, scaledJob, 10, nil)
+	var created []client.Object
+	images := map[string]int{}
+	for _, job := range jobs {
+		images[job.Spec.Template.Spec.Containers[0].Image+"/"+job.Annotations[scaledJobGenerationAnnotation]]++
+		created = append(created, job)
+	}
+	assert.Equal(t, map[string]int{"job:v2/2": 1, "job:v1/1": 9}, images)
+	scaleExecutor.recordRolloutJobs(ctx, logger, scaledJob, created)
+	assert.Equal(t, int64(1), scaledJob.Status.Rollout.CanaryJobs)
+	assert.Equal(t, int64(9), scaledJob.Status.Rollout.StableJobs)
+
+	// not enough finished canary jobs yet
+	assert.NoError(t, scaleExecutor.client.Create(ctx, newJob("canary-1", "2", batchv1.JobComplete)))
+	assert.NoError(t, scaleExecutor.client.Create(ctx, newJob("stable-1", "1", batchv1.JobFailed)))
+	scaleExecutor.updateRollout(ctx, logger, scaledJob)
+	assert.Equal(t, kedav1alpha1.RolloutPhaseProgressing, scaledJob.Status.Rollout.Phase)
+	assert.Equal(t, int64(1), scaledJob.Status.Rollout.CanarySucceededJobs)
+
+	// the canary is rolled back below the success ratio
+	assert.NoError(t, scaleExecutor.client.Create(ctx, newJob("canary-2", "2", batchv1.JobFailed)))
+	scaleExecutor.updateRollout(ctx, logger, scaledJob)
+	assert.Equal(t, kedav1alpha1.RolloutPhaseRolledBack, scaledJob.Status.Rollout.Phase)
+	assert.Equal(t, int64(1), scaledJob.Status.Rollout.StableGeneration)
+	for _, job := range scaleExecutor.generateRolloutJobs(logger, scaledJob, 3, nil) {
+		assert.Equal(t, "job:v1", job.Spec.Template.Spec.Containers[0].Image)
+	}
+
+	// a new generation is promoted above the success ratio
+	updateSpec(3, "job:v3")
+	scaleExecutor.updateRollout(ctx, logger, scaledJob)
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, scaleExecutor.client.Create(ctx, newJob(fmt.Sprintf("canary-3-%d", i), "3", batchv1.JobComplete)))
+	}
+	scaleExecutor.updateRollout(ctx, logger, scaledJob)
+	assert.Equal(t, &kedav1alpha1.ScaledJobRolloutStatus{
+		Phase:              kedav1alpha1.RolloutPhaseStable,
+		StableGeneration:   3,
+		StableJobTargetRef: scaledJob.Spec.JobTargetRef,
+	}, scaledJob.Status.Rollout)
+}
@@ -28,8 +28,10 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	eventingv1alpha1 "github.com/kedacore/keda/v2/apis/eventing/v1alpha1"
 	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
 	"github.com/kedacore/keda/v2/pkg/eventreason"
+	"github.com/kedacore/keda/v2/pkg/scaling/history"
 	"github.com/kedacore/keda/v2/pkg/scaling/resolver"
 	kedastatus "github.com/kedacore/keda/v2/pkg/status"
 )
@@ -46,6 +48,12 @@ func (e *scaleExecutor) RequestScale(ctx context.Context, scaledObject *kedav1al
 		logger.Error(err, "Error getting information on the current Scale")
 		return
 	}
+	// the replicas may have been changed by the HPA or outside of KEDA since the last request
+	if previousReplicas, found := e.scalingHistory.ObserveReplicas(scaledObject.GenerateIdentifier(), currentReplicas); found && previousReplicas != currentReplicas {
+		e.recordTransition(ctx, scaledObject, previousReplicas, currentReplicas, history.ReasonReplicasChanged,
+			"Replicas changed by the HPA or outside of KEDA", options)
+		e.emitReplicasChanged(scaledObject, previousReplicas, currentReplicas, options)
+	}
 	// if the ScaledObject's triggers aren't in the error state,
 	// but ScaledObject.Status.ReadyCondition is set not set to 'true' -> set it back to 'true'
 	readyCondition := scaledObject.Status.Conditions.GetReadyCondition()
@@ -79,6 +87,8 @@ func (e *scaleExecutor) RequestScale(ctx context.Context, scaledObject *kedav1al
 				}
 				return
 			}
+			e.recordTransition(ctx, scaledObject, currentReplicas, *pausedCount, history.ReasonPaused,
+				"Scaled to the paused replicas count", options)
 		}
 		if *pausedCount != currentReplicas || status.PausedReplicaCount == nil {
 			status.PausedReplicaCount = pausedCount
@@ -112,7 +122,7 @@ func (e *scaleExecutor) RequestScale(ctx context.Context, scaledObject *kedav1al
 			// replica count is equal to 0
 
 			// Scale the ScaleTarget up
-			e.scaleFromZeroOrIdle(ctx, logger, scaledObject, currentScale, options.ActiveTriggers)
+			e.scaleFromZeroOrIdle(ctx, logger, scaledObject, currentScale, options)
 		case isError:
 			// some triggers are active, but some responded with error
 
@@ -123,6 +133,7 @@ func (e *scaleExecutor) RequestScale(ctx context.Context, scaledObject *kedav1al
 				if err := e.setReadyCondition(ctx, logger, scaledObject, metav1.ConditionUnknown, "PartialTriggerError", msg); err != nil {
 					logger.Error(err, "error setting ready condition")
 				}
+				e.emit(scaledObject, corev1.EventTypeWarning, eventingv1alpha1.ScaledObjectScalerFailedType, eventreason.KEDAScalerFailed, msg, newTriggersData(options))
 			}
 		default:
 			// triggers are active, but we didn't need to scale (replica count > 0)
@@ -156,6 +167,7 @@ func (e *scaleExecutor) RequestScale(ctx context.Context, scaledObject *kedav1al
 				if err := e.setReadyCondition(ctx, logger, scaledObject, metav1.ConditionFalse, "TriggerError", msg); err != nil {
 					logger.Error(err, "error setting ready condition")
 				}
+				e.emit(scaledObject, corev1.EventTypeWarning, eventingv1alpha1.ScaledObjectScalerFailedType, eventreason.KEDAScalerFailed, msg, newTriggersData(options))
 			}
 		case scaledObject.Spec.IdleReplicaCount != nil && currentReplicas > *scaledObject.Spec.IdleReplicaCount,
 			// there are no active triggers, Idle Replicas mode is enabled
@@ -168,7 +180,7 @@ func (e *scaleExecutor) RequestScale(ctx context.Context, scaledObject *kedav1al
 			// there is no minimum configured or minimum is set to ZERO
 
 			// Try to scale the deployment down, HPA will handle other scale in operations
-			e.scaleToZeroOrIdle(ctx, logger, scaledObject, currentScale)
+			e.scaleToZeroOrIdle(ctx, logger, scaledObject, currentScale, options)
 		case currentReplicas < minReplicas && scaledObject.Spec.IdleReplicaCount == nil:
 			// there are no active triggers
 			// AND
@@ -182,6 +194,8 @@ func (e *scaleExecutor) RequestScale(ctx context.Context, scaledObject *kedav1al
 				logger.Info("Successfully set ScaleTarget replicas count to ScaledObject minReplicaCount",
 					"Original Replicas Count", currentReplicas,
 					"New Replicas Count", *scaledObject.Spec.MinReplicaCount)
+				e.recordTransition(ctx, scaledObject, currentReplicas, *scaledObject.Spec.MinReplicaCount, history.ReasonMinReplicas,
+					"Scaled to the minReplicaCount", options)
 			}
 		default:
 			// there are no active triggers
@@ -217,7 +231,7 @@ func (e *scaleExecutor) RequestScale(ctx context.Context, scaledObject *kedav1al
 
 // An object will be scaled down to 0 only if it's passed its cooldown period
 // or if LastActiveTime is nil and scale in is not paused
-func (e *scaleExecutor) scaleToZeroOrIdle(ctx context.Context, logger logr.Logger, scaledObject *kedav1alpha1.ScaledObject, scale *autoscalingv1.Scale) {
+func (e *scaleExecutor) scaleToZeroOrIdle(ctx context.Context, logger logr.Logger, scaledObject *kedav1alpha1.ScaledObject, scale *autoscalingv1.Scale, options *ScaleExecutorOptions) {
 	if scaledObject.NeedToPauseScaleIn() {
 		// The Pause Scale Down annotation is set so we should not scale down this target
 		logger.Info("Pause Scale Down annotation set on ScaledObject, no scaling down on inactive trigger")
@@ -260,8 +274,11 @@ func (e *scaleExecutor) scaleToZeroOrIdle(ctx context.Context, logger logr.Logge
 			}
 			logger.Info(msg, "Original Replicas Count", currentReplicas, "New Replicas Count", scaleToReplicas)
 
-			e.recorder.Eventf(scaledObject, corev1.EventTypeNormal, eventreason.KEDAScaleTargetDeactivated,
-				"Deactivated %s %s/%s from %d to %d", scaledObject.Status.ScaleTargetKind, scaledObject.Namespace, scaledObject.Spec.ScaleTargetRef.Name, currentReplicas, scaleToReplicas)
+			e.emit(scaledObject, corev1.EventTypeNormal, eventingv1alpha1.ScaledObjectDeactivatedType, eventreason.KEDAScaleTargetDeactivated,
+				fmt.Sprintf("Deactivated %s %s/%s from %d to %d", scaledObject.Status.ScaleTargetKind, scaledObject.Namespace, scaledObject.Spec.ScaleTargetRef.Name, currentReplicas, scaleToReplicas),
+				newScalingData(currentReplicas, scaleToReplicas, options))
+			e.recordTransition(ctx, scaledObject, currentReplicas, scaleToReplicas, history.ReasonDeactivated,
+				"Triggers are not active and the cooldown period has elapsed", options)
 			if err := e.setActiveCondition(ctx, logger, scaledObject, metav1.ConditionFalse, "ScalerNotActive", "Scaling is not performed because triggers are not active"); err != nil {
 				logger.Error(err, "Error in setting active condition")
 				return
@@ -285,7 +302,7 @@ func (e *scaleExecutor) scaleToZeroOrIdle(ctx context.Context, logger logr.Logge
 	}
 }
 
-func (e *scaleExecutor) scaleFromZeroOrIdle(ctx context.Context, logger logr.Logger, scaledObject *kedav1alpha1.ScaledObject, scale *autoscalingv1.Scale, activeTriggers []string) {
+func (e *scaleExecutor) scaleFromZeroOrIdle(ctx context.Context, logger logr.Logger, scaledObject *kedav1alpha1.ScaledObject, scale *autoscalingv1.Scale, options *ScaleExecutorOptions) {
 	if scaledObject.NeedToPauseScaleOut() {
 		// The Pause Scale Out annotation is set so we should not scale up (out) this target
 		logger.Info("Pause Scale Out annotation set on ScaledObject, no scaling out on active trigger")
@@ -313,7 +330,7 @@ func (e *scaleExecutor) scaleFromZeroOrIdle(ctx context.Context, logger logr.Log
 			scaledObject.Spec.ScaleTargetRef.Name,
 			currentReplicas,
 			replicas,
-			strings.Join(activeTriggers, ";"),
+			strings.Join(options.ActiveTriggers, ";"),
 		)
 
 		if scaledObject.NeedToForceActivation() {
@@ -328,12 +345,15 @@ func (e *scaleExecutor) scaleFromZeroOrIdle(ctx context.Context, logger logr.Log
 			)
 		}
 
-		e.recorder.Event(
+		e.emit(
 			scaledObject,
 			corev1.EventTypeNormal,
+			eventingv1alpha1.ScaledObjectActivatedType,
 			eventreason.KEDAScaleTargetActivated,
 			eventMessage,
+			newScalingData(currentReplicas, replicas, options),
 		)
+		e.recordTransition(ctx, scaledObject, currentReplicas, replicas, history.ReasonActivated, eventMessage, options)
 
 		// Scale was successful. Update lastScaleTime and lastActiveTime on the scaledObject
 		if err := e.updateLastActiveTime(ctx, logger, scaledObject); err != nil {
@@ -367,6 +387,35 @@ func (e *scaleExecutor) updateScaleOnScaleTarget(ctx context.Context, scaledObje
 	return currentReplicas, err
 }
 
+// recordTransition records a scale transition of the ScaledObject in the scaling history
+func (e *scaleExecutor) recordTransition(ctx context.Context, scaledObject *kedav1alpha1.ScaledObject, fromReplicas, toReplicas int32, reason, message string, options *ScaleExecutorOptions) {
+	fallbackCondition := scaledObject.Status.Conditions.GetFallbackCondition()
+	transition := history.Transition{
+		FromReplicas: fromReplicas,
+		ToReplicas:   toReplicas,
+		Reason:       reason,
+		Message:      message,
+		Paused:       scaledObject.HasPausedAnnotation(),
+		Fallback:     fallbackCondition.IsTrue(),
+	}
+	if options != nil {
+		transition.ActiveTriggers = options.ActiveTriggers
+		transition.MetricValues = getMetricValues(options)
+	}
+	e.scalingHistory.Record(ctx, scaledObject, "ScaledObject", transition)
+}
+
+// emitReplicasChanged emits the scale out or scale in of the scale target of the ScaledObject by the HPA or outside of KEDA
+func (e *scaleExecutor) emitReplicasChanged(scaledObject *kedav1alpha1.ScaledObject, fromReplicas, toReplicas int32, options *ScaleExecutorOptions) {
+	cloudeventType, reason, action := eventingv1alpha1.ScaledObjectScaledOutType, eventreason.KEDAScaleTargetScaledOut, "Scaled out"
+	if toReplicas < fromReplicas {
+		cloudeventType, reason, action = eventingv1alpha1.ScaledObjectScaledInType, eventreason.KEDAScaleTargetScaledIn, "Scaled in"
+	}
+	e.emit(scaledObject, corev1.EventTypeNormal, cloudeventType, reason,
+		fmt.Sprintf("%s %s %s/%s from %d to %d", action, scaledObject.Status.ScaleTargetKind, scaledObject.Namespace, scaledObject.Spec.ScaleTargetRef.Name, fromReplicas, toReplicas),
+		newScalingData(fromReplicas, toReplicas, options))
+}
+
 // getIdleOrMinimumReplicaCount returns true if the second value returned is from IdleReplicaCount
 // it returns false if it is from MinReplicaCount followed by the actual value
 func getIdleOrMinimumReplicaCount(scaledObject *kedav1alpha1.ScaledObject) (bool, int32) {
@@ -25,12 +25,20 @@ import (
 	"go.uber.org/mock/gomock"
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/metrics/pkg/apis/external_metrics"
+	"k8s.io/utils/ptr"
 
+	eventingv1alpha1 "github.com/kedacore/keda/v2/apis/eventing/v1alpha1"
 	"github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+	"github.com/kedacore/keda/v2/pkg/eventemitter/eventdata"
+	"github.com/kedacore/keda/v2/pkg/eventreason"
 	"github.com/kedacore/keda/v2/pkg/mock/mock_client"
+	"github.com/kedacore/keda/v2/pkg/mock/mock_eventemitter"
 	"github.com/kedacore/keda/v2/pkg/mock/mock_scale"
+	"github.com/kedacore/keda/v2/pkg/scaling/history"
 )
 
 func TestScaleToMinReplicasWhenNotActive(t *testing.T) {
@@ -41,7 +49,7 @@ func TestScaleToMinReplicasWhenNotActive(t *testing.T) {
 	mockScaleInterface := mock_scale.NewMockScaleInterface(ctrl)
 	statusWriter := mock_client.NewMockStatusWriter(ctrl)
 
-	scaleExecutor := NewScaleExecutor(client, mockScaleClient, nil, recorder)
+	scaleExecutor := NewScaleExecutor(client, mockScaleClient, nil, recorder, nil, nil)
 
 	minReplicas := int32(0)
 
@@ -102,7 +110,7 @@ func TestScaleToMinReplicasFromLowerInitialReplicaCount(t *testing.T) {
 	mockScaleInterface := mock_scale.NewMockScaleInterface(ctrl)
 	statusWriter := mock_client.NewMockStatusWriter(ctrl)
 
-	scaleExecutor := NewScaleExecutor(client, mockScaleClient, nil, recorder)
+	scaleExecutor := NewScaleExecutor(client, mockScaleClient, nil, recorder, nil, nil)
 
 	minReplicas := int32(5)
 
@@ -163,7 +171,7 @@ func TestScaleFromMinReplicasWhenActive(t *testing.T) {
 	mockScaleInterface := mock_scale.NewMockScaleInterface(ctrl)
 	statusWriter := mock_client.NewMockStatusWriter(ctrl)
 
-	scaleExecutor := NewScaleExecutor(client, mockScaleClient, nil, recorder)
+	scaleExecutor := NewScaleExecutor(client, mockScaleClient, nil, recorder, nil, nil)
 
 	minReplicas := int32(0)
 
@@ -224,7 +232,7 @@ func TestScaleToIdleReplicasWhenNotActive(t *testing.T) {
 	mockScaleInterface := mock_scale.NewMockScaleInterface(ctrl)
 	statusWriter := mock_client.NewMockStatusWriter(ctrl)
 
-	scaleExecutor := NewScaleExecutor(client, mockScaleClient, nil, recorder)
+	scaleExecutor := NewScaleExecutor(client, mockScaleClient, nil, recorder, nil, nil)
 
 	idleReplicas := int32(0)
 	minReplicas := int32(5)
@@ -287,7 +295,7 @@ func TestScaleFromIdleToMinReplicasWhenActive(t *testing.T) {
 	mockScaleInterface := mock_scale.NewMockScaleInterface(ctrl)
 	statusWriter := mock_client.NewMockStatusWriter(ctrl)
 
-	scaleExecutor := NewScaleExecutor(client, mockScaleClient, nil, recorder)
+	scaleExecutor := NewScaleExecutor(client, mockScaleClient, nil, recorder, nil, nil)
 
 	idleReplicas := int32(0)
 	minReplicas := int32(5)
@@ -350,7 +358,7 @@ func TestScaleToPausedReplicasCount(t *testing.T) {
 	mockScaleInterface := mock_scale.NewMockScaleInterface(ctrl)
 	statusWriter := mock_client.NewMockStatusWriter(ctrl)
 
-	scaleExecutor := NewScaleExecutor(client, mockScaleClient, nil, recorder)
+	scaleExecutor := NewScaleExecutor(client, mockScaleClient, nil, recorder, nil, nil)
 
 	pausedReplicaCount := int32(0)
 	replicaCount := int32(2)
@@ -412,7 +420,8 @@ func TestEventWitTriggerInfo(t *testing.T) {
 	mockScaleInterface := mock_scale.NewMockScaleInterface(ctrl)
 	statusWriter := mock_client.NewMockStatusWriter(ctrl)
 
-	scaleExecutor := NewScaleExecutor(client, mockScaleClient, nil, recorder)
+	scalingHistory := history.NewStore(history.DefaultSize, nil)
+	scaleExecutor := NewScaleExecutor(client, mockScaleClient, nil, recorder, nil, scalingHistory)
 
 	replicaCount := int32(2)
 	idleReplicas := int32(0)
@@ -459,10 +468,89 @@ func TestEventWitTriggerInfo(t *testing.T) {
 	client.EXPECT().Status().Return(statusWriter).AnyTimes()
 	statusWriter.EXPECT().Patch(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 
-	scaleExecutor.RequestScale(context.TODO(), &scaledObject, true, false, &ScaleExecutorOptions{ActiveTriggers: []string{"testTrigger"}})
+	scaleExecutor.RequestScale(context.TODO(), &scaledObject, true, false, &ScaleExecutorOptions{
+		ActiveTriggers: []string{"testTrigger"},
+		Metrics:        []external_metrics.ExternalMetricValue{{MetricName: "s0-queue", Value: *resource.NewQuantity(12, resource.DecimalSI)}},
+	})
 
 	eventstring := <-recorder.Events
 	assert.Equal(t, "Normal KEDAScaleTargetActivated Scaled  namespace/name from 2 to 5, triggered by testTrigger", eventstring)
+
+	transitions := scalingHistory.List(scaledObject.GenerateIdentifier())
+	assert.Len(t, transitions, 1)
+	assert.Equal(t, history.ReasonActivated, transitions[0].Reason)
+	assert.Equal(t, int32(2), transitions[0].FromReplicas)
+	assert.Equal(t, int32(5), transitions[0].ToReplicas)
+	assert.Equal(t, []string{"testTrigger"}, transitions[0].ActiveTriggers)
+	assert.Equal(t, map[string]float64{"s0-queue": 12}, transitions[0].MetricValues)
+}
+
+func TestActivationCloudEventWithScalingData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mock_client.NewMockClient(ctrl)
+	recorder := record.NewFakeRecorder(1)
+	eventEmitter := mock_eventemitter.NewMockEventHandler(ctrl)
+	mockScaleClient := mock_scale.NewMockScalesGetter(ctrl)
+	mockScaleInterface := mock_scale.NewMockScaleInterface(ctrl)
+	statusWriter := mock_client.NewMockStatusWriter(ctrl)
+
+	scaleExecutor := NewScaleExecutor(client, mockScaleClient, nil, recorder, eventEmitter, nil)
+
+	replicaCount := int32(0)
+	minReplicas := int32(2)
+
+	scaledObject := v1alpha1.ScaledObject{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "name",
+			Namespace: "namespace",
+		},
+		Spec: v1alpha1.ScaledObjectSpec{
+			ScaleTargetRef: &v1alpha1.ScaleTarget{
+				Name: "name",
+			},
+			MinReplicaCount: &minReplicas,
+		},
+		Status: v1alpha1.ScaledObjectStatus{
+			ScaleTargetKind: "apps/v1.Deployment",
+			ScaleTargetGVKR: &v1alpha1.GroupVersionKindResource{
+				Group: "apps",
+				Kind:  "Deployment",
+			},
+		},
+	}
+
+	client.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).SetArg(2, appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicaCount,
+		},
+	})
+
+	scale := &autoscalingv1.Scale{
+		Spec: autoscalingv1.ScaleSpec{
+			Replicas: replicaCount,
+		},
+	}
+
+	mockScaleClient.EXPECT().Scales(gomock.Any()).Return(mockScaleInterface).Times(2)
+	mockScaleInterface.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(scale, nil)
+	mockScaleInterface.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Eq(scale), gomock.Any())
+
+	client.EXPECT().Status().Return(statusWriter).AnyTimes()
+	statusWriter.EXPECT().Patch(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	eventEmitter.EXPECT().Emit(&scaledObject, "namespace", "Normal", eventingv1alpha1.ScaledObjectActivatedType, eventreason.KEDAScaleTargetActivated,
+		"Scaled apps/v1.Deployment namespace/name from 0 to 2, triggered by testTrigger",
+		eventdata.ScalingData{
+			FromReplicas:   ptr.To[int32](0),
+			ToReplicas:     ptr.To[int32](2),
+			ActiveTriggers: []string{"testTrigger"},
+			MetricValues:   map[string]float64{"s0-queue": 12},
+		})
+
+	scaleExecutor.RequestScale(context.TODO(), &scaledObject, true, false, &ScaleExecutorOptions{
+		ActiveTriggers: []string{"testTrigger"},
+		Metrics:        []external_metrics.ExternalMetricValue{{MetricName: "s0-queue", Value: *resource.NewQuantity(12, resource.DecimalSI)}},
+	})
 }
 
 func TestNoScaleToMinReplicasWhenNotActiveAndPauseScaleInAnnotationSet(t *testing.T) {
@@ -473,7 +561,7 @@ func TestNoScaleToMinReplicasWhenNotActiveAndPauseScaleInAnnotationSet(t *testin
 	mockScaleInterface := mock_scale.NewMockScaleInterface(ctrl)
 	statusWriter := mock_client.NewMockStatusWriter(ctrl)
 
-	scaleExecutor := NewScaleExecutor(client, mockScaleClient, nil, recorder)
+	scaleExecutor := NewScaleExecutor(client, mockScaleClient, nil, recorder, nil, nil)
 
 	minReplicas := int32(0)
 
@@ -538,7 +626,7 @@ func TestNoScaleToIdleReplicasWhenNotActiveAndPauseScaleInAnnotationSet(t *testi
 	mockScaleInterface := mock_scale.NewMockScaleInterface(ctrl)
 	statusWriter := mock_client.NewMockStatusWriter(ctrl)
 
-	scaleExecutor := NewScaleExecutor(client, mockScaleClient, nil, recorder)
+	scaleExecutor := NewScaleExecutor(client, mockScaleClient, nil, recorder, nil, nil)
 
 	idleReplicas := int32(0)
 	minReplicas := int32(5)
@@ -605,7 +693,7 @@ func TestScaleFromMinReplicasWhenActivationForced(t *testing.T) {
 	mockScaleInterface := mock_scale.NewMockScaleInterface(ctrl)
 	statusWriter := mock_client.NewMockStatusWriter(ctrl)
 
-	scaleExecutor := NewScaleExecutor(client, mockScaleClient, nil, recorder)
+	scaleExecutor := NewScaleExecutor(client, mockScaleClient, nil, recorder, nil, nil)
 
 	minReplicas := int32(0)
 
@@ -669,7 +757,7 @@ func TestNoScaleFromMinReplicasWhenActiveAndPausedScaleOutAnnotationSet(t *testi
 	mockScaleInterface := mock_scale.NewMockScaleInterface(ctrl)
 	statusWriter := mock_client.NewMockStatusWriter(ctrl)
 
-	scaleExecutor := NewScaleExecutor(client, mockScaleClient, nil, recorder)
+	scaleExecutor := NewScaleExecutor(client, mockScaleClient, nil, recorder, nil, nil)
 
 	minReplicas := int32(0)
 
@@ -732,7 +820,7 @@ func TestNoScaleFromIdleReplicasToMinReplicasWhenActiveAndPausedScaleOutAnnotati
 	mockScaleInterface := mock_scale.NewMockScaleInterface(ctrl)
 	statusWriter := mock_client.NewMockStatusWriter(ctrl)
 
-	scaleExecutor := NewScaleExecutor(client, mockScaleClient, nil, recorder)
+	scaleExecutor := NewScaleExecutor(client, mockScaleClient, nil, recorder, nil, nil)
 
 	idleReplicaCount := int32(0)
 	minReplicas := int32(5)
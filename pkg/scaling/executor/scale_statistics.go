@@ -0,0 +1,154 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+	"github.com/kedacore/keda/v2/pkg/metricscollector"
+	kedastatus "github.com/kedacore/keda/v2/pkg/status"
+)
+
+const (
+	defaultScalingStrategyName      = "default"
+	defaultStatisticsUpdateInterval = 30 * time.Second
+)
+
+// statisticsUpdateInterval is the minimum interval between two updates of the statistics
+// in the status of a ScaledJob. A negative value disables the updates.
+var statisticsUpdateInterval = parseStatisticsUpdateInterval()
+
+// parseStatisticsUpdateInterval parses the KEDA_SCALEDJOB_STATISTICS_UPDATE_INTERVAL environment variable
+func parseStatisticsUpdateInterval() time.Duration {
+	value := os.Getenv("KEDA_SCALEDJOB_STATISTICS_UPDATE_INTERVAL")
+	if value == "" {
+		return defaultStatisticsUpdateInterval
+	}
+	interval, err := time.ParseDuration(value)
+	if err != nil {
+		logf.Log.WithName("scaleexecutor").Error(err, "invalid KEDA_SCALEDJOB_STATISTICS_UPDATE_INTERVAL, using the default", "default", defaultStatisticsUpdateInterval)
+		return defaultStatisticsUpdateInterval
+	}
+	return interval
+}
+
+// getFinishedJobStatistics returns the number of succeeded and failed Jobs kept in the history of the ScaledJob
+// and the average duration of the succeeded ones, the duration of the workloads of a WorkloadTemplate isn't known
+func (e *scaleExecutor) getFinishedJobStatistics(ctx context.Context, logger logr.Logger, scaledJob *kedav1alpha1.ScaledJob) (int64, int64, *metav1.Duration) {
+	if scaledJob.Spec.WorkloadTemplate != nil {
+		return e.getWorkloadCount(ctx, scaledJob, workloadSucceeded), e.getWorkloadCount(ctx, scaledJob, workloadFailed), nil
+	}
+
+	opts := []runtimeclient.ListOption{
+		runtimeclient.InNamespace(scaledJob.GetNamespace()),
+		runtimeclient.MatchingLabels(map[string]string{"scaledjob.keda.sh/name": scaledJob.GetName()}),
+	}
+	jobs := &batchv1.JobList{}
+	if err := e.client.List(ctx, jobs, opts...); err != nil {
+		logger.Error(err, "Failed to list the jobs")
+		return 0, 0, nil
+	}
+
+	var succeeded, failed, timed int64
+	var total time.Duration
+	for i := range jobs.Items {
+		job := &jobs.Items[i]
+		switch e.getFinishedJobConditionType(job) {
+		case batchv1.JobComplete:
+			succeeded++
+			if job.Status.StartTime != nil && job.Status.CompletionTime != nil {
+				total += job.Status.CompletionTime.Sub(job.Status.StartTime.Time)
+				timed++
+			}
+		case batchv1.JobFailed:
+			failed++
+		}
+	}
+
+	if timed == 0 {
+		return succeeded, failed, nil
+	}
+	return succeeded, failed, &metav1.Duration{Duration: total / time.Duration(timed)}
+}
+
+// updateStatistics records the runtime statistics of the ScaledJob as metrics on every poll cycle and updates them
+// in its status at most once per statisticsUpdateInterval, the finished Jobs are only listed when the update is due.
+// The status isn't updated if the statistics didn't change, to avoid status churn.
+func (e *scaleExecutor) updateStatistics(ctx context.Context, logger logr.Logger, scaledJob *kedav1alpha1.ScaledJob, runningJobCount, pendingJobCount int64, decision kedav1alpha1.ScaledJobScaleDecision) {
+	if decision.ScalingStrategy == "" {
+		decision.ScalingStrategy = defaultScalingStrategyName
+	}
+	metricscollector.RecordScaledJobJobs(scaledJob.Namespace, scaledJob.Name, "running", runningJobCount)
+	metricscollector.RecordScaledJobJobs(scaledJob.Namespace, scaledJob.Name, "pending", pendingJobCount)
+	metricscollector.RecordScaledJobScaleDecision(scaledJob.Namespace, scaledJob.Name, decision.QueueLength, decision.MaxValue, decision.EffectiveMaxScale)
+
+	if statisticsUpdateInterval < 0 {
+		return
+	}
+	now := time.Now()
+	if previous := scaledJob.Status.Statistics; previous != nil && previous.LastUpdateTime != nil &&
+		now.Sub(previous.LastUpdateTime.Time) < statisticsUpdateInterval {
+		return
+	}
+
+	succeededJobCount, failedJobCount, averageJobDuration := e.getFinishedJobStatistics(ctx, logger, scaledJob)
+	metricscollector.RecordScaledJobJobs(scaledJob.Namespace, scaledJob.Name, "succeeded", succeededJobCount)
+	metricscollector.RecordScaledJobJobs(scaledJob.Namespace, scaledJob.Name, "failed", failedJobCount)
+	if averageJobDuration != nil {
+		metricscollector.RecordScaledJobAverageJobDuration(scaledJob.Namespace, scaledJob.Name, averageJobDuration.Duration)
+	}
+
+	statistics := &kedav1alpha1.ScaledJobStatistics{
+		RunningJobs:        runningJobCount,
+		PendingJobs:        pendingJobCount,
+		SucceededJobs:      succeededJobCount,
+		FailedJobs:         failedJobCount,
+		AverageJobDuration: averageJobDuration,
+		LastScaleDecision:  &decision,
+	}
+	if previous := scaledJob.Status.Statistics; previous != nil {
+		previous = previous.DeepCopy()
+		previous.LastUpdateTime = nil
+		if equality.Semantic.DeepEqual(previous, statistics) {
+			return
+		}
+	}
+
+	statistics.LastUpdateTime = &metav1.Time{Time: now}
+	transform := func(runtimeObj runtimeclient.Object, target interface{}) error {
+		obj, ok := runtimeObj.(*kedav1alpha1.ScaledJob)
+		if !ok {
+			return fmt.Errorf("transform object is not a ScaledJob %v", runtimeObj)
+		}
+		obj.Status.Statistics = target.(*kedav1alpha1.ScaledJobStatistics)
+		return nil
+	}
+	if err := kedastatus.TransformObject(ctx, e.client, logger, scaledJob, statistics, transform); err != nil {
+		logger.Error(err, "Error setting the statistics")
+	}
+}
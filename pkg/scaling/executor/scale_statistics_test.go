@@ -0,0 +1,99 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+)
+
+func TestUpdateStatistics(t *testing.T) {
+	ctx := context.Background()
+	logger := logf.Log.WithName("StatisticsTest")
+
+	scaledJob := getMockScaledJobWithDefaultStrategyAndMeta("test")
+	startTime := metav1.NewTime(time.Now().Add(-time.Hour))
+	newJob := func(name string, duration time.Duration, conditionType batchv1.JobConditionType) *batchv1.Job {
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: scaledJob.Namespace,
+				Labels:    map[string]string{"scaledjob.keda.sh/name": scaledJob.Name},
+			},
+			Status: batchv1.JobStatus{
+				StartTime:  &startTime,
+				Conditions: []batchv1.JobCondition{{Type: conditionType, Status: corev1.ConditionTrue}},
+			},
+		}
+		if conditionType == batchv1.JobComplete {
+			completionTime := metav1.NewTime(startTime.Add(duration))
+			job.Status.CompletionTime = &completionTime
+		}
+		return job
+	}
+
+	scaleExecutor := getMockScaleExecutor(nil)
+	scaleExecutor.client = fake.NewClientBuilder().WithScheme(scaleExecutor.reconcilerScheme).
+		WithObjects(
+			scaledJob,
+			newJob("succeeded-1", time.Minute, batchv1.JobComplete),
+			newJob("succeeded-2", 3*time.Minute, batchv1.JobComplete),
+			newJob("failed", 0, batchv1.JobFailed),
+		).WithStatusSubresource(scaledJob).Build()
+
+	decision := kedav1alpha1.ScaledJobScaleDecision{QueueLength: 12, MaxValue: 10, EffectiveMaxScale: 7}
+	scaleExecutor.updateStatistics(ctx, logger, scaledJob, 3, 1, decision)
+
+	assert.NotNil(t, scaledJob.Status.Statistics.LastUpdateTime)
+	lastUpdateTime := scaledJob.Status.Statistics.LastUpdateTime
+	assert.Equal(t, &kedav1alpha1.ScaledJobStatistics{
+		RunningJobs:        3,
+		PendingJobs:        1,
+		SucceededJobs:      2,
+		FailedJobs:         1,
+		AverageJobDuration: &metav1.Duration{Duration: 2 * time.Minute},
+		LastScaleDecision:  &kedav1alpha1.ScaledJobScaleDecision{QueueLength: 12, MaxValue: 10, EffectiveMaxScale: 7, ScalingStrategy: "default"},
+		LastUpdateTime:     lastUpdateTime,
+	}, scaledJob.Status.Statistics)
+
+	stored := &kedav1alpha1.ScaledJob{}
+	assert.NoError(t, scaleExecutor.client.Get(ctx, client.ObjectKeyFromObject(scaledJob), stored))
+	assert.Equal(t, scaledJob.Status.Statistics.RunningJobs, stored.Status.Statistics.RunningJobs)
+
+	// the status isn't updated again before the update interval elapsed
+	scaleExecutor.updateStatistics(ctx, logger, scaledJob, 4, 0, decision)
+	assert.Equal(t, int64(3), scaledJob.Status.Statistics.RunningJobs)
+	assert.Equal(t, lastUpdateTime, scaledJob.Status.Statistics.LastUpdateTime)
+
+	scaledJob.Status.Statistics.LastUpdateTime = &metav1.Time{Time: time.Now().Add(-statisticsUpdateInterval)}
+	scaleExecutor.updateStatistics(ctx, logger, scaledJob, 4, 0, decision)
+	assert.Equal(t, int64(4), scaledJob.Status.Statistics.RunningJobs)
+	assert.Equal(t, int64(0), scaledJob.Status.Statistics.PendingJobs)
+	assert.NoError(t, scaleExecutor.client.Get(ctx, client.ObjectKeyFromObject(scaledJob), stored))
+	assert.Equal(t, int64(4), stored.Status.Statistics.RunningJobs)
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+	kedastatus "github.com/kedacore/keda/v2/pkg/status"
+)
+
+const (
+	timeWindowsBlackoutReason      = "BlackoutWindow"
+	timeWindowsOutsideActiveReason = "OutsideActiveWindows"
+)
+
+// isJobCreationWithheld returns true when the time windows of the ScaledJob withhold the creation of Jobs,
+// the backlog requested by the triggers meanwhile is reported in the status
+func (e *scaleExecutor) isJobCreationWithheld(ctx context.Context, logger logr.Logger, scaledJob *kedav1alpha1.ScaledJob, backlog int64) bool {
+	withheld, reason := getTimeWindowsState(logger, scaledJob, time.Now())
+
+	var status *kedav1alpha1.ScaledJobTimeWindowsStatus
+	if withheld {
+		status = &kedav1alpha1.ScaledJobTimeWindowsStatus{Withheld: true, Backlog: backlog, Reason: reason}
+	}
+	if !equality.Semantic.DeepEqual(status, scaledJob.Status.TimeWindows) {
+		transform := func(runtimeObj runtimeclient.Object, target interface{}) error {
+			obj, ok := runtimeObj.(*kedav1alpha1.ScaledJob)
+			if !ok {
+				return fmt.Errorf("transform object is not a ScaledJob %v", runtimeObj)
+			}
+			obj.Status.TimeWindows = target.(*kedav1alpha1.ScaledJobTimeWindowsStatus)
+			return nil
+		}
+		if err := kedastatus.TransformObject(ctx, e.client, logger, scaledJob, status, transform); err != nil {
+			logger.Error(err, "Error setting the time windows status")
+		}
+	}
+	return withheld
+}
+
+// getTimeWindowsState returns true and the reason when the creation of Jobs is withheld at the time,
+// the invalid windows are ignored
+func getTimeWindowsState(logger logr.Logger, scaledJob *kedav1alpha1.ScaledJob, now time.Time) (bool, string) {
+	for _, window := range scaledJob.Spec.BlackoutWindows {
+		contains, err := window.Contains(now)
+		if err != nil {
+			logger.Error(err, "Ignoring invalid blackout window", "schedule", window.Schedule)
+			continue
+		}
+		if contains {
+			return true, timeWindowsBlackoutReason
+		}
+	}
+
+	if len(scaledJob.Spec.ActiveWindows) == 0 {
+		return false, ""
+	}
+	for _, window := range scaledJob.Spec.ActiveWindows {
+		contains, err := window.Contains(now)
+		if err != nil {
+			logger.Error(err, "Ignoring invalid active window", "schedule", window.Schedule)
+			continue
+		}
+		if contains {
+			return false, ""
+		}
+	}
+	return true, timeWindowsOutsideActiveReason
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+)
+
+func TestGetTimeWindowsState(t *testing.T) {
+	logger := logf.Log.WithName("TimeWindowsTest")
+	nights := kedav1alpha1.ScaledJobTimeWindow{Schedule: "0 20 * * *", Duration: metav1.Duration{Duration: 10 * time.Hour}}
+	release := kedav1alpha1.ScaledJobTimeWindow{Schedule: "0 23 * * 5", Duration: metav1.Duration{Duration: 2 * time.Hour}}
+	invalid := kedav1alpha1.ScaledJobTimeWindow{Schedule: "every night", Duration: metav1.Duration{Duration: time.Hour}}
+
+	tests := []struct {
+		name             string
+		spec             kedav1alpha1.ScaledJobSpec
+		time             string
+		expectedWithheld bool
+		expectedReason   string
+	}{
+		{
+			name: "no time windows",
+			time: "2025-01-10T12:00:00Z",
+		},
+		{
+			name: "in an active window",
+			spec: kedav1alpha1.ScaledJobSpec{ActiveWindows: []kedav1alpha1.ScaledJobTimeWindow{nights}},
+			time: "2025-01-10T04:00:00Z",
+		},
+		{
+			name:             "outside the active windows",
+			spec:             kedav1alpha1.ScaledJobSpec{ActiveWindows: []kedav1alpha1.ScaledJobTimeWindow{invalid, nights}},
+			time:             "2025-01-10T12:00:00Z",
+			expectedWithheld: true,
+			expectedReason:   timeWindowsOutsideActiveReason,
+		},
+		{
+			name:             "blackout window takes precedence",
+			spec:             kedav1alpha1.ScaledJobSpec{ActiveWindows: []kedav1alpha1.ScaledJobTimeWindow{nights}, BlackoutWindows: []kedav1alpha1.ScaledJobTimeWindow{release}},
+			time:             "2025-01-11T00:30:00Z",
+			expectedWithheld: true,
+			expectedReason:   timeWindowsBlackoutReason,
+		},
+		{
+			name: "invalid blackout window is ignored",
+			spec: kedav1alpha1.ScaledJobSpec{BlackoutWindows: []kedav1alpha1.ScaledJobTimeWindow{invalid}},
+			time: "2025-01-10T12:00:00Z",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			now, _ := time.Parse(time.RFC3339, test.time)
+			withheld, reason := getTimeWindowsState(logger, &kedav1alpha1.ScaledJob{Spec: test.spec}, now)
+			assert.Equal(t, test.expectedWithheld, withheld)
+			assert.Equal(t, test.expectedReason, reason)
+		})
+	}
+}
+
+func TestIsJobCreationWithheld(t *testing.T) {
+	ctx := context.Background()
+	logger := logf.Log.WithName("TimeWindowsTest")
+
+	scaledJob := getMockScaledJobWithDefaultStrategyAndMeta("test")
+	// a window which is always open
+	scaledJob.Spec.BlackoutWindows = []kedav1alpha1.ScaledJobTimeWindow{{Schedule: "* * * * *", Duration: metav1.Duration{Duration: time.Hour}}}
+
+	scaleExecutor := getMockScaleExecutor(nil)
+	scaleExecutor.client = fake.NewClientBuilder().WithScheme(scaleExecutor.reconcilerScheme).
+		WithObjects(scaledJob).WithStatusSubresource(scaledJob).Build()
+
+	assert.True(t, scaleExecutor.isJobCreationWithheld(ctx, logger, scaledJob, 7))
+	assert.Equal(t, &kedav1alpha1.ScaledJobTimeWindowsStatus{Withheld: true, Backlog: 7, Reason: timeWindowsBlackoutReason}, scaledJob.Status.TimeWindows)
+
+	scaledJob.Spec.BlackoutWindows = nil
+	assert.False(t, scaleExecutor.isJobCreationWithheld(ctx, logger, scaledJob, 7))
+	assert.Nil(t, scaledJob.Status.TimeWindows)
+}
@@ -0,0 +1,312 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"sort"
+	"time"
+
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+	"github.com/kedacore/keda/v2/pkg/scalers"
+)
+
+// workloadState is the state of a workload created from the WorkloadTemplate of a ScaledJob
+type workloadState int
+
+const (
+	workloadRunning workloadState = iota
+	workloadPending
+	workloadSucceeded
+	workloadFailed
+)
+
+// workloadStatusEvaluator evaluates the state of the workloads with the status rules of a WorkloadTemplate
+type workloadStatusEvaluator struct {
+	succeeded workloadStatusMatcher
+	failed    workloadStatusMatcher
+	pending   *workloadStatusMatcher
+}
+
+// workloadStatusMatcher matches a workload either by a status condition or by a compiled expression
+type workloadStatusMatcher struct {
+	condition *kedav1alpha1.WorkloadStatusCondition
+	program   *vm.Program
+}
+
+func newWorkloadStatusEvaluator(template *kedav1alpha1.WorkloadTemplate) (*workloadStatusEvaluator, error) {
+	rules := template.GetStatusRules()
+	if rules == nil {
+		return nil, fmt.Errorf("no status rules for the workloads of kind %s", template.Kind)
+	}
+
+	succeeded, err := newWorkloadStatusMatcher(rules.Succeeded)
+	if err != nil {
+		return nil, err
+	}
+	failed, err := newWorkloadStatusMatcher(rules.Failed)
+	if err != nil {
+		return nil, err
+	}
+	evaluator := &workloadStatusEvaluator{succeeded: succeeded, failed: failed}
+	if rules.Pending != nil {
+		pending, err := newWorkloadStatusMatcher(*rules.Pending)
+		if err != nil {
+			return nil, err
+		}
+		evaluator.pending = &pending
+	}
+	return evaluator, nil
+}
+
+func newWorkloadStatusMatcher(rule kedav1alpha1.WorkloadStatusRule) (workloadStatusMatcher, error) {
+	if rule.Condition != nil {
+		return workloadStatusMatcher{condition: rule.Condition}, nil
+	}
+	program, err := kedav1alpha1.CompileWorkloadStatusExpression(rule.Expression)
+	if err != nil {
+		return workloadStatusMatcher{}, fmt.Errorf("error compiling workload status expression %q: %w", rule.Expression, err)
+	}
+	return workloadStatusMatcher{program: program}, nil
+}
+
+// state returns the state of the workload, finished workloads are never pending
+func (ev *workloadStatusEvaluator) state(workload *unstructured.Unstructured) workloadState {
+	switch {
+	case ev.succeeded.matches(workload):
+		return workloadSucceeded
+	case ev.failed.matches(workload):
+		return workloadFailed
+	case ev.pending != nil && ev.pending.matches(workload):
+		return workloadPending
+	default:
+		return workloadRunning
+	}
+}
+
+func (m *workloadStatusMatcher) matches(workload *unstructured.Unstructured) bool {
+	if m.program != nil {
+		// a failing expression, e.g. accessing a field of a missing object, doesn't match
+		result, err := expr.Run(m.program, workload.Object)
+		matched, ok := result.(bool)
+		return err == nil && ok && matched
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(workload.Object, "status", "conditions")
+	status := m.condition.Status
+	if status == "" {
+		status = string(metav1.ConditionTrue)
+	}
+	for _, c := range conditions {
+		condition, ok := c.(map[string]any)
+		if !ok || condition["type"] != m.condition.Type {
+			continue
+		}
+		return condition["status"] == status && (m.condition.Reason == "" || condition["reason"] == m.condition.Reason)
+	}
+	return false
+}
+
+// failureTime returns the time a failed workload failed at, that is the last transition time of the condition
+// matched by the failed rule, or of its latest condition if the rule is an expression.
+// The creation time of the workload is used if it has no such condition
+func (ev *workloadStatusEvaluator) failureTime(workload *unstructured.Unstructured) time.Time {
+	failedAt := workload.GetCreationTimestamp().Time
+	conditions, _, _ := unstructured.NestedSlice(workload.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]any)
+		if !ok || (ev.failed.condition != nil && condition["type"] != ev.failed.condition.Type) {
+			continue
+		}
+		value, _ := condition["lastTransitionTime"].(string)
+		transitionTime, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			continue
+		}
+		if ev.failed.condition != nil {
+			return transitionTime
+		}
+		if transitionTime.After(failedAt) {
+			failedAt = transitionTime
+		}
+	}
+	return failedAt
+}
+
+// listWorkloads lists the workloads created from the WorkloadTemplate of the ScaledJob
+func (e *scaleExecutor) listWorkloads(ctx context.Context, scaledJob *kedav1alpha1.ScaledJob) ([]unstructured.Unstructured, error) {
+	return listWorkloads(ctx, e.client, scaledJob)
+}
+
+func listWorkloads(ctx context.Context, c client.Client, scaledJob *kedav1alpha1.ScaledJob) ([]unstructured.Unstructured, error) {
+	gvk := scaledJob.Spec.WorkloadTemplate.GroupVersionKind()
+	workloads := &unstructured.UnstructuredList{}
+	workloads.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+
+	opts := []client.ListOption{
+		client.InNamespace(scaledJob.GetNamespace()),
+		client.MatchingLabels(map[string]string{"scaledjob.keda.sh/name": scaledJob.GetName()}),
+	}
+	if err := c.List(ctx, workloads, opts...); err != nil {
+		return nil, err
+	}
+	return workloads.Items, nil
+}
+
+// getWorkloadCount returns the number of workloads of the ScaledJob in one of the states
+func (e *scaleExecutor) getWorkloadCount(ctx context.Context, scaledJob *kedav1alpha1.ScaledJob, states ...workloadState) int64 {
+	count, err := countWorkloads(ctx, e.client, scaledJob, states...)
+	if err != nil {
+		e.logger.Error(err, "Failed to count the workloads", "scaledJob.Name", scaledJob.Name, "scaledJob.Namespace", scaledJob.Namespace)
+		return 0
+	}
+	return count
+}
+
+func countWorkloads(ctx context.Context, c client.Client, scaledJob *kedav1alpha1.ScaledJob, states ...workloadState) (int64, error) {
+	evaluator, err := newWorkloadStatusEvaluator(scaledJob.Spec.WorkloadTemplate)
+	if err != nil {
+		return 0, err
+	}
+	workloads, err := listWorkloads(ctx, c, scaledJob)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int64
+	for i := range workloads {
+		state := evaluator.state(&workloads[i])
+		for _, s := range states {
+			if state == s {
+				count++
+				break
+			}
+		}
+	}
+	return count, nil
+}
+
+// generateWorkloads generates scaleTo workloads from the WorkloadTemplate of the ScaledJob,
+// the messages are bound to the first workloads
+func (e *scaleExecutor) generateWorkloads(logger logr.Logger, scaledJob *kedav1alpha1.ScaledJob, scaleTo int64, messages []scalers.ClaimedMessage) ([]*unstructured.Unstructured, error) {
+	template := scaledJob.Spec.WorkloadTemplate
+	object := map[string]any{}
+	if err := json.Unmarshal(template.Template.Raw, &object); err != nil {
+		return nil, fmt.Errorf("error decoding the workloadTemplate template: %w", err)
+	}
+
+	labels, annotations := getJobLabelsAndAnnotations(scaledJob)
+
+	workloads := make([]*unstructured.Unstructured, int(scaleTo))
+	for i := 0; i < int(scaleTo); i++ {
+		workload := &unstructured.Unstructured{Object: object}
+		workload = workload.DeepCopy()
+		workload.SetGroupVersionKind(template.GroupVersionKind())
+		workload.SetName("")
+		workload.SetGenerateName(scaledJob.GetName() + "-")
+		workload.SetNamespace(scaledJob.GetNamespace())
+
+		workloadLabels := workload.GetLabels()
+		if workloadLabels == nil {
+			workloadLabels = map[string]string{}
+		}
+		maps.Copy(workloadLabels, labels)
+		workload.SetLabels(workloadLabels)
+
+		workloadAnnotations := workload.GetAnnotations()
+		if workloadAnnotations == nil {
+			workloadAnnotations = map[string]string{}
+		}
+		maps.Copy(workloadAnnotations, annotations)
+		if i < len(messages) {
+			message := messages[i]
+			value := getBoundMessageValue(logger, scaledJob.Spec.MessageBinding, message)
+			workloadAnnotations = bindMessageAnnotations(workloadAnnotations, scaledJob.Spec.MessageBinding, message, value)
+		}
+		workload.SetAnnotations(workloadAnnotations)
+
+		// Set ScaledJob instance as the owner and controller
+		err := controllerutil.SetControllerReference(scaledJob, workload, e.reconcilerScheme)
+		if err != nil {
+			logger.Error(err, "Failed to set ScaledJob as the owner of the new workload")
+		}
+
+		workloads[i] = workload
+	}
+	return workloads, nil
+}
+
+// cleanUpWorkloads deletes the finished workloads exceeding the history limits,
+// the workloads are deleted from the oldest created
+func (e *scaleExecutor) cleanUpWorkloads(ctx context.Context, logger logr.Logger, scaledJob *kedav1alpha1.ScaledJob) error {
+	evaluator, err := newWorkloadStatusEvaluator(scaledJob.Spec.WorkloadTemplate)
+	if err != nil {
+		return err
+	}
+	workloads, err := e.listWorkloads(ctx, scaledJob)
+	if err != nil {
+		logger.Error(err, "Can not get list of workloads")
+		return err
+	}
+
+	var succeededWorkloads, failedWorkloads []unstructured.Unstructured
+	for _, workload := range workloads {
+		switch evaluator.state(&workload) {
+		case workloadSucceeded:
+			succeededWorkloads = append(succeededWorkloads, workload)
+		case workloadFailed:
+			failedWorkloads = append(failedWorkloads, workload)
+		}
+	}
+
+	successfulJobsHistoryLimit, failedJobsHistoryLimit := getJobsHistoryLimits(scaledJob)
+	if err := e.deleteWorkloadsWithHistoryLimit(ctx, logger, succeededWorkloads, successfulJobsHistoryLimit); err != nil {
+		return err
+	}
+	return e.deleteWorkloadsWithHistoryLimit(ctx, logger, failedWorkloads, failedJobsHistoryLimit)
+}
+
+func (e *scaleExecutor) deleteWorkloadsWithHistoryLimit(ctx context.Context, logger logr.Logger, workloads []unstructured.Unstructured, historyLimit int32) error {
+	if len(workloads) <= int(historyLimit) {
+		return nil
+	}
+
+	sort.Slice(workloads, func(i, j int) bool {
+		iCreationTimestamp, jCreationTimestamp := workloads[i].GetCreationTimestamp(), workloads[j].GetCreationTimestamp()
+		return iCreationTimestamp.Before(&jCreationTimestamp)
+	})
+	deleteWorkloadLength := len(workloads) - int(historyLimit)
+	for _, workload := range workloads[0:deleteWorkloadLength] {
+		err := e.client.Delete(ctx, &workload, client.PropagationPolicy(metav1.DeletePropagationBackground))
+		if err != nil {
+			return err
+		}
+		logger.Info("Remove a workload by reaching the historyLimit", "workload.Name", workload.GetName(), "historyLimit", historyLimit)
+	}
+	return nil
+}
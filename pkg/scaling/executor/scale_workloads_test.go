@@ -0,0 +1,210 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+	"github.com/kedacore/keda/v2/pkg/scalers"
+)
+
+func TestWorkloadStatusEvaluator(t *testing.T) {
+	withStatus := func(status map[string]any) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{"status": status}}
+	}
+	tests := []struct {
+		name     string
+		template kedav1alpha1.WorkloadTemplate
+		workload *unstructured.Unstructured
+		expected workloadState
+	}{
+		{
+			name:     "pod without status is pending",
+			template: kedav1alpha1.WorkloadTemplate{APIVersion: "v1", Kind: "Pod"},
+			workload: &unstructured.Unstructured{Object: map[string]any{}},
+			expected: workloadPending,
+		},
+		{
+			name:     "running pod",
+			template: kedav1alpha1.WorkloadTemplate{APIVersion: "v1", Kind: "Pod"},
+			workload: &unstructured.Unstructured{Object: map[string]any{"status": map[string]any{"phase": "Running"}}},
+			expected: workloadRunning,
+		},
+		{
+			name:     "failed argo workflow",
+			template: kedav1alpha1.WorkloadTemplate{APIVersion: "argoproj.io/v1alpha1", Kind: "Workflow"},
+			workload: &unstructured.Unstructured{Object: map[string]any{"status": map[string]any{"phase": "Error"}}},
+			expected: workloadFailed,
+		},
+		{
+			name:     "succeeded tekton pipelinerun",
+			template: kedav1alpha1.WorkloadTemplate{APIVersion: "tekton.dev/v1", Kind: "PipelineRun"},
+			workload: withStatus(map[string]any{
+				"startTime":  "2025-01-01T00:00:00Z",
+				"conditions": []any{map[string]any{"type": "Succeeded", "status": "True"}},
+			}),
+			expected: workloadSucceeded,
+		},
+		{
+			name:     "started tekton pipelinerun",
+			template: kedav1alpha1.WorkloadTemplate{APIVersion: "tekton.dev/v1", Kind: "PipelineRun"},
+			workload: withStatus(map[string]any{
+				"startTime":  "2025-01-01T00:00:00Z",
+				"conditions": []any{map[string]any{"type": "Succeeded", "status": "Unknown"}},
+			}),
+			expected: workloadRunning,
+		},
+		{
+			name: "custom rules",
+			template: kedav1alpha1.WorkloadTemplate{
+				APIVersion: "example.com/v1",
+				Kind:       "Task",
+				Status: &kedav1alpha1.WorkloadStatusRules{
+					Succeeded: kedav1alpha1.WorkloadStatusRule{Condition: &kedav1alpha1.WorkloadStatusCondition{Type: "Done", Reason: "Completed"}},
+					Failed:    kedav1alpha1.WorkloadStatusRule{Condition: &kedav1alpha1.WorkloadStatusCondition{Type: "Done", Reason: "Crashed"}},
+				},
+			},
+			workload: withStatus(map[string]any{
+				"conditions": []any{map[string]any{"type": "Done", "status": "True", "reason": "Crashed"}},
+			}),
+			expected: workloadFailed,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			evaluator, err := newWorkloadStatusEvaluator(&test.template)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, evaluator.state(test.workload))
+		})
+	}
+
+	_, err := newWorkloadStatusEvaluator(&kedav1alpha1.WorkloadTemplate{APIVersion: "example.com/v1", Kind: "Task"})
+	assert.Error(t, err)
+}
+
+func TestWorkloadFailureTime(t *testing.T) {
+	createdAt := metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	tests := []struct {
+		name       string
+		template   kedav1alpha1.WorkloadTemplate
+		conditions []any
+		expected   time.Time
+	}{
+		{
+			name:     "failed condition",
+			template: kedav1alpha1.WorkloadTemplate{APIVersion: "tekton.dev/v1", Kind: "PipelineRun"},
+			conditions: []any{
+				map[string]any{"type": "Other", "status": "True", "lastTransitionTime": "2025-01-01T03:00:00Z"},
+				map[string]any{"type": "Succeeded", "status": "False", "lastTransitionTime": "2025-01-01T02:00:00Z"},
+			},
+			expected: time.Date(2025, 1, 1, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "latest condition of an expression",
+			template: kedav1alpha1.WorkloadTemplate{APIVersion: "v1", Kind: "Pod"},
+			conditions: []any{
+				map[string]any{"type": "PodScheduled", "status": "True", "lastTransitionTime": "2025-01-01T00:01:00Z"},
+				map[string]any{"type": "Ready", "status": "False", "lastTransitionTime": "2025-01-01T01:00:00Z"},
+			},
+			expected: time.Date(2025, 1, 1, 1, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "creation time without condition",
+			template: kedav1alpha1.WorkloadTemplate{APIVersion: "v1", Kind: "Pod"},
+			expected: createdAt.Time,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			evaluator, err := newWorkloadStatusEvaluator(&test.template)
+			assert.NoError(t, err)
+			workload := &unstructured.Unstructured{Object: map[string]any{"status": map[string]any{"conditions": test.conditions}}}
+			workload.SetCreationTimestamp(createdAt)
+			assert.True(t, test.expected.Equal(evaluator.failureTime(workload)), "got %s", evaluator.failureTime(workload))
+		})
+	}
+}
+
+func TestWorkloadTemplateLifecycle(t *testing.T) {
+	ctx := context.Background()
+	logger := logf.Log.WithName("WorkloadTemplateLifecycleTest")
+	scaleExecutor := getMockScaleExecutor(nil)
+	client := fake.NewClientBuilder().WithScheme(scaleExecutor.reconcilerScheme).Build()
+	scaleExecutor.client = client
+
+	successfulJobsHistoryLimit := int32(0)
+	scaledJob := getMockScaledJobWithDefaultStrategyAndMeta("test")
+	scaledJob.Spec.JobTargetRef = nil
+	scaledJob.Spec.SuccessfulJobsHistoryLimit = &successfulJobsHistoryLimit
+	scaledJob.Spec.MessageBinding = &kedav1alpha1.ScaledJobMessageBinding{InjectAs: kedav1alpha1.MessageBindingInjectAsAnnotation}
+	scaledJob.Spec.WorkloadTemplate = &kedav1alpha1.WorkloadTemplate{
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Template: runtime.RawExtension{Raw: []byte(`{
+			"metadata": {"labels": {"team": "ci"}},
+			"spec": {"restartPolicy": "Never", "containers": [{"name": "runner", "image": "runner"}]}
+		}`)},
+	}
+	claimer := &fakeMessageClaimer{messages: []scalers.ClaimedMessage{{ID: "id-1"}, {ID: "id-2"}}}
+	options := &ScaleExecutorOptions{
+		GetMessageClaimer: func(context.Context) (scalers.MessageClaimer, error) {
+			return claimer, nil
+		},
+	}
+
+	assert.Equal(t, int64(2), scaleExecutor.createJobs(ctx, logger, scaledJob, 2, 2, options))
+	assert.Equal(t, []string{"id-1", "id-2"}, claimer.acked)
+
+	pods := &corev1.PodList{}
+	assert.NoError(t, client.List(ctx, pods))
+	assert.Len(t, pods.Items, 2)
+	for _, pod := range pods.Items {
+		assert.Equal(t, "ci", pod.Labels["team"])
+		assert.Equal(t, "test", pod.Labels["scaledjob.keda.sh/name"])
+		assert.Equal(t, pod.Annotations[kedav1alpha1.ScaledJobMessageIDAnnotation], pod.Annotations[kedav1alpha1.ScaledJobMessageAnnotation])
+		assert.Equal(t, "runner", pod.Spec.Containers[0].Name)
+		assert.Equal(t, "test", pod.OwnerReferences[0].Name)
+	}
+	assert.Equal(t, int64(2), scaleExecutor.getRunningJobCount(ctx, scaledJob))
+	assert.Equal(t, int64(2), scaleExecutor.getPendingJobCount(ctx, scaledJob))
+
+	pods.Items[0].Status.Phase = corev1.PodSucceeded
+	assert.NoError(t, client.Status().Update(ctx, &pods.Items[0]))
+	pods.Items[1].Status.Phase = corev1.PodRunning
+	assert.NoError(t, client.Status().Update(ctx, &pods.Items[1]))
+	assert.Equal(t, int64(1), scaleExecutor.getRunningJobCount(ctx, scaledJob))
+	assert.Equal(t, int64(0), scaleExecutor.getPendingJobCount(ctx, scaledJob))
+
+	// the succeeded pod exceeds the successful history limit
+	assert.NoError(t, scaleExecutor.cleanUp(ctx, scaledJob))
+	assert.NoError(t, client.List(ctx, pods))
+	assert.Len(t, pods.Items, 1)
+	assert.Equal(t, corev1.PodRunning, pods.Items[0].Status.Phase)
+}
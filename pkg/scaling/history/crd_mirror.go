@@ -0,0 +1,148 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=keda.sh,resources=scalinghistories;scalinghistories/status,verbs=get;list;watch;create;update;patch
+
+// CRDMirror mirrors the transitions to a ScalingHistory owned by the ScaledObject or ScaledJob,
+// so the history survives operator restarts and is garbage collected with its owner
+type CRDMirror struct {
+	client client.Client
+	size   int
+}
+
+// NewCRDMirror returns a CRDMirror writing ScalingHistory objects with client, keeping up to size transitions per object
+func NewCRDMirror(client client.Client, size int) *CRDMirror {
+	if size <= 0 {
+		size = DefaultSize
+	}
+	return &CRDMirror{client: client, size: size}
+}
+
+// ScalingHistoryName returns the name of the ScalingHistory of the object, e.g. scaledobject-my-app
+func ScalingHistoryName(kind, name string) string {
+	return fmt.Sprintf("%s-%s", strings.ToLower(kind), name)
+}
+
+// Mirror writes the transitions to the status of the ScalingHistory of the object, after the transitions already stored
+func (m *CRDMirror) Mirror(ctx context.Context, object client.Object, transitions []Transition) error {
+	gvk, err := apiutil.GVKForObject(object, m.client.Scheme())
+	if err != nil {
+		return err
+	}
+
+	scalingHistory := &kedav1alpha1.ScalingHistory{}
+	key := types.NamespacedName{Namespace: object.GetNamespace(), Name: ScalingHistoryName(gvk.Kind, object.GetName())}
+	err = m.client.Get(ctx, key, scalingHistory)
+	switch {
+	case apierrors.IsNotFound(err):
+		scalingHistory = &kedav1alpha1.ScalingHistory{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+			Spec: kedav1alpha1.ScalingHistorySpec{
+				ScalableObjectRef: kedav1alpha1.ScalableObjectReference{
+					Kind: gvk.Kind,
+					Name: object.GetName(),
+				},
+			},
+		}
+		if err := controllerutil.SetOwnerReference(object, scalingHistory, m.client.Scheme()); err != nil {
+			return err
+		}
+		if err := m.client.Create(ctx, scalingHistory); err != nil {
+			return err
+		}
+	case err != nil:
+		return err
+	}
+
+	scalingHistory.Status = mergeScalingHistoryStatus(scalingHistory.Status, toScalingHistoryStatus(transitions), m.size)
+	return m.client.Status().Update(ctx, scalingHistory)
+}
+
+// mergeScalingHistoryStatus appends the transitions of current to the ones of stored recorded before them,
+// e.g. before the operator restarted, and keeps the latest size transitions
+func mergeScalingHistoryStatus(stored, current kedav1alpha1.ScalingHistoryStatus, size int) kedav1alpha1.ScalingHistoryStatus {
+	if len(current.Transitions) == 0 {
+		return stored
+	}
+	// the stored times are truncated to the second, so the stored transitions of the same second
+	// as the first current transition are considered as already in current
+	first := current.Transitions[0].Time.Truncate(time.Second)
+	var transitions []kedav1alpha1.ScalingTransition
+	for _, transition := range stored.Transitions {
+		if transition.Time.Time.Before(first) {
+			transitions = append(transitions, transition)
+		}
+	}
+	transitions = append(transitions, current.Transitions...)
+	if len(transitions) > size {
+		transitions = transitions[len(transitions)-size:]
+	}
+	return kedav1alpha1.ScalingHistoryStatus{
+		Transitions:        transitions,
+		LastTransitionTime: current.LastTransitionTime,
+	}
+}
+
+// toScalingHistoryStatus converts the transitions to the status of a ScalingHistory
+func toScalingHistoryStatus(transitions []Transition) kedav1alpha1.ScalingHistoryStatus {
+	status := kedav1alpha1.ScalingHistoryStatus{}
+	for _, transition := range transitions {
+		var metricValues map[string]string
+		if len(transition.MetricValues) > 0 {
+			metricValues = make(map[string]string, len(transition.MetricValues))
+			for name, value := range transition.MetricValues {
+				metricValues[name] = strconv.FormatFloat(value, 'f', -1, 64)
+			}
+		}
+		status.Transitions = append(status.Transitions, kedav1alpha1.ScalingTransition{
+			Time:           metav1.NewTime(transition.Time),
+			FromReplicas:   transition.FromReplicas,
+			ToReplicas:     transition.ToReplicas,
+			Reason:         transition.Reason,
+			Message:        transition.Message,
+			ActiveTriggers: transition.ActiveTriggers,
+			MetricValues:   metricValues,
+			Paused:         transition.Paused,
+			Fallback:       transition.Fallback,
+		})
+	}
+	if len(transitions) > 0 {
+		status.LastTransitionTime = &metav1.Time{Time: transitions[len(transitions)-1].Time}
+	}
+	return status
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+)
+
+var log = logf.Log.WithName("scaling_history")
+
+// DefaultSize is the default number of transitions kept per ScaledObject or ScaledJob
+const DefaultSize = 50
+
+// Reasons of the scale transitions
+const (
+	// ReasonActivated is used when KEDA scales the target from zero or idle replicas because triggers are active
+	ReasonActivated = "Activated"
+	// ReasonDeactivated is used when KEDA scales the target to zero or idle replicas because triggers are not active
+	ReasonDeactivated = "Deactivated"
+	// ReasonMinReplicas is used when KEDA scales the target to the minimum replica count
+	ReasonMinReplicas = "MinReplicas"
+	// ReasonPaused is used when KEDA scales the target to the paused replica count
+	ReasonPaused = "Paused"
+	// ReasonReplicasChanged is used when the replicas of the target were changed by the HPA or outside of KEDA
+	ReasonReplicasChanged = "ReplicasChanged"
+	// ReasonJobsCreated is used when a ScaledJob creates new Jobs
+	ReasonJobsCreated = "JobsCreated"
+)
+
+// Transition is a scale transition of a ScaledObject or a ScaledJob
+type Transition struct {
+	Time time.Time
+	// FromReplicas and ToReplicas are the replicas of the scale target, or the running Jobs of a ScaledJob,
+	// before and after the transition
+	FromReplicas   int32
+	ToReplicas     int32
+	Reason         string
+	Message        string
+	ActiveTriggers []string
+	MetricValues   map[string]float64
+	Paused         bool
+	Fallback       bool
+}
+
+// Mirror persists the transitions of a ScaledObject or a ScaledJob outside of the operator,
+// the transitions persisted before the operator started are kept
+type Mirror interface {
+	Mirror(ctx context.Context, object client.Object, transitions []Transition) error
+}
+
+// mirrorRequest is the latest history of an object waiting to be mirrored
+type mirrorRequest struct {
+	object      client.Object
+	kind        string
+	transitions []Transition
+}
+
+// Store keeps the latest scale transitions of each ScaledObject and ScaledJob in a bounded ring buffer.
+// A nil Store doesn't record anything.
+type Store struct {
+	size     int
+	mirror   Mirror
+	buffers  map[string]*ring
+	replicas map[string]int32
+	pending  map[string]mirrorRequest
+	notify   chan struct{}
+	lock     *sync.RWMutex
+}
+
+// NewStore returns an empty Store keeping up to size transitions per object,
+// the transitions are also mirrored by mirror, which can be nil, once the Store is started
+func NewStore(size int, mirror Mirror) *Store {
+	if size <= 0 {
+		size = DefaultSize
+	}
+	return &Store{
+		size:     size,
+		mirror:   mirror,
+		buffers:  map[string]*ring{},
+		replicas: map[string]int32{},
+		pending:  map[string]mirrorRequest{},
+		notify:   make(chan struct{}, 1),
+		lock:     &sync.RWMutex{},
+	}
+}
+
+// Start implements manager.Runnable, it mirrors the recorded transitions until the context is canceled,
+// so the scaling path doesn't wait for the mirror. Only the latest history of each object is mirrored.
+func (s *Store) Start(ctx context.Context) error {
+	for {
+		select {
+		case <-s.notify:
+			s.mirrorPending(ctx)
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (s *Store) mirrorPending(ctx context.Context) {
+	s.lock.Lock()
+	pending := s.pending
+	s.pending = map[string]mirrorRequest{}
+	s.lock.Unlock()
+
+	for _, request := range pending {
+		if err := s.mirror.Mirror(ctx, request.object, request.transitions); err != nil {
+			log.Error(err, "error mirroring scaling history", "kind", request.kind, "namespace", request.object.GetNamespace(), "name", request.object.GetName())
+		}
+	}
+}
+
+// Record adds the transition to the history of the object, the oldest transition is dropped when the history is full
+func (s *Store) Record(_ context.Context, object client.Object, kind string, transition Transition) {
+	if s == nil {
+		return
+	}
+	if transition.Time.IsZero() {
+		transition.Time = time.Now()
+	}
+	identifier := kedav1alpha1.GenerateIdentifier(kind, object.GetNamespace(), object.GetName())
+
+	s.lock.Lock()
+	buffer, ok := s.buffers[identifier]
+	if !ok {
+		buffer = newRing(s.size)
+		s.buffers[identifier] = buffer
+	}
+	buffer.add(transition)
+	s.replicas[identifier] = transition.ToReplicas
+	if s.mirror != nil {
+		// the object is copied as it's mirrored from another goroutine
+		s.pending[identifier] = mirrorRequest{object: object.DeepCopyObject().(client.Object), kind: kind, transitions: buffer.list()}
+	}
+	s.lock.Unlock()
+
+	if s.mirror != nil {
+		select {
+		case s.notify <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// ObserveReplicas records the current replicas of the scale target of the object and returns the previously known replicas.
+// The second return value is false if the replicas haven't been observed or recorded before.
+func (s *Store) ObserveReplicas(identifier string, replicas int32) (int32, bool) {
+	if s == nil {
+		return 0, false
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	previous, ok := s.replicas[identifier]
+	s.replicas[identifier] = replicas
+	return previous, ok
+}
+
+// List returns the transitions of the object identified by identifier, from the oldest to the latest
+func (s *Store) List(identifier string) []Transition {
+	if s == nil {
+		return nil
+	}
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	buffer, ok := s.buffers[identifier]
+	if !ok {
+		return nil
+	}
+	return buffer.list()
+}
+
+// Delete drops the history of the object identified by identifier
+func (s *Store) Delete(identifier string) {
+	if s == nil {
+		return
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.buffers, identifier)
+	delete(s.replicas, identifier)
+	delete(s.pending, identifier)
+}
+
+// ring is a fixed size circular buffer of transitions
+type ring struct {
+	transitions []Transition
+	next        int
+	full        bool
+}
+
+func newRing(size int) *ring {
+	return &ring{transitions: make([]Transition, size)}
+}
+
+func (r *ring) add(transition Transition) {
+	r.transitions[r.next] = transition
+	r.next = (r.next + 1) % len(r.transitions)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// list returns a copy of the transitions, from the oldest to the latest
+func (r *ring) list() []Transition {
+	if !r.full {
+		return append([]Transition{}, r.transitions[:r.next]...)
+	}
+	return append(append([]Transition{}, r.transitions[r.next:]...), r.transitions[:r.next]...)
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+)
+
+type fakeMirror struct {
+	transitions []Transition
+}
+
+func (m *fakeMirror) Mirror(_ context.Context, _ client.Object, transitions []Transition) error {
+	m.transitions = transitions
+	return nil
+}
+
+func TestStoreKeepsLatestTransitions(t *testing.T) {
+	mirror := &fakeMirror{}
+	store := NewStore(3, mirror)
+	scaledObject := &kedav1alpha1.ScaledObject{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+
+	for i := int32(0); i < 5; i++ {
+		store.Record(context.TODO(), scaledObject, "ScaledObject", Transition{FromReplicas: i, ToReplicas: i + 1, Reason: ReasonReplicasChanged})
+	}
+
+	transitions := store.List(scaledObject.GenerateIdentifier())
+	assert.Len(t, transitions, 3)
+	for i, transition := range transitions {
+		assert.Equal(t, int32(i+2), transition.FromReplicas)
+		assert.False(t, transition.Time.IsZero())
+	}
+	// the transitions are mirrored asynchronously, only the latest history is mirrored
+	assert.Empty(t, mirror.transitions)
+	store.mirrorPending(context.TODO())
+	assert.Equal(t, transitions, mirror.transitions)
+	assert.Empty(t, store.List(kedav1alpha1.GenerateIdentifier("ScaledJob", "default", "test")))
+
+	store.Delete(scaledObject.GenerateIdentifier())
+	assert.Empty(t, store.List(scaledObject.GenerateIdentifier()))
+}
+
+func TestStoreObserveReplicas(t *testing.T) {
+	store := NewStore(DefaultSize, nil)
+	scaledObject := &kedav1alpha1.ScaledObject{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+	identifier := scaledObject.GenerateIdentifier()
+
+	_, found := store.ObserveReplicas(identifier, 2)
+	assert.False(t, found)
+	previous, found := store.ObserveReplicas(identifier, 4)
+	assert.True(t, found)
+	assert.Equal(t, int32(2), previous)
+
+	// a recorded transition updates the known replicas
+	store.Record(context.TODO(), scaledObject, "ScaledObject", Transition{FromReplicas: 4, ToReplicas: 0, Reason: ReasonDeactivated})
+	previous, _ = store.ObserveReplicas(identifier, 0)
+	assert.Equal(t, int32(0), previous)
+}
+
+func TestNilStore(t *testing.T) {
+	var store *Store
+	store.Record(context.TODO(), &kedav1alpha1.ScaledObject{}, "ScaledObject", Transition{})
+	_, found := store.ObserveReplicas("scaledobject.default.test", 1)
+	assert.False(t, found)
+	assert.Nil(t, store.List("scaledobject.default.test"))
+	store.Delete("scaledobject.default.test")
+}
+
+func TestCRDMirrorKeepsStoredTransitions(t *testing.T) {
+	scheme := runtime.NewScheme()
+	assert.NoError(t, kedav1alpha1.AddToScheme(scheme))
+	client := fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&kedav1alpha1.ScalingHistory{}).Build()
+	mirror := NewCRDMirror(client, 3)
+	scaledObject := &kedav1alpha1.ScaledObject{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default", UID: "uid"}}
+	start := time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	before := []Transition{
+		{Time: start, FromReplicas: 0, ToReplicas: 1},
+		{Time: start.Add(time.Minute), FromReplicas: 1, ToReplicas: 2},
+	}
+	assert.NoError(t, mirror.Mirror(context.TODO(), scaledObject, before))
+
+	// after a restart the store only knows the new transitions
+	after := []Transition{{Time: start.Add(2 * time.Minute), FromReplicas: 2, ToReplicas: 3}}
+	assert.NoError(t, mirror.Mirror(context.TODO(), scaledObject, after))
+	after = append(after, Transition{Time: start.Add(3 * time.Minute), FromReplicas: 3, ToReplicas: 4})
+	assert.NoError(t, mirror.Mirror(context.TODO(), scaledObject, after))
+
+	scalingHistory := &kedav1alpha1.ScalingHistory{}
+	assert.NoError(t, client.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: "scaledobject-test"}, scalingHistory))
+	var toReplicas []int32
+	for _, transition := range scalingHistory.Status.Transitions {
+		toReplicas = append(toReplicas, transition.ToReplicas)
+	}
+	// the oldest transition is dropped to keep the history bounded
+	assert.Equal(t, []int32{2, 3, 4}, toReplicas)
+	assert.True(t, start.Add(3*time.Minute).Equal(scalingHistory.Status.LastTransitionTime.Time))
+}
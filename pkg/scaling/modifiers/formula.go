@@ -27,6 +27,7 @@ package modifiers
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/expr-lang/expr"
@@ -36,6 +37,7 @@ import (
 
 	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
 	"github.com/kedacore/keda/v2/pkg/scaling/cache"
+	"github.com/kedacore/keda/v2/pkg/scaling/scaledjob"
 )
 
 // HandleScalingModifiers is the parent function for scalingModifiers structure.
@@ -67,6 +69,75 @@ func HandleScalingModifiers(so *kedav1alpha1.ScaledObject, metrics []external_me
 	return metrics
 }
 
+// HandleScaledJobScalingModifiers is the ScaledJob counterpart of HandleScalingModifiers.
+// The queue lengths of the named triggers are combined by the formula into a single
+// composite metric, the number of jobs requested is its value divided by the target.
+// If a trigger falls back, the fallback metrics are returned instead of the formula.
+func HandleScaledJobScalingModifiers(sj *kedav1alpha1.ScaledJob, scalersMetrics []scaledjob.ScalerMetrics, cacheObj *cache.ScalersCache, log logr.Logger) []scaledjob.ScalerMetrics {
+	if sj == nil || !sj.IsUsingModifiers() {
+		return scalersMetrics
+	}
+
+	var fallbackMetrics []scaledjob.ScalerMetrics
+	for _, metrics := range scalersMetrics {
+		if metrics.IsFallback {
+			fallbackMetrics = append(fallbackMetrics, metrics)
+		}
+	}
+	if len(fallbackMetrics) > 0 {
+		return fallbackMetrics
+	}
+
+	metrics, err := calculateScaledJobScalingModifiersFormula(*sj.Spec.ScalingModifiers, scalersMetrics, cacheObj)
+	if err != nil {
+		log.Error(err, "error applying custom scalingModifiers.Formula")
+		return nil
+	}
+	log.V(1).Info("returned metrics after formula is applied", "metrics", metrics)
+	return []scaledjob.ScalerMetrics{metrics}
+}
+
+// calculateScaledJobScalingModifiersFormula calculates the custom formula with the
+// queue lengths of the triggers and returns the composite metric of the ScaledJob
+func calculateScaledJobScalingModifiersFormula(sm kedav1alpha1.ScalingModifiers, scalersMetrics []scaledjob.ScalerMetrics, cacheObj *cache.ScalersCache) (scaledjob.ScalerMetrics, error) {
+	if cacheObj == nil || cacheObj.CompiledFormula == nil {
+		return scaledjob.ScalerMetrics{}, fmt.Errorf("cached compiled formula is nil during its calculation")
+	}
+
+	data := make(map[string]float64)
+	for _, metrics := range scalersMetrics {
+		if metrics.TriggerName != "" {
+			data[metrics.TriggerName] = metrics.QueueLength
+		}
+	}
+
+	// run expression with precompiled formula and real data
+	tmp, err := expr.Run(cacheObj.CompiledFormula, data)
+	if err != nil {
+		return scaledjob.ScalerMetrics{}, fmt.Errorf("error trying to run custom formula: %w", err)
+	}
+	queueLength := tmp.(float64)
+
+	target, err := strconv.ParseFloat(sm.Target, 64)
+	if err != nil || target <= 0 {
+		return scaledjob.ScalerMetrics{}, fmt.Errorf("scalingModifiers.Target parsing error %w", err)
+	}
+	activationValue := float64(0)
+	if sm.ActivationTarget != "" {
+		activationValue, err = strconv.ParseFloat(sm.ActivationTarget, 64)
+		if err != nil {
+			return scaledjob.ScalerMetrics{}, fmt.Errorf("scalingModifiers.ActivationTarget parsing error %w", err)
+		}
+	}
+
+	return scaledjob.ScalerMetrics{
+		QueueLength: queueLength,
+		MaxValue:    max(0, queueLength/target),
+		IsActive:    queueLength > activationValue,
+		TriggerName: kedav1alpha1.CompositeMetricName,
+	}, nil
+}
+
 // ArrayContainsElement determines whether array 'arr' contains element 'el'
 func ArrayContainsElement(el string, arr []string) bool {
 	for _, item := range arr {
@@ -0,0 +1,152 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package polling
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"k8s.io/metrics/pkg/apis/external_metrics"
+
+	"github.com/kedacore/keda/v2/pkg/scalers"
+)
+
+// schedulingTolerance absorbs the jitter of the scale loop, so a metric due slightly
+// after the current iteration isn't postponed to the next one
+const schedulingTolerance = time.Second
+
+// Config is the polling configuration of a trigger
+type Config struct {
+	// Interval is the polling interval of the trigger
+	Interval time.Duration
+	// MaxInterval is the maximum interval the polling can back off to, zero if the polling isn't adaptive
+	MaxInterval time.Duration
+	// BackoffFactor multiplies the interval each time the metrics are unchanged
+	BackoffFactor float64
+}
+
+func (c Config) isAdaptive() bool {
+	return c.MaxInterval > c.Interval && c.BackoffFactor > 1
+}
+
+// Result is the outcome of polling a trigger metric
+type Result struct {
+	Metrics  []external_metrics.ExternalMetricValue
+	IsActive bool
+	Err      error
+	// PolledAt is the time the scaler was queried
+	PolledAt time.Time
+}
+
+type metricState struct {
+	config   Config
+	interval time.Duration
+	nextPoll time.Time
+	last     Result
+}
+
+// Scheduler keeps track of when the trigger metrics of the scalable objects have to be polled
+// and of their last results, which are used until they are due again. A nil Scheduler polls the metrics every time.
+type Scheduler struct {
+	metrics map[string]map[string]*metricState
+	lock    *sync.RWMutex
+}
+
+// NewScheduler returns an empty Scheduler
+func NewScheduler() *Scheduler {
+	return &Scheduler{
+		metrics: map[string]map[string]*metricState{},
+		lock:    &sync.RWMutex{},
+	}
+}
+
+// Last returns the last result of the metric if the metric isn't due for polling at now,
+// the second return value is false if the metric has to be polled
+func (s *Scheduler) Last(scalableObjectIdentifier, metricName string, config Config, now time.Time) (Result, bool) {
+	if s == nil {
+		return Result{}, false
+	}
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	state, found := s.metrics[scalableObjectIdentifier][metricName]
+	if !found || state.config != config || !now.Add(schedulingTolerance).Before(state.nextPoll) {
+		return Result{}, false
+	}
+	return state.last, true
+}
+
+// Record stores the result of polling the metric and schedules the next poll. With an adaptive config,
+// the interval is multiplied by the backoff factor while the metrics are unchanged and it's reset as soon as they change.
+// A rate limit returned by the scaler postpones the next poll until the metric source accepts requests again.
+func (s *Scheduler) Record(scalableObjectIdentifier, metricName string, config Config, result Result) {
+	if s == nil {
+		return
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, found := s.metrics[scalableObjectIdentifier]; !found {
+		s.metrics[scalableObjectIdentifier] = map[string]*metricState{}
+	}
+	previous, found := s.metrics[scalableObjectIdentifier][metricName]
+	if found && previous.config != config {
+		found = false
+	}
+
+	interval := config.Interval
+	if found && config.isAdaptive() && result.Err == nil && previous.last.Err == nil && !hasChanged(previous.last, result) {
+		interval = min(time.Duration(float64(previous.interval)*config.BackoffFactor), config.MaxInterval)
+	}
+
+	wait := interval
+	var rateLimitErr *scalers.RateLimitError
+	if errors.As(result.Err, &rateLimitErr) {
+		wait = max(wait, rateLimitErr.RetryAfter)
+	}
+
+	s.metrics[scalableObjectIdentifier][metricName] = &metricState{
+		config:   config,
+		interval: interval,
+		nextPoll: result.PolledAt.Add(wait),
+		last:     result,
+	}
+}
+
+// Delete removes all the metrics of the scalable object
+func (s *Scheduler) Delete(scalableObjectIdentifier string) {
+	if s == nil {
+		return
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.metrics, scalableObjectIdentifier)
+}
+
+func hasChanged(previous, current Result) bool {
+	if previous.IsActive != current.IsActive || len(previous.Metrics) != len(current.Metrics) {
+		return true
+	}
+	for i := range current.Metrics {
+		if previous.Metrics[i].MetricName != current.Metrics[i].MetricName ||
+			previous.Metrics[i].Value.Cmp(current.Metrics[i].Value) != 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package polling
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/metrics/pkg/apis/external_metrics"
+
+	"github.com/kedacore/keda/v2/pkg/scalers"
+)
+
+const (
+	testIdentifier = "scaledobject.default.test"
+	testMetricName = "s0-metric"
+)
+
+func result(value int64, polledAt time.Time) Result {
+	return Result{
+		Metrics: []external_metrics.ExternalMetricValue{
+			{MetricName: testMetricName, Value: *resource.NewQuantity(value, resource.DecimalSI)},
+		},
+		IsActive: value > 0,
+		PolledAt: polledAt,
+	}
+}
+
+func TestSchedulerFixedInterval(t *testing.T) {
+	scheduler := NewScheduler()
+	config := Config{Interval: time.Minute}
+	start := time.Now()
+
+	_, found := scheduler.Last(testIdentifier, testMetricName, config, start)
+	assert.False(t, found)
+
+	scheduler.Record(testIdentifier, testMetricName, config, result(5, start))
+
+	last, found := scheduler.Last(testIdentifier, testMetricName, config, start.Add(30*time.Second))
+	assert.True(t, found)
+	assert.Equal(t, int64(5), last.Metrics[0].Value.Value())
+
+	_, found = scheduler.Last(testIdentifier, testMetricName, config, start.Add(time.Minute))
+	assert.False(t, found)
+
+	// a different configuration, e.g. after the ScaledObject has been updated, polls right away
+	_, found = scheduler.Last(testIdentifier, testMetricName, Config{Interval: 2 * time.Minute}, start.Add(30*time.Second))
+	assert.False(t, found)
+
+	scheduler.Delete(testIdentifier)
+	_, found = scheduler.Last(testIdentifier, testMetricName, config, start.Add(30*time.Second))
+	assert.False(t, found)
+}
+
+func TestSchedulerAdaptiveInterval(t *testing.T) {
+	scheduler := NewScheduler()
+	config := Config{Interval: 10 * time.Second, MaxInterval: 30 * time.Second, BackoffFactor: 2}
+	now := time.Now()
+
+	nextInterval := func(value int64) time.Duration {
+		scheduler.Record(testIdentifier, testMetricName, config, result(value, now))
+		return scheduler.metrics[testIdentifier][testMetricName].nextPoll.Sub(now)
+	}
+
+	assert.Equal(t, 10*time.Second, nextInterval(0))
+	// unchanged metrics back off up to the max interval
+	assert.Equal(t, 20*time.Second, nextInterval(0))
+	assert.Equal(t, 30*time.Second, nextInterval(0))
+	assert.Equal(t, 30*time.Second, nextInterval(0))
+	// a change goes back to the base interval
+	assert.Equal(t, 10*time.Second, nextInterval(3))
+	assert.Equal(t, 20*time.Second, nextInterval(3))
+
+	// errors aren't backed off
+	scheduler.Record(testIdentifier, testMetricName, config, Result{Err: errors.New("error"), PolledAt: now})
+	assert.Equal(t, 10*time.Second, scheduler.metrics[testIdentifier][testMetricName].nextPoll.Sub(now))
+}
+
+func TestSchedulerRateLimit(t *testing.T) {
+	scheduler := NewScheduler()
+	config := Config{Interval: 10 * time.Second}
+	now := time.Now()
+
+	err := scalers.NewRateLimitError(time.Minute, errors.New("too many requests"))
+	scheduler.Record(testIdentifier, testMetricName, config, Result{Err: err, PolledAt: now})
+
+	last, found := scheduler.Last(testIdentifier, testMetricName, config, now.Add(30*time.Second))
+	assert.True(t, found)
+	assert.ErrorIs(t, last.Err, err)
+
+	_, found = scheduler.Last(testIdentifier, testMetricName, config, now.Add(time.Minute))
+	assert.False(t, found)
+}
+
+func TestNilScheduler(t *testing.T) {
+	var scheduler *Scheduler
+	config := Config{Interval: 10 * time.Second}
+	now := time.Now()
+
+	scheduler.Record(testIdentifier, testMetricName, config, Result{PolledAt: now})
+	_, found := scheduler.Last(testIdentifier, testMetricName, config, now)
+	assert.False(t, found)
+	scheduler.Delete(testIdentifier)
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prediction
+
+// Parameters describes the Holt-Winters model used for a single series
+type Parameters struct {
+	// Period is the length of the seasonal cycle, expressed in number of observations
+	Period int
+	// Alpha is the smoothing factor for the level
+	Alpha float64
+	// Beta is the smoothing factor for the trend
+	Beta float64
+	// Gamma is the smoothing factor for the seasonal component
+	Gamma float64
+}
+
+// HoltWinters is an online additive Holt-Winters (triple exponential smoothing) model.
+// Observations are expected to be evenly spaced, the model is updated in O(1)
+// for every new observation and keeps only a single seasonal cycle in memory.
+type HoltWinters struct {
+	params Parameters
+
+	level    float64
+	trend    float64
+	seasonal []float64
+
+	// warmup holds the observations of the first seasonal cycle,
+	// which are used to initialize the model
+	warmup       []float64
+	observations int
+	last         float64
+}
+
+// NewHoltWinters returns a new model for the given parameters. A period lower
+// than 2 disables the seasonal component and the model falls back to Holt's linear method.
+func NewHoltWinters(params Parameters) *HoltWinters {
+	if params.Period < 2 {
+		params.Period = 1
+		params.Gamma = 0
+	}
+	return &HoltWinters{
+		params:   params,
+		seasonal: make([]float64, params.Period),
+		warmup:   make([]float64, 0, params.Period),
+	}
+}
+
+// Observe updates the model with a new observation
+func (hw *HoltWinters) Observe(value float64) {
+	hw.last = value
+	if !hw.Ready() {
+		hw.warmup = append(hw.warmup, value)
+		hw.observations++
+		if len(hw.warmup) == hw.params.Period {
+			hw.initialize()
+		}
+		return
+	}
+
+	index := hw.observations % hw.params.Period
+	previousLevel := hw.level
+	hw.level = hw.params.Alpha*(value-hw.seasonal[index]) + (1-hw.params.Alpha)*(hw.level+hw.trend)
+	hw.trend = hw.params.Beta*(hw.level-previousLevel) + (1-hw.params.Beta)*hw.trend
+	hw.seasonal[index] = hw.params.Gamma*(value-hw.level) + (1-hw.params.Gamma)*hw.seasonal[index]
+	hw.observations++
+}
+
+// Ready returns true once the model has observed a full seasonal cycle and is able to forecast
+func (hw *HoltWinters) Ready() bool {
+	return hw.observations >= hw.params.Period
+}
+
+// Last returns the last observed value, or false if nothing has been observed yet
+func (hw *HoltWinters) Last() (float64, bool) {
+	return hw.last, hw.observations > 0
+}
+
+// Forecast returns the value expected the given number of observations after the last one,
+// the second return value is false if the model hasn't observed a full seasonal cycle yet
+func (hw *HoltWinters) Forecast(steps int) (float64, bool) {
+	if !hw.Ready() {
+		return 0, false
+	}
+	if steps < 0 {
+		steps = 0
+	}
+	index := (hw.observations - 1 + steps) % hw.params.Period
+	return hw.level + float64(steps)*hw.trend + hw.seasonal[index], true
+}
+
+// initialize sets the initial level as the mean of the first seasonal cycle
+// and the seasonal components as the deviations from that mean
+func (hw *HoltWinters) initialize() {
+	sum := 0.0
+	for _, value := range hw.warmup {
+		sum += value
+	}
+	hw.level = sum / float64(len(hw.warmup))
+	hw.trend = 0
+	for i, value := range hw.warmup {
+		hw.seasonal[i] = value - hw.level
+	}
+	hw.warmup = nil
+}
@@ -0,0 +1,86 @@
+package prediction
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHoltWintersNotReadyBeforeFullSeason(t *testing.T) {
+	hw := NewHoltWinters(Parameters{Period: 4, Alpha: 0.3, Beta: 0.1, Gamma: 0.1})
+
+	_, ok := hw.Last()
+	assert.False(t, ok)
+
+	for _, value := range []float64{1, 2, 3} {
+		hw.Observe(value)
+		_, ready := hw.Forecast(1)
+		assert.False(t, ready)
+	}
+
+	last, ok := hw.Last()
+	assert.True(t, ok)
+	assert.Equal(t, float64(3), last)
+
+	hw.Observe(4)
+	assert.True(t, hw.Ready())
+}
+
+func TestHoltWintersForecastsSeasonality(t *testing.T) {
+	season := []float64{10, 20, 40, 20}
+	hw := NewHoltWinters(Parameters{Period: len(season), Alpha: 0.3, Beta: 0.1, Gamma: 0.3})
+
+	for i := 0; i < 10*len(season); i++ {
+		hw.Observe(season[i%len(season)])
+	}
+
+	// the last observation is the end of a season, so the next steps start a new season
+	for steps := 1; steps <= len(season); steps++ {
+		forecast, ready := hw.Forecast(steps)
+		assert.True(t, ready)
+		assert.InDelta(t, season[(steps-1)%len(season)], forecast, 0.01)
+	}
+}
+
+func TestHoltWintersForecastsTrend(t *testing.T) {
+	hw := NewHoltWinters(Parameters{Period: 1, Alpha: 0.5, Beta: 0.5, Gamma: 0.5})
+
+	for i := 0; i < 50; i++ {
+		hw.Observe(float64(i * 2))
+	}
+
+	forecast, ready := hw.Forecast(5)
+	assert.True(t, ready)
+	assert.InDelta(t, float64(49*2+5*2), forecast, 0.01)
+}
+
+func TestStoreForecast(t *testing.T) {
+	store := NewStore()
+	params := Parameters{Period: 2, Alpha: 0.3, Beta: 0.1, Gamma: 0.1}
+
+	_, ok := store.Forecast("so", "metric", 1)
+	assert.False(t, ok)
+
+	// not enough history, the last observed value is returned
+	store.Observe("so", "metric", 5, params)
+	value, ok := store.Forecast("so", "metric", 1)
+	assert.True(t, ok)
+	assert.Equal(t, float64(5), value)
+
+	store.Observe("so", "metric", 7, params)
+	value, ok = store.Forecast("so", "metric", 1)
+	assert.True(t, ok)
+	assert.False(t, math.IsNaN(value))
+	assert.InDelta(t, float64(5), value, 0.01)
+
+	// changing the parameters resets the history
+	store.Observe("so", "metric", 9, Parameters{Period: 3, Alpha: 0.3, Beta: 0.1, Gamma: 0.1})
+	value, ok = store.Forecast("so", "metric", 1)
+	assert.True(t, ok)
+	assert.Equal(t, float64(9), value)
+
+	store.Delete("so")
+	_, ok = store.Forecast("so", "metric", 1)
+	assert.False(t, ok)
+}
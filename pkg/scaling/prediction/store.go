@@ -0,0 +1,79 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prediction
+
+import (
+	"sync"
+)
+
+// Store keeps the in-process metric history of ScaledObjects, one model per ScaledObject and metric
+type Store struct {
+	models map[string]map[string]*HoltWinters
+	lock   *sync.RWMutex
+}
+
+// NewStore returns an empty Store
+func NewStore() *Store {
+	return &Store{
+		models: map[string]map[string]*HoltWinters{},
+		lock:   &sync.RWMutex{},
+	}
+}
+
+// Observe records a new value for the metric of the ScaledObject identified by scaledObjectIdentifier.
+// If the model parameters have changed since the last observation, the history is discarded.
+func (s *Store) Observe(scaledObjectIdentifier, metricName string, value float64, params Parameters) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	metrics, ok := s.models[scaledObjectIdentifier]
+	if !ok {
+		metrics = map[string]*HoltWinters{}
+		s.models[scaledObjectIdentifier] = metrics
+	}
+
+	model, ok := metrics[metricName]
+	if !ok || model.params != NewHoltWinters(params).params {
+		model = NewHoltWinters(params)
+		metrics[metricName] = model
+	}
+	model.Observe(value)
+}
+
+// Forecast returns the forecasted value of the metric the given number of observations ahead.
+// If there isn't enough history to forecast yet, the last observed value is returned instead.
+// The second return value is false if the metric has never been observed.
+func (s *Store) Forecast(scaledObjectIdentifier, metricName string, steps int) (float64, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	model, ok := s.models[scaledObjectIdentifier][metricName]
+	if !ok {
+		return 0, false
+	}
+	if value, ready := model.Forecast(steps); ready {
+		return value, true
+	}
+	return model.Last()
+}
+
+// Delete drops the history of all metrics of the ScaledObject
+func (s *Store) Delete(scaledObjectIdentifier string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.models, scaledObjectIdentifier)
+}
@@ -150,6 +150,10 @@ func ResolveScaleTargetPodSpec(ctx context.Context, kubeClient client.Client, sc
 
 		return &podTemplateSpec, obj.Spec.ScaleTargetRef.EnvSourceContainerName, nil
 	case *kedav1alpha1.ScaledJob:
+		if obj.Spec.JobTargetRef == nil {
+			// the workloads created from a workloadTemplate don't have a pod template to inject environment properties from
+			return nil, "", nil
+		}
 		return &obj.Spec.JobTargetRef.Template, obj.Spec.EnvSourceContainerName, nil
 	default:
 		return nil, "", fmt.Errorf("unknown scalable object type %v", scalableObject)
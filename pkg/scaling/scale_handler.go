@@ -19,6 +19,7 @@ package scaling
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -35,8 +36,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
+	eventingv1alpha1 "github.com/kedacore/keda/v2/apis/eventing/v1alpha1"
 	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
 	"github.com/kedacore/keda/v2/pkg/common/message"
+	"github.com/kedacore/keda/v2/pkg/eventemitter"
+	"github.com/kedacore/keda/v2/pkg/eventemitter/eventdata"
 	"github.com/kedacore/keda/v2/pkg/eventreason"
 	"github.com/kedacore/keda/v2/pkg/fallback"
 	"github.com/kedacore/keda/v2/pkg/metricscollector"
@@ -44,10 +48,14 @@ import (
 	"github.com/kedacore/keda/v2/pkg/scalers"
 	"github.com/kedacore/keda/v2/pkg/scalers/authentication"
 	"github.com/kedacore/keda/v2/pkg/scalers/scalersconfig"
+	"github.com/kedacore/keda/v2/pkg/scaling/activation"
 	"github.com/kedacore/keda/v2/pkg/scaling/cache"
 	"github.com/kedacore/keda/v2/pkg/scaling/cache/metricscache"
 	"github.com/kedacore/keda/v2/pkg/scaling/executor"
+	"github.com/kedacore/keda/v2/pkg/scaling/history"
 	"github.com/kedacore/keda/v2/pkg/scaling/modifiers"
+	"github.com/kedacore/keda/v2/pkg/scaling/polling"
+	"github.com/kedacore/keda/v2/pkg/scaling/prediction"
 	"github.com/kedacore/keda/v2/pkg/scaling/resolver"
 	"github.com/kedacore/keda/v2/pkg/scaling/scaledjob"
 )
@@ -68,6 +76,7 @@ type ScaleHandler interface {
 	SubscribeMetric(ctx context.Context, subscriber string, metricMetadata *api.ScaledObjectRef) bool
 	UnsubscribeMetric(ctx context.Context, subscriber string, metadata *api.ScaledObjectRef) bool
 	GetRawMetricsChan(subscriber string) (rawMetrics chan RawMetrics, done chan bool)
+	GetScalingHistory(kind, namespace, name string) []history.Transition
 }
 
 type scaleHandler struct {
@@ -77,9 +86,17 @@ type scaleHandler struct {
 	scaleExecutor            executor.ScaleExecutor
 	globalHTTPTimeout        time.Duration
 	recorder                 record.EventRecorder
+	eventEmitter             eventemitter.EventHandler
 	scalerCaches             map[string]*cache.ScalersCache
 	scalerCachesLock         *sync.RWMutex
 	scaledObjectsMetricCache metricscache.MetricsCache
+	predictionStore          *prediction.Store
+	activationStore          *activation.Store
+	pollingScheduler         *polling.Scheduler
+	requestCoalescer         *cache.RequestCoalescer
+	scalingDecisionUpdates   *sync.Map
+	fallbackStates           *sync.Map
+	scalingHistory           *history.Store
 	authClientSet            *authentication.AuthClientSet
 	rawMetricsSubscriptions  map[string]*RawMetricSubscriptions
 	// redundant, but it will speed up the lookups
@@ -87,18 +104,28 @@ type scaleHandler struct {
 	subsLock              *sync.RWMutex
 }
 
-// NewScaleHandler creates a ScaleHandler object
-func NewScaleHandler(client client.Client, scaleClient scale.ScalesGetter, reconcilerScheme *runtime.Scheme, globalHTTPTimeout time.Duration, recorder record.EventRecorder, authClientSet *authentication.AuthClientSet) ScaleHandler {
+// NewScaleHandler creates a ScaleHandler object, the metrics records of ScaledObjects are kept in metricsCacheStorage.
+// The requests of the scalers go through requestCoalescer and the scale transitions are recorded in scalingHistory, both can be nil.
+// The CloudEvents of the scale executor are emitted through eventEmitter, which can be nil too
+func NewScaleHandler(client client.Client, scaleClient scale.ScalesGetter, reconcilerScheme *runtime.Scheme, globalHTTPTimeout time.Duration, recorder record.EventRecorder, eventEmitter eventemitter.EventHandler, authClientSet *authentication.AuthClientSet, metricsCacheStorage metricscache.Storage, requestCoalescer *cache.RequestCoalescer, scalingHistory *history.Store) ScaleHandler {
 	return &scaleHandler{
 		client:                   client,
 		scaleClient:              scaleClient,
 		scaleLoopContexts:        &sync.Map{},
-		scaleExecutor:            executor.NewScaleExecutor(client, scaleClient, reconcilerScheme, recorder),
+		scaleExecutor:            executor.NewScaleExecutor(client, scaleClient, reconcilerScheme, recorder, eventEmitter, scalingHistory),
 		globalHTTPTimeout:        globalHTTPTimeout,
 		recorder:                 recorder,
+		eventEmitter:             eventEmitter,
 		scalerCaches:             map[string]*cache.ScalersCache{},
 		scalerCachesLock:         &sync.RWMutex{},
-		scaledObjectsMetricCache: metricscache.NewMetricsCache(),
+		scaledObjectsMetricCache: metricscache.NewMetricsCacheWithStorage(metricsCacheStorage),
+		predictionStore:          prediction.NewStore(),
+		activationStore:          activation.NewStore(),
+		pollingScheduler:         polling.NewScheduler(),
+		requestCoalescer:         requestCoalescer,
+		scalingDecisionUpdates:   &sync.Map{},
+		fallbackStates:           &sync.Map{},
+		scalingHistory:           scalingHistory,
 		authClientSet:            authClientSet,
 		metricToSubscriptions:    map[metricMeta][]*RawMetricSubscriptions{},
 		rawMetricsSubscriptions:  map[string]*RawMetricSubscriptions{},
@@ -164,6 +191,15 @@ func (h *scaleHandler) DeleteScalableObject(ctx context.Context, scalableObject
 			cancel()
 		}
 		h.scaleLoopContexts.Delete(key)
+		h.predictionStore.Delete(key)
+		h.activationStore.Delete(key)
+		h.pollingScheduler.Delete(key)
+		h.scalingDecisionUpdates.Delete(key)
+		h.fallbackStates.Delete(key)
+		// the scaling history is kept while the scale loop is stopped, e.g. when the object is paused
+		if withTriggers.GetDeletionTimestamp() != nil {
+			h.scalingHistory.Delete(key)
+		}
 		err := h.ClearScalersCache(ctx, scalableObject)
 		if err != nil {
 			log.Error(err, "error clearing scalers cache", "scalableObject", scalableObject, "key", key)
@@ -176,11 +212,12 @@ func (h *scaleHandler) DeleteScalableObject(ctx context.Context, scalableObject
 	return nil
 }
 
-// startScaleLoop blocks forever and checks the scalableObject based on its pollingInterval
+// startScaleLoop blocks forever and checks the scalableObject based on its pollingInterval,
+// or on the shortest pollingInterval of its triggers
 func (h *scaleHandler) startScaleLoop(ctx context.Context, withTriggers *kedav1alpha1.WithTriggers, scalableObject interface{}, scalingMutex sync.Locker, isScaledObject bool) {
 	logger := log.WithValues("type", withTriggers.Kind, "namespace", withTriggers.Namespace, "name", withTriggers.Name)
 
-	pollingInterval := withTriggers.GetPollingInterval()
+	pollingInterval := withTriggers.GetScaleLoopInterval()
 	logger.V(1).Info("Watching with pollingInterval", "PollingInterval", pollingInterval)
 
 	next := time.Now()
@@ -264,17 +301,24 @@ func (h *scaleHandler) checkScalers(ctx context.Context, scalableObject interfac
 			log.Error(err, "error getting scaledObject", "object", scalableObject)
 			return
 		}
-		isActive, isError, metricsRecords, activeTriggers, err := h.getScaledObjectState(ctx, obj)
+		state, err := h.getScaledObjectState(ctx, obj)
 		if err != nil {
 			log.Error(err, "error getting state of scaledObject", "scaledObject.Namespace", obj.Namespace, "scaledObject.Name", obj.Name)
 			return
 		}
 
-		h.scaleExecutor.RequestScale(ctx, obj, isActive, isError, &executor.ScaleExecutorOptions{ActiveTriggers: activeTriggers})
+		if obj.IsDryRun() {
+			h.handleDryRun(ctx, obj, state.IsActive, state.IsError, state.Metrics)
+		} else {
+			h.clearDryRun(ctx, obj)
+			h.scaleExecutor.RequestScale(ctx, obj, state.IsActive, state.IsError, &executor.ScaleExecutorOptions{ActiveTriggers: state.ActiveTriggers, Metrics: state.Metrics})
+		}
+		h.updateScalingDecision(ctx, obj, state)
+		h.emitFallbackTransition(obj, state.Metrics)
 
-		if len(metricsRecords) > 0 {
-			log.V(1).Info("Storing metrics to cache", "scaledObject.Namespace", obj.Namespace, "scaledObject.Name", obj.Name, "metricsRecords", metricsRecords)
-			h.scaledObjectsMetricCache.StoreRecords(obj.GenerateIdentifier(), metricsRecords)
+		if len(state.MetricsRecords) > 0 {
+			log.V(1).Info("Storing metrics to cache", "scaledObject.Namespace", obj.Namespace, "scaledObject.Name", obj.Name, "metricsRecords", state.MetricsRecords)
+			h.scaledObjectsMetricCache.StoreRecords(obj.GenerateIdentifier(), state.MetricsRecords)
 		}
 	case *kedav1alpha1.ScaledJob:
 		err := h.client.Get(ctx, types.NamespacedName{Name: obj.Name, Namespace: obj.Namespace}, obj)
@@ -284,7 +328,24 @@ func (h *scaleHandler) checkScalers(ctx context.Context, scalableObject interfac
 		}
 
 		isActive, isError, scaleTo, maxScale := h.isScaledJobActive(ctx, obj)
-		h.scaleExecutor.RequestJobScale(ctx, obj, isActive, isError, scaleTo, maxScale)
+		h.scaleExecutor.RequestJobScale(ctx, obj, isActive, isError, scaleTo, maxScale, h.getScaledJobExecutorOptions(ctx, obj))
+	}
+}
+
+// getScaledJobExecutorOptions returns the options of RequestJobScale, the messages bound to the Jobs are claimed
+// from the scaler of the trigger selected by the message binding of the ScaledJob
+func (h *scaleHandler) getScaledJobExecutorOptions(ctx context.Context, scaledJob *kedav1alpha1.ScaledJob) *executor.ScaleExecutorOptions {
+	if scaledJob.Spec.MessageBinding == nil {
+		return nil
+	}
+	return &executor.ScaleExecutorOptions{
+		GetMessageClaimer: func(ctx context.Context) (scalers.MessageClaimer, error) {
+			cache, err := h.GetScalersCache(ctx, scaledJob)
+			if err != nil {
+				return nil, err
+			}
+			return cache.GetMessageClaimer(scaledJob.Spec.MessageBinding.TriggerName)
+		},
 	}
 }
 
@@ -372,6 +433,8 @@ func (h *scaleHandler) performGetScalersCache(ctx context.Context, key string, s
 	switch obj := scalableObject.(type) {
 	case *kedav1alpha1.ScaledObject:
 		asMetricSource = obj.IsUsingModifiers()
+	case *kedav1alpha1.ScaledJob:
+		asMetricSource = obj.IsUsingModifiers()
 	default:
 	}
 
@@ -384,6 +447,7 @@ func (h *scaleHandler) performGetScalersCache(ctx context.Context, key string, s
 		Scalers:                  scalers,
 		ScalableObjectGeneration: withTriggers.Generation,
 		Recorder:                 h.recorder,
+		RequestCoalescer:         h.requestCoalescer,
 	}
 	switch obj := scalableObject.(type) {
 	case *kedav1alpha1.ScaledObject:
@@ -397,6 +461,16 @@ func (h *scaleHandler) performGetScalersCache(ctx context.Context, key string, s
 			newCache.CompiledFormula = program
 		}
 		newCache.ScaledObject = obj
+	case *kedav1alpha1.ScaledJob:
+		if obj.IsUsingModifiers() {
+			// validate scalingModifiers struct and compile formula
+			program, err := kedav1alpha1.ValidateAndCompileScaledJobScalingModifiers(obj)
+			if err != nil {
+				log.Error(err, "error validating-compiling scalingModifiers")
+				return nil, err
+			}
+			newCache.CompiledFormula = program
+		}
 	default:
 	}
 
@@ -466,6 +540,17 @@ func (h *scaleHandler) GetScaledObjectMetrics(ctx context.Context, scaledObjectN
 	isScalerError := false
 	scaledObjectIdentifier := scaledObject.GenerateIdentifier()
 
+	// the prediction metric is computed in the scale loop, so it's always served from the cache
+	if metricsName == kedav1alpha1.PredictionMetricName {
+		metricsRecord, found := h.scaledObjectsMetricCache.ReadRecord(scaledObjectIdentifier, metricsName)
+		if !found {
+			return nil, fmt.Errorf("no forecast found for %s", metricsName)
+		}
+		return &external_metrics.ExternalMetricValueList{
+			Items: metricsRecord.Metric,
+		}, nil
+	}
+
 	// returns all relevant metrics for current scaler (standard is one metric,
 	// composite scaler gets all external metrics for further computation)
 	metricsArray, err := h.getTrueMetricArray(ctx, metricsName, scaledObject)
@@ -486,6 +571,7 @@ func (h *scaleHandler) GetScaledObjectMetrics(ctx context.Context, scaledObjectN
 		triggerName       string
 		triggerIndex      int
 		metricSpec        v2.MetricSpec
+		collectedAt       time.Time
 		err               error
 	}
 	allScalers, scalerConfigs := cache.GetScalers()
@@ -531,6 +617,7 @@ func (h *scaleHandler) GetScaledObjectMetrics(ctx context.Context, scaledObjectN
 						logger.Error(err, "error pairing triggers & metrics for compositeScaler")
 					}
 					var metrics []external_metrics.ExternalMetricValue
+					collectedAt := time.Now()
 
 					// if cache is defined for this scaler/metric, let's try to hit it first
 					metricsFoundInCache := false
@@ -540,6 +627,12 @@ func (h *scaleHandler) GetScaledObjectMetrics(ctx context.Context, scaledObjectN
 							logger.V(1).Info("Reading metrics from cache", "scaler", triggerName, "metricName", metricName, "metricsRecord", metricsRecord)
 							metrics = metricsRecord.Metric
 							err = metricsRecord.ScalerError
+							collectedAt = metricsRecord.Timestamp
+							// the scale loop hasn't refreshed the record for too long, don't serve it to the HPA
+							if age := metricsRecord.Age(); scalerConfig.TriggerMaxMetricAge > 0 && age > scalerConfig.TriggerMaxMetricAge {
+								metrics = nil
+								err = fmt.Errorf("cached metric %s is stale: collected %s ago, maxMetricAge is %s", metricName, age.Round(time.Second), scalerConfig.TriggerMaxMetricAge)
+							}
 						}
 					}
 
@@ -555,6 +648,7 @@ func (h *scaleHandler) GetScaledObjectMetrics(ctx context.Context, scaledObjectN
 					result.triggerName = triggerName
 					result.triggerIndex = triggerIndex
 					result.metricSpec = spec
+					result.collectedAt = collectedAt
 					result.metrics = metrics
 					result.err = err
 					results <- result
@@ -580,10 +674,11 @@ func (h *scaleHandler) GetScaledObjectMetrics(ctx context.Context, scaledObjectN
 				metricValue := metric.Value.AsApproximateFloat64()
 				metricscollector.RecordScalerMetric(scaledObjectNamespace, scaledObjectName, result.triggerName, result.triggerIndex, metric.MetricName, true, metricValue)
 			}
+			metricscollector.RecordScalerMetricAge(scaledObjectNamespace, scaledObjectName, result.triggerName, result.triggerIndex, result.metricName, true, time.Since(result.collectedAt))
 			// this is for raw metrics subscription for HPA requests
 			if shouldSendRawMetrics(RawMetricsHPA) {
 				// send the raw metric to all subscribed clients in a non-blocking fashion
-				go h.sendWhenSubscribed(scaledObjectName, scaledObjectNamespace, result.triggerName, metrics)
+				go h.sendWhenSubscribed(scaledObjectName, scaledObjectNamespace, result.triggerName, metrics, result.collectedAt)
 			}
 		}
 		if fallbackActive {
@@ -619,12 +714,59 @@ func (h *scaleHandler) GetScaledObjectMetrics(ctx context.Context, scaledObjectN
 	}, nil
 }
 
-// getScaledObjectState returns whether the input ScaledObject:
-// is active as the first return value,
-// the second return value indicates whether there was any error during querying scalers,
-// the third return value is a map of metrics record - a metric value for each scaler and its metric
-// the fourth return value contains error if is not able to access scalers cache
-func (h *scaleHandler) getScaledObjectState(ctx context.Context, scaledObject *kedav1alpha1.ScaledObject) (bool, bool, map[string]metricscache.MetricsRecord, []string, error) {
+// emitFallbackTransition emits the CloudEvent of the ScaledObject entering or exiting the fallback, with the metric values
+// of the scale loop, if its fallback condition has changed since the last scale loop.
+// The fallback condition is updated by the metrics requests of the HPA, which run concurrently, so the transitions
+// are only detected by the scale loop to emit each of them once
+func (h *scaleHandler) emitFallbackTransition(scaledObject *kedav1alpha1.ScaledObject, metrics []external_metrics.ExternalMetricValue) {
+	if h.fallbackStates == nil {
+		return
+	}
+	fallbackCondition := scaledObject.Status.Conditions.GetFallbackCondition()
+	isFallbackActive := fallbackCondition.IsTrue()
+	wasFallbackActive, found := h.fallbackStates.Swap(scaledObject.GenerateIdentifier(), isFallbackActive)
+	if !found || wasFallbackActive.(bool) == isFallbackActive {
+		return
+	}
+
+	eventType, cloudeventType, reason, msg := corev1.EventTypeWarning, eventingv1alpha1.ScaledObjectFallbackEnteredType, eventreason.KEDAFallbackEntered, "At least one trigger is falling back on this scaled object"
+	if !isFallbackActive {
+		eventType, cloudeventType, reason, msg = corev1.EventTypeNormal, eventingv1alpha1.ScaledObjectFallbackExitedType, eventreason.KEDAFallbackExited, "No fallbacks are active on this scaled object"
+	}
+	data := eventdata.ScalingData{}
+	if len(metrics) > 0 {
+		data.MetricValues = make(map[string]float64, len(metrics))
+		for _, metric := range metrics {
+			data.MetricValues[metric.MetricName] = metric.Value.AsApproximateFloat64()
+		}
+	}
+
+	if h.eventEmitter == nil {
+		h.recorder.Event(scaledObject, eventType, reason, msg)
+		return
+	}
+	h.eventEmitter.Emit(scaledObject, scaledObject.Namespace, eventType, cloudeventType, reason, msg, data)
+}
+
+// scaledObjectState is the state of a ScaledObject computed by getScaledObjectState
+type scaledObjectState struct {
+	// IsActive is whether the ScaledObject is active
+	IsActive bool
+	// IsError is whether there was any error during querying scalers
+	IsError bool
+	// MetricsRecords is a map of metrics record - a metric value for each scaler and its metric
+	MetricsRecords map[string]metricscache.MetricsRecord
+	// Metrics are the metrics of the scalers, after applying the scaling modifiers
+	Metrics []external_metrics.ExternalMetricValue
+	// ActiveTriggers are the names of the active triggers
+	ActiveTriggers []string
+	// Triggers are the states of the scalers, ordered by trigger index
+	Triggers []scalerState
+}
+
+// getScaledObjectState returns the state of the input ScaledObject, see scaledObjectState.
+// It returns an error if is not able to access scalers cache
+func (h *scaleHandler) getScaledObjectState(ctx context.Context, scaledObject *kedav1alpha1.ScaledObject) (scaledObjectState, error) {
 	logger := log.WithValues("scaledObject.Namespace", scaledObject.Namespace, "scaledObject.Name", scaledObject.Name)
 
 	isScaledObjectActive := false
@@ -633,11 +775,12 @@ func (h *scaleHandler) getScaledObjectState(ctx context.Context, scaledObject *k
 	metricTriggerPairList := make(map[string]string)
 	var matchingMetrics []external_metrics.ExternalMetricValue
 	var activeTriggers []string
+	var triggerStates []scalerState
 
 	cache, err := h.GetScalersCache(ctx, scaledObject)
 	metricscollector.RecordScaledObjectError(scaledObject.Namespace, scaledObject.Name, err)
 	if err != nil {
-		return false, true, map[string]metricscache.MetricsRecord{}, []string{}, fmt.Errorf("error getting scalers cache %w", err)
+		return scaledObjectState{IsError: true, MetricsRecords: map[string]metricscache.MetricsRecord{}, ActiveTriggers: []string{}}, fmt.Errorf("error getting scalers cache %w", err)
 	}
 
 	// count the number of non-external triggers (cpu/mem) in order to check for
@@ -653,19 +796,26 @@ func (h *scaleHandler) getScaledObjectState(ctx context.Context, scaledObject *k
 
 	// Let's collect status of all allScalers in parallel,
 	// no matter if any scaler raises error or is active
+	withTriggers, err := kedav1alpha1.AsDuckWithTriggers(scaledObject)
+	if err != nil {
+		return scaledObjectState{IsError: true, MetricsRecords: map[string]metricscache.MetricsRecord{}, ActiveTriggers: []string{}}, err
+	}
+	pollingInterval := withTriggers.GetPollingInterval()
+
 	allScalers, scalerConfigs := cache.GetScalers()
 	results := make(chan scalerState, len(allScalers))
 	wg := sync.WaitGroup{}
 	for scalerIndex := 0; scalerIndex < len(allScalers); scalerIndex++ {
 		wg.Add(1)
 		go func(scaler scalers.Scaler, index int, scalerConfig scalersconfig.ScalerConfig, results chan scalerState, wg *sync.WaitGroup) {
-			results <- h.getScalerState(ctx, scaler, index, scalerConfig, cache, logger, scaledObject)
+			results <- h.getScalerState(ctx, scaler, index, scalerConfig, cache, logger, scaledObject, pollingInterval)
 			wg.Done()
 		}(allScalers[scalerIndex], scalerIndex, scalerConfigs[scalerIndex], results, &wg)
 	}
 	wg.Wait()
 	close(results)
 	for result := range results {
+		triggerStates = append(triggerStates, result)
 		if result.IsActive {
 			isScaledObjectActive = true
 			activeTriggers = append(activeTriggers, result.TriggerName)
@@ -686,10 +836,14 @@ func (h *scaleHandler) getScaledObjectState(ctx context.Context, scaledObject *k
 		// this is for raw metrics subscription for polling interval
 		if shouldSendRawMetrics(RawMetricsPollingInterval) {
 			// send the raw metric to all subscribed clients in a non-blocking fashion
-			go h.sendWhenSubscribed(scaledObject.Name, scaledObject.Namespace, result.TriggerName, result.Metrics)
+			go h.sendWhenSubscribed(scaledObject.Name, scaledObject.Namespace, result.TriggerName, result.Metrics, time.Now())
 		}
 	}
 
+	slices.SortFunc(triggerStates, func(a, b scalerState) int {
+		return a.TriggerIndex - b.TriggerIndex
+	})
+
 	// invalidate the cache for the ScaledObject, if we hit an error in any scaler
 	// in this case we try to build all scalers (and resolve all secrets/creds) again in the next call
 	if isScaledObjectError {
@@ -713,7 +867,7 @@ func (h *scaleHandler) getScaledObjectState(ctx context.Context, scaledObject *k
 			if scaledObject.Spec.Advanced.ScalingModifiers.ActivationTarget != "" {
 				targetValue, err := strconv.ParseFloat(scaledObject.Spec.Advanced.ScalingModifiers.ActivationTarget, 64)
 				if err != nil {
-					return false, true, metricsRecord, []string{}, fmt.Errorf("scalingModifiers.ActivationTarget parsing error %w", err)
+					return scaledObjectState{IsError: true, MetricsRecords: metricsRecord, ActiveTriggers: []string{}}, fmt.Errorf("scalingModifiers.ActivationTarget parsing error %w", err)
 				}
 				activationValue = targetValue
 			}
@@ -733,12 +887,34 @@ func (h *scaleHandler) getScaledObjectState(ctx context.Context, scaledObject *k
 		}
 	}
 
+	// feed the metric history and store the forecast, the HPA reads it from the metrics cache
+	if scaledObject.IsUsingPrediction() && !isScaledObjectError {
+		predictionRecord, err := h.getPredictionRecord(ctx, scaledObject, cache, matchingMetrics)
+		if err != nil {
+			logger.Error(err, "error forecasting metrics for prediction")
+		} else {
+			metricsRecord[kedav1alpha1.PredictionMetricName] = predictionRecord
+		}
+	}
+
 	// cpu/memory scaler only can scale to zero if there is any other external metric because otherwise
 	// it'll never scale from 0. If all the triggers are only cpu/memory, we enforce the IsActive
 	if len(scaledObject.Spec.Triggers) <= cpuMemCount && !isScaledObjectError {
 		isScaledObjectActive = true
 	}
-	return isScaledObjectActive, isScaledObjectError, metricsRecord, activeTriggers, err
+
+	// the activity is kept until the triggers have been active, or inactive, for the activation stabilization window
+	if !isScaledObjectError {
+		isScaledObjectActive = h.stabilizeActivity(scaledObject, isScaledObjectActive, logger)
+	}
+	return scaledObjectState{
+		IsActive:       isScaledObjectActive,
+		IsError:        isScaledObjectError,
+		MetricsRecords: metricsRecord,
+		Metrics:        matchingMetrics,
+		ActiveTriggers: activeTriggers,
+		Triggers:       triggerStates,
+	}, err
 }
 
 // scalerState is used as return
@@ -747,27 +923,33 @@ func (h *scaleHandler) getScaledObjectState(ctx context.Context, scaledObject *k
 // info for calculating the ScaledObjectState
 type scalerState struct {
 	// IsActive will be overrided by formula calculation
-	IsActive    bool
-	TriggerName string
-	Metrics     []external_metrics.ExternalMetricValue
-	Pairs       map[string]string
-	Records     map[string]metricscache.MetricsRecord
-	Err         error
+	IsActive     bool
+	TriggerIndex int
+	TriggerName  string
+	TriggerType  string
+	MetricSpecs  []v2.MetricSpec
+	Metrics      []external_metrics.ExternalMetricValue
+	Pairs        map[string]string
+	Records      map[string]metricscache.MetricsRecord
+	Err          error
 }
 
 // getScalerState returns getStateScalerResult with the state
 // for an specific scaler. The state contains if it's active or
 // with erros, but also the records for the cache and he metrics
-// for the custom formulas
-func (*scaleHandler) getScalerState(ctx context.Context, scaler scalers.Scaler, triggerIndex int, scalerConfig scalersconfig.ScalerConfig,
-	cache *cache.ScalersCache, logger logr.Logger, scaledObject *kedav1alpha1.ScaledObject) scalerState {
+// for the custom formulas. Triggers with their own pollingInterval
+// are queried only when they are due, see pollMetricsAndActivity
+func (h *scaleHandler) getScalerState(ctx context.Context, scaler scalers.Scaler, triggerIndex int, scalerConfig scalersconfig.ScalerConfig,
+	cache *cache.ScalersCache, logger logr.Logger, scaledObject *kedav1alpha1.ScaledObject, pollingInterval time.Duration) scalerState {
 	result := scalerState{
-		IsActive:    false,
-		Err:         nil,
-		TriggerName: "",
-		Metrics:     []external_metrics.ExternalMetricValue{},
-		Pairs:       map[string]string{},
-		Records:     map[string]metricscache.MetricsRecord{},
+		IsActive:     false,
+		Err:          nil,
+		TriggerIndex: triggerIndex,
+		TriggerName:  "",
+		TriggerType:  scalerConfig.TriggerType,
+		Metrics:      []external_metrics.ExternalMetricValue{},
+		Pairs:        map[string]string{},
+		Records:      map[string]metricscache.MetricsRecord{},
 	}
 
 	result.TriggerName = strings.Replace(fmt.Sprintf("%T", scaler), "*scalers.", "", 1)
@@ -776,6 +958,7 @@ func (*scaleHandler) getScalerState(ctx context.Context, scaler scalers.Scaler,
 	}
 
 	metricSpecs, err := cache.GetMetricSpecForScalingForScaler(ctx, triggerIndex)
+	result.MetricSpecs = metricSpecs
 	if err != nil {
 		result.Err = err
 		logger.Error(err, "error getting metric spec for the scaler", "scaler", result.TriggerName)
@@ -789,8 +972,11 @@ func (*scaleHandler) getScalerState(ctx context.Context, scaler scalers.Scaler,
 
 		metricName := spec.External.Metric.Name
 
-		var latency time.Duration
-		metrics, isMetricActive, latency, err := cache.GetMetricsAndActivityForScaler(ctx, triggerIndex, metricName)
+		polled, latency := h.pollMetricsAndActivity(ctx, cache, scaledObject.GenerateIdentifier(), pollingInterval, triggerIndex, metricName, scalerConfig)
+		metrics, isMetricActive, err := polled.Metrics, polled.IsActive, polled.Err
+		if err == nil {
+			isMetricActive = h.getMetricActivity(scaledObject.GenerateIdentifier(), metricName, scalerConfig, metrics, isMetricActive)
+		}
 		metricscollector.RecordScalerError(scaledObject.Namespace, scaledObject.Name, result.TriggerName, triggerIndex, metricName, true, err)
 		if latency != -1 {
 			metricscollector.RecordScalerLatency(scaledObject.Namespace, scaledObject.Name, result.TriggerName, triggerIndex, metricName, true, latency)
@@ -803,6 +989,7 @@ func (*scaleHandler) getScalerState(ctx context.Context, scaler scalers.Scaler,
 				IsActive:    isMetricActive,
 				Metric:      metrics,
 				ScalerError: err,
+				Timestamp:   polled.PolledAt,
 			}
 		}
 
@@ -859,6 +1046,13 @@ func (h *scaleHandler) getScaledJobMetrics(ctx context.Context, scaledJob *kedav
 	}
 	var isError bool
 	var scalersMetrics []scaledjob.ScalerMetrics
+	withTriggers, err := kedav1alpha1.AsDuckWithTriggers(scaledJob)
+	if err != nil {
+		log.Error(err, "error duck typing object into withTrigger", "scaledJob.Namespace", scaledJob.Namespace, "scaledJob.Name", scaledJob.Name)
+		return nil, true
+	}
+	scaledJobIdentifier := withTriggers.GenerateIdentifier()
+	pollingInterval := withTriggers.GetPollingInterval()
 	scalers, scalerConfigs := cache.GetScalers()
 	for scalerIndex, scaler := range scalers {
 		scalerName := strings.Replace(fmt.Sprintf("%T", scalers[scalerIndex]), "*scalers.", "", 1)
@@ -879,20 +1073,25 @@ func (h *scaleHandler) getScaledJobMetrics(ctx context.Context, scaledJob *kedav
 				continue
 			}
 			metricName := spec.External.Metric.Name
-			metrics, isTriggerActive, latency, err := cache.GetMetricsAndActivityForScaler(ctx, scalerIndex, metricName)
+			polled, latency := h.pollMetricsAndActivity(ctx, cache, scaledJobIdentifier, pollingInterval, scalerIndex, metricName, scalerConfigs[scalerIndex])
+			metrics, isTriggerActive, err := polled.Metrics, polled.IsActive, polled.Err
 			metricscollector.RecordScaledJobError(scaledJob.Namespace, scaledJob.Name, err)
 			if latency != -1 {
 				metricscollector.RecordScalerLatency(scaledJob.Namespace, scaledJob.Name, scalerName, scalerIndex, metricName, false, latency)
 			}
+			jobCount, fallbackActive := fallback.GetJobCountWithFallback(ctx, h.client, scaledJob, err, metricName)
 			if err != nil {
 				scalerLogger.Error(err, "Error getting scaler metrics and activity, but continue")
 				cache.Recorder.Event(scaledJob, corev1.EventTypeWarning, eventreason.KEDAScalerFailed, err.Error())
 				isError = true
+				if fallbackActive {
+					scalersMetrics = append(scalersMetrics, scaledjob.GetFallbackScalerMetrics(jobCount, metricSpecs, scaledJob.MaxReplicaCount()))
+				}
 				continue
 			}
 			if shouldSendRawMetrics(RawMetricsPollingInterval) {
 				// send the raw metric to all subscribed clients in a non-blocking fashion
-				go h.sendWhenSubscribed(scaledJob.Name, scaledJob.Namespace, scalerName, metrics)
+				go h.sendWhenSubscribed(scaledJob.Name, scaledJob.Namespace, scalerName, metrics, time.Now())
 			}
 			if isTriggerActive {
 				isActive = true
@@ -905,6 +1104,7 @@ func (h *scaleHandler) getScaledJobMetrics(ctx context.Context, scaledJob *kedav
 				QueueLength: queueLength,
 				MaxValue:    maxValue,
 				IsActive:    isActive,
+				TriggerName: scalerConfigs[scalerIndex].TriggerName,
 			})
 			for _, metric := range metrics {
 				metricValue := metric.Value.AsApproximateFloat64()
@@ -924,6 +1124,14 @@ func (h *scaleHandler) getScaledJobMetrics(ctx context.Context, scaledJob *kedav
 			metricscollector.RecordScalerActive(scaledJob.Namespace, scaledJob.Name, scalerName, scalerIndex, metricName, false, isTriggerActive)
 		}
 	}
+	if scaledJob.IsUsingModifiers() {
+		// handle scalingModifiers here and simply return the composite metrics
+		scalersMetrics = modifiers.HandleScaledJobScalingModifiers(scaledJob, scalersMetrics, cache, logger)
+		for _, metrics := range scalersMetrics {
+			metricscollector.RecordScalerMetric(scaledJob.Namespace, scaledJob.Name, kedav1alpha1.CompositeMetricName, 0, kedav1alpha1.CompositeMetricName, false, metrics.QueueLength)
+			metricscollector.RecordScalerActive(scaledJob.Namespace, scaledJob.Name, kedav1alpha1.CompositeMetricName, 0, kedav1alpha1.CompositeMetricName, false, metrics.IsActive)
+		}
+	}
 	return scalersMetrics, isError
 }
 
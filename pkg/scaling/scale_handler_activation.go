@@ -0,0 +1,67 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/metrics/pkg/apis/external_metrics"
+
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+	"github.com/kedacore/keda/v2/pkg/scalers/scalersconfig"
+)
+
+// getMetricActivity returns the activity of the trigger metric, the activation hysteresis of the trigger,
+// if any, replaces the activity returned by the scaler with the one given by the highest metric value
+func (h *scaleHandler) getMetricActivity(scaledObjectIdentifier, metricName string, scalerConfig scalersconfig.ScalerConfig,
+	metrics []external_metrics.ExternalMetricValue, isActive bool) bool {
+	if scalerConfig.TriggerActivationHysteresis == nil || len(metrics) == 0 {
+		return isActive
+	}
+	// the thresholds are validated by the webhook, invalid ones disable the hysteresis
+	activateThreshold, deactivateThreshold, err := scalerConfig.TriggerActivationHysteresis.GetThresholds()
+	if err != nil {
+		return isActive
+	}
+
+	value := metrics[0].Value.AsApproximateFloat64()
+	for _, metric := range metrics[1:] {
+		value = max(value, metric.Value.AsApproximateFloat64())
+	}
+	return h.activationStore.IsMetricActive(scaledObjectIdentifier, metricName, value, activateThreshold, deactivateThreshold)
+}
+
+// stabilizeActivity returns the activity of the ScaledObject once its triggers have been active,
+// or inactive, for the whole activation stabilization window of the ScaledObject
+func (h *scaleHandler) stabilizeActivity(scaledObject *kedav1alpha1.ScaledObject, isActive bool, logger logr.Logger) bool {
+	window := scaledObject.GetActivationStabilizationWindow()
+	if window <= 0 {
+		return isActive
+	}
+
+	var initialActive *bool
+	if activeCondition := scaledObject.Status.Conditions.GetActiveCondition(); !activeCondition.IsUnknown() {
+		active := activeCondition.IsTrue()
+		initialActive = &active
+	}
+	stabilized := h.activationStore.Stabilize(scaledObject.GenerateIdentifier(), isActive, initialActive, window, time.Now())
+	if stabilized != isActive {
+		logger.V(1).Info("Activity of the triggers is stabilizing", "triggersActive", isActive, "active", stabilized, "activationStabilizationWindow", window)
+	}
+	return stabilized
+}
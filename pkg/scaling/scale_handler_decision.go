@@ -0,0 +1,202 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	v2 "k8s.io/api/autoscaling/v2"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+	kedastatus "github.com/kedacore/keda/v2/pkg/status"
+)
+
+const defaultScalingDecisionUpdateInterval = 30 * time.Second
+
+// scalingDecisionUpdateInterval is the minimum interval between two updates of the scaling decision
+// in the status of a ScaledObject, unless its activity changes. A negative value disables the updates.
+var scalingDecisionUpdateInterval = parseScalingDecisionUpdateInterval()
+
+// scalingDecisionUpdate tracks the last update of the scaling decision of a ScaledObject
+type scalingDecisionUpdate struct {
+	updatedAt time.Time
+	isActive  bool
+}
+
+// parseScalingDecisionUpdateInterval parses the KEDA_SCALING_DECISION_UPDATE_INTERVAL environment variable
+func parseScalingDecisionUpdateInterval() time.Duration {
+	value := os.Getenv("KEDA_SCALING_DECISION_UPDATE_INTERVAL")
+	if value == "" {
+		return defaultScalingDecisionUpdateInterval
+	}
+	interval, err := time.ParseDuration(value)
+	if err != nil {
+		log.Error(err, "invalid KEDA_SCALING_DECISION_UPDATE_INTERVAL, using the default", "default", defaultScalingDecisionUpdateInterval)
+		return defaultScalingDecisionUpdateInterval
+	}
+	return interval
+}
+
+// updateScalingDecision records in the status of the ScaledObject why the last scaling decision was made.
+// The status is updated at most once per scalingDecisionUpdateInterval, unless the activity of the ScaledObject changes,
+// and only if the decision differs from the one already recorded, to avoid status churn.
+func (h *scaleHandler) updateScalingDecision(ctx context.Context, scaledObject *kedav1alpha1.ScaledObject, state scaledObjectState) {
+	if h.scalingDecisionUpdates == nil || scalingDecisionUpdateInterval < 0 {
+		return
+	}
+	logger := log.WithValues("scaledObject.Namespace", scaledObject.Namespace, "scaledObject.Name", scaledObject.Name)
+
+	key := scaledObject.GenerateIdentifier()
+	now := time.Now()
+	if value, found := h.scalingDecisionUpdates.Load(key); found {
+		last := value.(scalingDecisionUpdate)
+		if last.isActive == state.IsActive && now.Sub(last.updatedAt) < scalingDecisionUpdateInterval {
+			return
+		}
+	}
+	h.scalingDecisionUpdates.Store(key, scalingDecisionUpdate{updatedAt: now, isActive: state.IsActive})
+
+	scalersCache, err := h.GetScalersCache(ctx, scaledObject)
+	if err != nil {
+		logger.Error(err, "error getting scalers cache for the scaling decision")
+		return
+	}
+	targets, err := getMetricTargets(ctx, scaledObject, scalersCache)
+	if err != nil {
+		logger.Error(err, "error getting metric targets for the scaling decision")
+		return
+	}
+	decision := buildScalingDecision(scaledObject, state, targets, h.getCurrentReplicas(ctx, scaledObject))
+
+	if previous := scaledObject.Status.ScalingDecision; previous != nil {
+		previous = previous.DeepCopy()
+		previous.LastUpdateTime = nil
+		if equality.Semantic.DeepEqual(previous, decision) {
+			return
+		}
+	}
+
+	decision.LastUpdateTime = &metav1.Time{Time: now}
+	status := scaledObject.Status.DeepCopy()
+	status.ScalingDecision = decision
+	if err := kedastatus.UpdateScaledObjectStatus(ctx, h.client, logger, scaledObject, status); err != nil {
+		logger.Error(err, "error updating scaling decision of ScaledObject")
+	}
+}
+
+// getCurrentReplicas returns the replicas of the scale target of the ScaledObject, or 1 if they can't be retrieved
+func (h *scaleHandler) getCurrentReplicas(ctx context.Context, scaledObject *kedav1alpha1.ScaledObject) int32 {
+	if h.scaleClient == nil || scaledObject.Status.ScaleTargetGVKR == nil {
+		return 1
+	}
+	scale, err := h.scaleClient.Scales(scaledObject.Namespace).Get(ctx, scaledObject.Status.ScaleTargetGVKR.GroupResource(), scaledObject.Spec.ScaleTargetRef.Name, metav1.GetOptions{})
+	if err != nil {
+		log.V(1).Info("error getting scale target replicas, assuming 1", "scaledObject.Namespace", scaledObject.Namespace, "scaledObject.Name", scaledObject.Name, "error", err.Error())
+		return 1
+	}
+	return max(scale.Spec.Replicas, 1)
+}
+
+// buildScalingDecision describes, for each metric of each trigger, its value, its target and its activity.
+// The dominating metric is the one for which the HPA proposes the highest number of replicas,
+// when using scalingModifiers it's the composite metric and the result of the formula is recorded.
+func buildScalingDecision(scaledObject *kedav1alpha1.ScaledObject, state scaledObjectState, targets map[string]metricTarget, currentReplicas int32) *kedav1alpha1.ScalingDecision {
+	decision := &kedav1alpha1.ScalingDecision{}
+	dominatingIndex := -1
+	dominatingProposal := int32(-1)
+
+	for _, trigger := range state.Triggers {
+		errMessage := ""
+		if trigger.Err != nil {
+			errMessage = trigger.Err.Error()
+		}
+		if len(trigger.MetricSpecs) == 0 {
+			decision.Triggers = append(decision.Triggers, kedav1alpha1.TriggerDecision{
+				Name:  trigger.TriggerName,
+				Type:  trigger.TriggerType,
+				Error: errMessage,
+			})
+			continue
+		}
+
+		for _, spec := range trigger.MetricSpecs {
+			triggerDecision := kedav1alpha1.TriggerDecision{
+				Name:   trigger.TriggerName,
+				Type:   trigger.TriggerType,
+				Active: trigger.IsActive,
+				Error:  errMessage,
+			}
+			switch {
+			case spec.External != nil:
+				triggerDecision.MetricName = spec.External.Metric.Name
+				triggerDecision.TargetType = spec.External.Target.Type
+				triggerDecision.Target = formatMetricTarget(spec.External.Target)
+				for _, metric := range trigger.Metrics {
+					if metric.MetricName != triggerDecision.MetricName {
+						continue
+					}
+					triggerDecision.Value = metric.Value.String()
+					target, found := targets[metric.MetricName]
+					if !found || target.value <= 0 {
+						continue
+					}
+					if proposal := getReplicasProposal(metric.Value.AsApproximateFloat64(), target, currentReplicas); proposal > dominatingProposal {
+						dominatingProposal = proposal
+						dominatingIndex = len(decision.Triggers)
+					}
+				}
+			case spec.Resource != nil:
+				// the resource metrics are collected by the HPA, KEDA doesn't know their value
+				triggerDecision.MetricName = string(spec.Resource.Name)
+				triggerDecision.TargetType = spec.Resource.Target.Type
+				triggerDecision.Target = formatMetricTarget(spec.Resource.Target)
+			}
+			decision.Triggers = append(decision.Triggers, triggerDecision)
+		}
+	}
+
+	if scaledObject.IsUsingModifiers() {
+		decision.DominatingMetric = kedav1alpha1.CompositeMetricName
+		for _, metric := range state.Metrics {
+			if metric.MetricName == kedav1alpha1.CompositeMetricName {
+				decision.FormulaResult = metric.Value.String()
+			}
+		}
+	} else if dominatingIndex >= 0 {
+		decision.Triggers[dominatingIndex].Dominating = true
+		decision.DominatingMetric = decision.Triggers[dominatingIndex].MetricName
+	}
+	return decision
+}
+
+func formatMetricTarget(target v2.MetricTarget) string {
+	switch {
+	case target.AverageUtilization != nil:
+		return fmt.Sprintf("%d%%", *target.AverageUtilization)
+	case target.AverageValue != nil:
+		return target.AverageValue.String()
+	case target.Value != nil:
+		return target.Value.String()
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	v2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/metrics/pkg/apis/external_metrics"
+
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+	"github.com/kedacore/keda/v2/pkg/eventreason"
+	"github.com/kedacore/keda/v2/pkg/metricscollector"
+	"github.com/kedacore/keda/v2/pkg/scaling/cache"
+	kedastatus "github.com/kedacore/keda/v2/pkg/status"
+)
+
+// hpaTolerance is the default tolerance of the HPA controller, changes of the usage ratio
+// smaller than the tolerance don't change the number of replicas
+const hpaTolerance = 0.1
+
+// metricTarget is the target of a metric exposed to the HPA
+type metricTarget struct {
+	metricType v2.MetricTargetType
+	value      float64
+}
+
+// handleDryRun computes the replicas that KEDA and the HPA would request for a ScaledObject in dry-run mode,
+// and publishes them in the status, in an event and in the shadow desired replicas metric, without scaling the target
+func (h *scaleHandler) handleDryRun(ctx context.Context, scaledObject *kedav1alpha1.ScaledObject, isActive bool, isError bool, metrics []external_metrics.ExternalMetricValue) {
+	logger := log.WithValues("scaledObject.Namespace", scaledObject.Namespace, "scaledObject.Name", scaledObject.Name)
+	// keep the last decision when the triggers can't be evaluated
+	if isError || scaledObject.Status.ScaleTargetGVKR == nil {
+		return
+	}
+
+	scale, err := h.scaleClient.Scales(scaledObject.Namespace).Get(ctx, scaledObject.Status.ScaleTargetGVKR.GroupResource(), scaledObject.Spec.ScaleTargetRef.Name, metav1.GetOptions{})
+	if err != nil {
+		logger.Error(err, "error getting scale target of ScaledObject in dry-run mode")
+		return
+	}
+	currentReplicas := scale.Spec.Replicas
+
+	scalersCache, err := h.GetScalersCache(ctx, scaledObject)
+	if err != nil {
+		logger.Error(err, "error getting scalers cache of ScaledObject in dry-run mode")
+		return
+	}
+	targets, err := getMetricTargets(ctx, scaledObject, scalersCache)
+	if err != nil {
+		logger.Error(err, "error getting metric targets of ScaledObject in dry-run mode")
+		return
+	}
+	desiredReplicas := getDryRunDesiredReplicas(scaledObject, isActive, currentReplicas, metrics, targets)
+	metricscollector.RecordScaledObjectShadowDesiredReplicas(scaledObject.Namespace, scaledObject.Name, desiredReplicas)
+
+	previous := scaledObject.Status.DryRun
+	if previous != nil && previous.DesiredReplicas == desiredReplicas && previous.CurrentReplicas == currentReplicas {
+		return
+	}
+	if desiredReplicas != currentReplicas && (previous == nil || previous.DesiredReplicas != desiredReplicas) {
+		h.recorder.Eventf(scaledObject, corev1.EventTypeNormal, eventreason.KEDAScaleTargetDryRunScaled,
+			"ScaledObject is in dry-run mode, it would have scaled %s %s/%s from %d to %d replicas",
+			scaledObject.Status.ScaleTargetKind, scaledObject.Namespace, scaledObject.Spec.ScaleTargetRef.Name, currentReplicas, desiredReplicas)
+	}
+
+	now := metav1.Now()
+	status := scaledObject.Status.DeepCopy()
+	status.DryRun = &kedav1alpha1.DryRunStatus{
+		DesiredReplicas:    desiredReplicas,
+		CurrentReplicas:    currentReplicas,
+		LastTransitionTime: &now,
+	}
+	if err := kedastatus.UpdateScaledObjectStatus(ctx, h.client, logger, scaledObject, status); err != nil {
+		logger.Error(err, "error updating dry-run status of ScaledObject")
+	}
+}
+
+// clearDryRun removes the dry-run status and metric of a ScaledObject that is no longer in dry-run mode
+func (h *scaleHandler) clearDryRun(ctx context.Context, scaledObject *kedav1alpha1.ScaledObject) {
+	if scaledObject.Status.DryRun == nil {
+		return
+	}
+	logger := log.WithValues("scaledObject.Namespace", scaledObject.Namespace, "scaledObject.Name", scaledObject.Name)
+	metricscollector.DeleteScaledObjectShadowDesiredReplicas(scaledObject.Namespace, scaledObject.Name)
+	status := scaledObject.Status.DeepCopy()
+	status.DryRun = nil
+	if err := kedastatus.UpdateScaledObjectStatus(ctx, h.client, logger, scaledObject, status); err != nil {
+		logger.Error(err, "error clearing dry-run status of ScaledObject")
+	}
+}
+
+// getReplicasProposal returns the replicas the HPA proposes for a metric with the given value and target
+func getReplicasProposal(value float64, target metricTarget, replicas int32) int32 {
+	var usageRatio float64
+	if target.metricType == v2.AverageValueMetricType {
+		usageRatio = value / (target.value * float64(replicas))
+	} else {
+		usageRatio = value / target.value
+	}
+	if math.Abs(1.0-usageRatio) <= hpaTolerance {
+		return replicas
+	}
+	return int32(math.Ceil(usageRatio * float64(replicas)))
+}
+
+// getMetricTargets returns the targets of the external metrics the HPA would be based on, indexed by metric name.
+// When using scalingModifiers, the HPA is based only on the composite metric.
+func getMetricTargets(ctx context.Context, scaledObject *kedav1alpha1.ScaledObject, scalersCache *cache.ScalersCache) (map[string]metricTarget, error) {
+	targets := map[string]metricTarget{}
+	if scaledObject.IsUsingModifiers() {
+		value, err := strconv.ParseFloat(scaledObject.Spec.Advanced.ScalingModifiers.Target, 64)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing scalingModifiers.target: %w", err)
+		}
+		metricType := scaledObject.Spec.Advanced.ScalingModifiers.MetricType
+		if metricType == "" {
+			metricType = v2.AverageValueMetricType
+		}
+		targets[kedav1alpha1.CompositeMetricName] = metricTarget{metricType: metricType, value: value}
+		return targets, nil
+	}
+
+	for _, spec := range scalersCache.GetMetricSpecForScaling(ctx) {
+		if spec.External == nil {
+			continue
+		}
+		target := spec.External.Target
+		switch {
+		case target.Type == v2.AverageValueMetricType && target.AverageValue != nil:
+			targets[spec.External.Metric.Name] = metricTarget{metricType: v2.AverageValueMetricType, value: target.AverageValue.AsApproximateFloat64()}
+		case target.Type == v2.ValueMetricType && target.Value != nil:
+			targets[spec.External.Metric.Name] = metricTarget{metricType: v2.ValueMetricType, value: target.Value.AsApproximateFloat64()}
+		}
+	}
+	return targets, nil
+}
+
+// getDryRunDesiredReplicas returns the replicas the scale target would have, following the activation logic of KEDA
+// and the algorithm of the HPA for external metrics: the replicas are proportional to the ratio between the metric
+// and its target, the highest proposal across metrics wins and the result is bounded by min and max replicas.
+// cpu and memory triggers can't be evaluated, so they don't contribute to the proposal.
+func getDryRunDesiredReplicas(scaledObject *kedav1alpha1.ScaledObject, isActive bool, currentReplicas int32, metrics []external_metrics.ExternalMetricValue, targets map[string]metricTarget) int32 {
+	if !isActive && (scaledObject.Spec.MinReplicaCount == nil || *scaledObject.Spec.MinReplicaCount == 0) {
+		if scaledObject.Spec.IdleReplicaCount != nil {
+			return *scaledObject.Spec.IdleReplicaCount
+		}
+		return 0
+	}
+
+	// the HPA scales from the replicas KEDA activated the scale target with
+	replicas := max(currentReplicas, 1)
+	desiredReplicas := int32(-1)
+	for _, metric := range metrics {
+		target, found := targets[metric.MetricName]
+		if !found || target.value <= 0 {
+			continue
+		}
+		desiredReplicas = max(desiredReplicas, getReplicasProposal(metric.Value.AsApproximateFloat64(), target, replicas))
+	}
+	if desiredReplicas < 0 {
+		desiredReplicas = replicas
+	}
+
+	return min(max(desiredReplicas, *scaledObject.GetHPAMinReplicas()), scaledObject.GetHPAMaxReplicas())
+}
@@ -0,0 +1,27 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+	"github.com/kedacore/keda/v2/pkg/scaling/history"
+)
+
+// GetScalingHistory returns the latest scale transitions of the ScaledObject or ScaledJob, from the oldest to the latest
+func (h *scaleHandler) GetScalingHistory(kind, namespace, name string) []history.Transition {
+	return h.scalingHistory.List(kedav1alpha1.GenerateIdentifier(kind, namespace, name))
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"time"
+
+	"github.com/kedacore/keda/v2/pkg/scalers/scalersconfig"
+	"github.com/kedacore/keda/v2/pkg/scaling/cache"
+	"github.com/kedacore/keda/v2/pkg/scaling/polling"
+)
+
+// getPollingConfig returns the polling configuration of the trigger, the second return value is false
+// if the trigger doesn't define its own polling, in which case it's polled on every iteration of the scale loop
+func getPollingConfig(scalerConfig scalersconfig.ScalerConfig, defaultInterval time.Duration) (polling.Config, bool) {
+	if scalerConfig.TriggerPollingInterval <= 0 && scalerConfig.TriggerAdaptivePolling == nil {
+		return polling.Config{}, false
+	}
+
+	config := polling.Config{Interval: defaultInterval}
+	if scalerConfig.TriggerPollingInterval > 0 {
+		config.Interval = scalerConfig.TriggerPollingInterval
+	}
+	if adaptivePolling := scalerConfig.TriggerAdaptivePolling; adaptivePolling != nil {
+		// the factor is validated by the webhook, an invalid one disables the backoff
+		if factor, err := adaptivePolling.GetBackoffFactor(); err == nil {
+			config.MaxInterval = time.Second * time.Duration(adaptivePolling.MaxPollingInterval)
+			config.BackoffFactor = factor
+		}
+	}
+	return config, true
+}
+
+// pollMetricsAndActivity returns the metrics and activity of the trigger, querying the scaler only
+// if the trigger is due for polling. Otherwise the last result is returned and the latency is -1.
+// It's used by the scale loop only, the metrics requests of the HPA always query the scaler.
+func (h *scaleHandler) pollMetricsAndActivity(ctx context.Context, cache *cache.ScalersCache, scalableObjectIdentifier string, defaultInterval time.Duration,
+	triggerIndex int, metricName string, scalerConfig scalersconfig.ScalerConfig) (polling.Result, time.Duration) {
+	config, ok := getPollingConfig(scalerConfig, defaultInterval)
+	now := time.Now()
+	if ok {
+		if last, found := h.pollingScheduler.Last(scalableObjectIdentifier, metricName, config, now); found {
+			return last, -1
+		}
+	}
+
+	metrics, isActive, latency, err := cache.GetMetricsAndActivityForScaler(ctx, triggerIndex, metricName)
+	result := polling.Result{
+		Metrics:  metrics,
+		IsActive: isActive,
+		Err:      err,
+		PolledAt: now,
+	}
+	if ok {
+		h.pollingScheduler.Record(scalableObjectIdentifier, metricName, config, result)
+	}
+	return result, latency
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	v2 "k8s.io/api/autoscaling/v2"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/metrics/pkg/apis/external_metrics"
+
+	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+	"github.com/kedacore/keda/v2/pkg/scaling/cache"
+	"github.com/kedacore/keda/v2/pkg/scaling/cache/metricscache"
+	"github.com/kedacore/keda/v2/pkg/scaling/prediction"
+)
+
+// getPredictionRecord feeds the metrics computed in the scale loop to the prediction models and returns
+// the record for the prediction metric. The value of the prediction metric is the number of replicas needed
+// to handle the forecasted metric values `horizon` seconds from now: the HPA is targeting AverageValue=1,
+// so for each forecasted metric we divide its value by its AverageValue target and we take the maximum.
+// When using scalingModifiers, the composite metric is forecasted instead of the individual triggers.
+func (h *scaleHandler) getPredictionRecord(ctx context.Context, scaledObject *kedav1alpha1.ScaledObject, cache *cache.ScalersCache, metrics []external_metrics.ExternalMetricValue) (metricscache.MetricsRecord, error) {
+	predictionConfig := scaledObject.Spec.Advanced.Prediction
+	alpha, beta, gamma, err := predictionConfig.GetSmoothingFactors()
+	if err != nil {
+		return metricscache.MetricsRecord{}, err
+	}
+
+	withTriggers, err := kedav1alpha1.AsDuckWithTriggers(scaledObject)
+	if err != nil {
+		return metricscache.MetricsRecord{}, err
+	}
+	// the models are fed once per iteration of the scale loop, so the period and the horizon are expressed in number of iterations
+	pollingInterval := withTriggers.GetScaleLoopInterval().Seconds()
+	params := prediction.Parameters{
+		Period: int(math.Round(float64(predictionConfig.GetSeasonalPeriod()) / pollingInterval)),
+		Alpha:  alpha,
+		Beta:   beta,
+		Gamma:  gamma,
+	}
+	horizonSteps := int(math.Ceil(float64(predictionConfig.Horizon) / pollingInterval))
+
+	targets, err := getPredictionTargets(ctx, scaledObject, cache)
+	if err != nil {
+		return metricscache.MetricsRecord{}, err
+	}
+
+	scaledObjectIdentifier := scaledObject.GenerateIdentifier()
+	desiredReplicas := float64(-1)
+	for _, metric := range metrics {
+		target, ok := targets[metric.MetricName]
+		if !ok {
+			continue
+		}
+		h.predictionStore.Observe(scaledObjectIdentifier, metric.MetricName, metric.Value.AsApproximateFloat64(), params)
+		forecast, _ := h.predictionStore.Forecast(scaledObjectIdentifier, metric.MetricName, horizonSteps)
+		desiredReplicas = math.Max(desiredReplicas, math.Max(forecast, 0)/target)
+	}
+	if desiredReplicas < 0 {
+		return metricscache.MetricsRecord{}, fmt.Errorf("no metric with AverageValue target found for prediction")
+	}
+
+	return metricscache.MetricsRecord{
+		Metric: []external_metrics.ExternalMetricValue{
+			{
+				MetricName: kedav1alpha1.PredictionMetricName,
+				Value:      *resource.NewMilliQuantity(int64(desiredReplicas*1000), resource.DecimalSI),
+				Timestamp:  metav1.Now(),
+			},
+		},
+	}, nil
+}
+
+// getPredictionTargets returns the AverageValue targets of the metrics that can be forecasted
+func getPredictionTargets(ctx context.Context, scaledObject *kedav1alpha1.ScaledObject, cache *cache.ScalersCache) (map[string]float64, error) {
+	targets := map[string]float64{}
+	if scaledObject.IsUsingModifiers() {
+		target, err := strconv.ParseFloat(scaledObject.Spec.Advanced.ScalingModifiers.Target, 64)
+		if err != nil || target <= 0 {
+			return nil, fmt.Errorf("scalingModifiers.Target is not valid for prediction")
+		}
+		targets[kedav1alpha1.CompositeMetricName] = target
+		return targets, nil
+	}
+
+	for _, spec := range cache.GetMetricSpecForScaling(ctx) {
+		if spec.External == nil || spec.External.Target.Type != v2.AverageValueMetricType || spec.External.Target.AverageValue == nil {
+			continue
+		}
+		if target := spec.External.Target.AverageValue.AsApproximateFloat64(); target > 0 {
+			targets[spec.External.Metric.Name] = target
+		}
+	}
+	return targets, nil
+}
@@ -100,6 +100,8 @@ type metricMeta struct {
 type RawMetrics struct {
 	Meta   metricMeta
 	Values []external_metrics.ExternalMetricValue
+	// CollectedAt is the time the values were collected from the scaler, it's older than now for cached metrics
+	CollectedAt time.Time
 }
 
 type RawMetricSubscriptions struct {
@@ -207,7 +209,7 @@ func (h *scaleHandler) GetRawMetricsChan(subscriber string) (chan RawMetrics, ch
 	return h.rawMetricsSubscriptions[subscriber].rawMetrics, h.rawMetricsSubscriptions[subscriber].done
 }
 
-func (h *scaleHandler) sendWhenSubscribed(soName, ns, triggerName string, metrics []external_metrics.ExternalMetricValue) {
+func (h *scaleHandler) sendWhenSubscribed(soName, ns, triggerName string, metrics []external_metrics.ExternalMetricValue, collectedAt time.Time) {
 	mm := metricMeta{
 		TriggerName:      triggerName,
 		ScaledObjectName: soName,
@@ -225,7 +227,7 @@ func (h *scaleHandler) sendWhenSubscribed(soName, ns, triggerName string, metric
 	if len(targets) > 0 {
 		vals := make([]external_metrics.ExternalMetricValue, len(metrics))
 		copy(vals, metrics)
-		msg := RawMetrics{Meta: mm, Values: vals}
+		msg := RawMetrics{Meta: mm, Values: vals, CollectedAt: collectedAt}
 
 		for _, t := range targets {
 			select {
@@ -37,6 +37,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/metrics/pkg/apis/external_metrics"
+	"k8s.io/utils/ptr"
 
 	kedav1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
 	"github.com/kedacore/keda/v2/pkg/mock/mock_client"
@@ -45,8 +46,11 @@ import (
 	"github.com/kedacore/keda/v2/pkg/scalers"
 	"github.com/kedacore/keda/v2/pkg/scalers/authentication"
 	"github.com/kedacore/keda/v2/pkg/scalers/scalersconfig"
+	"github.com/kedacore/keda/v2/pkg/scaling/activation"
 	"github.com/kedacore/keda/v2/pkg/scaling/cache"
 	"github.com/kedacore/keda/v2/pkg/scaling/cache/metricscache"
+	"github.com/kedacore/keda/v2/pkg/scaling/polling"
+	"github.com/kedacore/keda/v2/pkg/scaling/prediction"
 )
 
 const testNamespaceGlobal = "testNamespace"
@@ -145,6 +149,235 @@ func TestGetScaledObjectMetrics_DirectCall(t *testing.T) {
 	scalerCache.Close(context.Background())
 }
 
+func TestGetScaledObjectMetrics_BypassesPollingSchedule(t *testing.T) {
+	scaledObjectName := testNameGlobal
+	scaledObjectNamespace := testNamespaceGlobal
+	metricName := "test-metric-name"
+	triggerPollingInterval := 300 * time.Second
+
+	ctrl := gomock.NewController(t)
+	recorder := record.NewFakeRecorder(1)
+	mockClient := mock_client.NewMockClient(ctrl)
+	mockExecutor := mock_executor.NewMockScaleExecutor(ctrl)
+
+	metricsSpecs := []v2.MetricSpec{createMetricSpec(10, metricName)}
+	metricValue := scalers.GenerateMetricInMili(metricName, float64(10))
+
+	scaler := mock_scalers.NewMockScaler(ctrl)
+	scalerConfig := scalersconfig.ScalerConfig{TriggerPollingInterval: triggerPollingInterval}
+	factory := func() (scalers.Scaler, *scalersconfig.ScalerConfig, error) {
+		return scaler, &scalerConfig, nil
+	}
+
+	scaledObject := kedav1alpha1.ScaledObject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      scaledObjectName,
+			Namespace: scaledObjectNamespace,
+		},
+		Spec: kedav1alpha1.ScaledObjectSpec{
+			ScaleTargetRef: &kedav1alpha1.ScaleTarget{
+				Name: "test",
+			},
+		},
+		Status: kedav1alpha1.ScaledObjectStatus{
+			ScaleTargetGVKR: &kedav1alpha1.GroupVersionKindResource{
+				Group: "apps",
+				Kind:  "Deployment",
+			},
+		},
+	}
+
+	scalerCache := cache.ScalersCache{
+		ScaledObject: &scaledObject,
+		Scalers: []cache.ScalerBuilder{{
+			Scaler:       scaler,
+			ScalerConfig: scalerConfig,
+			Factory:      factory,
+		}},
+		Recorder: recorder,
+	}
+
+	caches := map[string]*cache.ScalersCache{}
+	caches[scaledObject.GenerateIdentifier()] = &scalerCache
+
+	sh := scaleHandler{
+		client:                   mockClient,
+		scaleLoopContexts:        &sync.Map{},
+		scaleExecutor:            mockExecutor,
+		globalHTTPTimeout:        time.Duration(1000),
+		recorder:                 recorder,
+		scalerCaches:             caches,
+		scalerCachesLock:         &sync.RWMutex{},
+		scaledObjectsMetricCache: metricscache.NewMetricsCache(),
+		pollingScheduler:         polling.NewScheduler(),
+		rawMetricsSubscriptions:  map[string]*RawMetricSubscriptions{},
+		metricToSubscriptions:    map[metricMeta][]*RawMetricSubscriptions{},
+		subsLock:                 &sync.RWMutex{},
+	}
+
+	mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	scaler.EXPECT().GetMetricSpecForScaling(gomock.Any()).Return(metricsSpecs).Times(3)
+	// the trigger is due again only in 5 minutes, but the metrics requests of the HPA always query the scaler
+	scaler.EXPECT().GetMetricsAndActivity(gomock.Any(), gomock.Any()).Return([]external_metrics.ExternalMetricValue{metricValue}, true, nil).Times(3)
+	mockExecutor.EXPECT().RequestScale(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any())
+	sh.checkScalers(context.TODO(), &scaledObject, &sync.RWMutex{})
+
+	expectNoStatusPatch(ctrl)
+	for i := 0; i < 2; i++ {
+		metrics, err := sh.GetScaledObjectMetrics(context.TODO(), scaledObjectName, scaledObjectNamespace, metricName)
+		assert.Nil(t, err)
+		assert.Len(t, metrics.Items, 1)
+	}
+
+	scaler.EXPECT().Close(gomock.Any())
+	scalerCache.Close(context.Background())
+}
+
+func TestGetScaledObjectState_TriggerPollingInterval(t *testing.T) {
+	metricName := "test-metric-name"
+	pollingInterval := int32(10)
+
+	ctrl := gomock.NewController(t)
+	recorder := record.NewFakeRecorder(1)
+
+	metricsSpecs := []v2.MetricSpec{createMetricSpec(10, metricName)}
+	metricValue := scalers.GenerateMetricInMili(metricName, float64(10))
+
+	scaler := mock_scalers.NewMockScaler(ctrl)
+	// the trigger is polled every 5 minutes, while the ScaledObject is checked every 10 seconds
+	scalerConfig := scalersconfig.ScalerConfig{TriggerPollingInterval: 5 * time.Minute}
+	factory := func() (scalers.Scaler, *scalersconfig.ScalerConfig, error) {
+		return scaler, &scalerConfig, nil
+	}
+
+	scaledObject := kedav1alpha1.ScaledObject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "test",
+		},
+		Spec: kedav1alpha1.ScaledObjectSpec{
+			ScaleTargetRef: &kedav1alpha1.ScaleTarget{
+				Name: "test",
+			},
+			PollingInterval: &pollingInterval,
+		},
+	}
+
+	scalerCache := cache.ScalersCache{
+		ScaledObject: &scaledObject,
+		Scalers: []cache.ScalerBuilder{{
+			Scaler:       scaler,
+			ScalerConfig: scalerConfig,
+			Factory:      factory,
+		}},
+		Recorder: recorder,
+	}
+
+	caches := map[string]*cache.ScalersCache{}
+	caches[scaledObject.GenerateIdentifier()] = &scalerCache
+
+	sh := scaleHandler{
+		scaleLoopContexts:        &sync.Map{},
+		recorder:                 recorder,
+		scalerCaches:             caches,
+		scalerCachesLock:         &sync.RWMutex{},
+		scaledObjectsMetricCache: metricscache.NewMetricsCache(),
+		pollingScheduler:         polling.NewScheduler(),
+		rawMetricsSubscriptions:  map[string]*RawMetricSubscriptions{},
+		metricToSubscriptions:    map[metricMeta][]*RawMetricSubscriptions{},
+		subsLock:                 &sync.RWMutex{},
+	}
+
+	scaler.EXPECT().GetMetricSpecForScaling(gomock.Any()).Return(metricsSpecs).Times(2)
+	// the scaler is queried only once, the second check uses the last result
+	scaler.EXPECT().GetMetricsAndActivity(gomock.Any(), gomock.Any()).Return([]external_metrics.ExternalMetricValue{metricValue}, true, nil).Times(1)
+
+	for i := 0; i < 2; i++ {
+		state, err := sh.getScaledObjectState(context.TODO(), &scaledObject)
+		isActive, isError, activeTriggers := state.IsActive, state.IsError, state.ActiveTriggers
+		assert.Nil(t, err)
+		assert.False(t, isError)
+		assert.True(t, isActive)
+		assert.Len(t, activeTriggers, 1)
+	}
+
+	scaler.EXPECT().Close(gomock.Any())
+	scalerCache.Close(context.Background())
+}
+
+func TestGetScaledObjectState_ActivationHysteresis(t *testing.T) {
+	metricName := "test-metric-name"
+
+	ctrl := gomock.NewController(t)
+	recorder := record.NewFakeRecorder(1)
+
+	metricsSpecs := []v2.MetricSpec{createMetricSpec(10, metricName)}
+
+	scaler := mock_scalers.NewMockScaler(ctrl)
+	// the scaler activation threshold is replaced by the high and low watermarks
+	scalerConfig := scalersconfig.ScalerConfig{
+		TriggerActivationHysteresis: &kedav1alpha1.ActivationHysteresis{ActivateThreshold: "10", DeactivateThreshold: "5"},
+	}
+	factory := func() (scalers.Scaler, *scalersconfig.ScalerConfig, error) {
+		return scaler, &scalerConfig, nil
+	}
+
+	scaledObject := kedav1alpha1.ScaledObject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "test",
+		},
+		Spec: kedav1alpha1.ScaledObjectSpec{
+			ScaleTargetRef: &kedav1alpha1.ScaleTarget{
+				Name: "test",
+			},
+			Triggers: []kedav1alpha1.ScaleTriggers{{Type: "rabbitmq"}},
+		},
+	}
+
+	scalerCache := cache.ScalersCache{
+		ScaledObject: &scaledObject,
+		Scalers: []cache.ScalerBuilder{{
+			Scaler:       scaler,
+			ScalerConfig: scalerConfig,
+			Factory:      factory,
+		}},
+		Recorder: recorder,
+	}
+
+	caches := map[string]*cache.ScalersCache{}
+	caches[scaledObject.GenerateIdentifier()] = &scalerCache
+
+	sh := scaleHandler{
+		scaleLoopContexts:        &sync.Map{},
+		recorder:                 recorder,
+		scalerCaches:             caches,
+		scalerCachesLock:         &sync.RWMutex{},
+		scaledObjectsMetricCache: metricscache.NewMetricsCache(),
+		pollingScheduler:         polling.NewScheduler(),
+		activationStore:          activation.NewStore(),
+		rawMetricsSubscriptions:  map[string]*RawMetricSubscriptions{},
+		metricToSubscriptions:    map[metricMeta][]*RawMetricSubscriptions{},
+		subsLock:                 &sync.RWMutex{},
+	}
+
+	for _, step := range []struct {
+		value    float64
+		isActive bool
+	}{{7, false}, {11, true}, {7, true}, {5, false}, {7, false}} {
+		metricValue := scalers.GenerateMetricInMili(metricName, step.value)
+		scaler.EXPECT().GetMetricSpecForScaling(gomock.Any()).Return(metricsSpecs)
+		scaler.EXPECT().GetMetricsAndActivity(gomock.Any(), gomock.Any()).Return([]external_metrics.ExternalMetricValue{metricValue}, step.value > 0, nil)
+
+		state, err := sh.getScaledObjectState(context.TODO(), &scaledObject)
+		assert.Nil(t, err)
+		assert.Equal(t, step.isActive, state.IsActive, "metric value %v", step.value)
+	}
+
+	scaler.EXPECT().Close(gomock.Any())
+	scalerCache.Close(context.Background())
+}
+
 func TestGetScaledObjectMetrics_FromCache(t *testing.T) {
 	scaledObjectName := "testName2"
 	scaledObjectNamespace := "testNamespace2"
@@ -236,6 +469,192 @@ func TestGetScaledObjectMetrics_FromCache(t *testing.T) {
 	scalerCache.Close(context.Background())
 }
 
+func TestGetScaledObjectMetrics_StaleCache(t *testing.T) {
+	scaledObjectName := "testName"
+	scaledObjectNamespace := "testNamespace"
+	metricName := "test-metric-name"
+
+	ctrl := gomock.NewController(t)
+	recorder := record.NewFakeRecorder(1)
+	mockClient := mock_client.NewMockClient(ctrl)
+
+	metricsSpecs := []v2.MetricSpec{createMetricSpec(10, metricName)}
+	metricValue := scalers.GenerateMetricInMili(metricName, float64(10))
+
+	scaler := mock_scalers.NewMockScaler(ctrl)
+	// cached metrics older than a minute mustn't be served
+	scalerConfig := scalersconfig.ScalerConfig{TriggerUseCachedMetrics: true, TriggerMaxMetricAge: time.Minute}
+	factory := func() (scalers.Scaler, *scalersconfig.ScalerConfig, error) {
+		return scaler, &scalerConfig, nil
+	}
+
+	scaledObject := kedav1alpha1.ScaledObject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      scaledObjectName,
+			Namespace: scaledObjectNamespace,
+		},
+		Spec: kedav1alpha1.ScaledObjectSpec{
+			ScaleTargetRef: &kedav1alpha1.ScaleTarget{
+				Name: "test",
+			},
+		},
+	}
+
+	scalerCache := cache.ScalersCache{
+		ScaledObject: &scaledObject,
+		Scalers: []cache.ScalerBuilder{{
+			Scaler:       scaler,
+			ScalerConfig: scalerConfig,
+			Factory:      factory,
+		}},
+		Recorder: recorder,
+	}
+
+	caches := map[string]*cache.ScalersCache{}
+	caches[scaledObject.GenerateIdentifier()] = &scalerCache
+
+	metricsCache := metricscache.NewMetricsCache()
+	sh := scaleHandler{
+		client:                   mockClient,
+		scaleLoopContexts:        &sync.Map{},
+		globalHTTPTimeout:        time.Duration(1000),
+		recorder:                 recorder,
+		scalerCaches:             caches,
+		scalerCachesLock:         &sync.RWMutex{},
+		scaledObjectsMetricCache: metricsCache,
+		rawMetricsSubscriptions:  map[string]*RawMetricSubscriptions{},
+		metricToSubscriptions:    map[metricMeta][]*RawMetricSubscriptions{},
+		subsLock:                 &sync.RWMutex{},
+	}
+
+	// a fresh record is served from the cache
+	metricsCache.StoreRecords(scaledObject.GenerateIdentifier(), map[string]metricscache.MetricsRecord{
+		metricName: {
+			IsActive:  true,
+			Metric:    []external_metrics.ExternalMetricValue{metricValue},
+			Timestamp: time.Now().Add(-30 * time.Second),
+		},
+	})
+	scaler.EXPECT().GetMetricSpecForScaling(gomock.Any()).Return(metricsSpecs)
+	metrics, err := sh.GetScaledObjectMetrics(context.TODO(), scaledObjectName, scaledObjectNamespace, metricName)
+	assert.Nil(t, err)
+	assert.Len(t, metrics.Items, 1)
+
+	// a stale record is treated as a scaler error
+	metricsCache.StoreRecords(scaledObject.GenerateIdentifier(), map[string]metricscache.MetricsRecord{
+		metricName: {
+			IsActive:  true,
+			Metric:    []external_metrics.ExternalMetricValue{metricValue},
+			Timestamp: time.Now().Add(-2 * time.Minute),
+		},
+	})
+	scaler.EXPECT().GetMetricSpecForScaling(gomock.Any()).Return(metricsSpecs)
+	// the scalers cache is cleared because of the error
+	scaler.EXPECT().Close(gomock.Any())
+	metrics, err = sh.GetScaledObjectMetrics(context.TODO(), scaledObjectName, scaledObjectNamespace, metricName)
+	assert.Nil(t, metrics)
+	assert.Error(t, err)
+}
+
+func TestGetScaledObjectMetrics_Prediction(t *testing.T) {
+	scaledObjectName := "testName3"
+	scaledObjectNamespace := "testNamespace3"
+	metricName := "test-metric-name3"
+	pollingInterval := int32(60)
+
+	ctrl := gomock.NewController(t)
+	recorder := record.NewFakeRecorder(1)
+	mockClient := mock_client.NewMockClient(ctrl)
+	mockExecutor := mock_executor.NewMockScaleExecutor(ctrl)
+
+	metricSpec := createMetricSpec(10, metricName)
+	metricSpec.External.Target.Type = v2.AverageValueMetricType
+	metricsSpecs := []v2.MetricSpec{metricSpec}
+
+	scaler := mock_scalers.NewMockScaler(ctrl)
+	scalerConfig := scalersconfig.ScalerConfig{}
+	factory := func() (scalers.Scaler, *scalersconfig.ScalerConfig, error) {
+		return scaler, &scalerConfig, nil
+	}
+
+	// seasonal period of 2 polling intervals and horizon of 1 polling interval
+	seasonalPeriod := int32(120)
+	scaledObject := kedav1alpha1.ScaledObject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      scaledObjectName,
+			Namespace: scaledObjectNamespace,
+		},
+		Spec: kedav1alpha1.ScaledObjectSpec{
+			ScaleTargetRef: &kedav1alpha1.ScaleTarget{
+				Name: "test",
+			},
+			PollingInterval: &pollingInterval,
+			Advanced: &kedav1alpha1.AdvancedConfig{
+				Prediction: &kedav1alpha1.PredictionConfig{
+					Horizon:        60,
+					SeasonalPeriod: &seasonalPeriod,
+					Alpha:          "0.5",
+					Beta:           "0",
+					Gamma:          "0.5",
+				},
+			},
+		},
+	}
+
+	scalerCache := cache.ScalersCache{
+		ScaledObject: &scaledObject,
+		Scalers: []cache.ScalerBuilder{{
+			Scaler:       scaler,
+			ScalerConfig: scalerConfig,
+			Factory:      factory,
+		}},
+		Recorder: recorder,
+	}
+
+	caches := map[string]*cache.ScalersCache{}
+	caches[scaledObject.GenerateIdentifier()] = &scalerCache
+
+	sh := scaleHandler{
+		client:                   mockClient,
+		scaleLoopContexts:        &sync.Map{},
+		scaleExecutor:            mockExecutor,
+		globalHTTPTimeout:        time.Duration(1000),
+		recorder:                 recorder,
+		scalerCaches:             caches,
+		scalerCachesLock:         &sync.RWMutex{},
+		scaledObjectsMetricCache: metricscache.NewMetricsCache(),
+		predictionStore:          prediction.NewStore(),
+		rawMetricsSubscriptions:  map[string]*RawMetricSubscriptions{},
+		metricToSubscriptions:    map[metricMeta][]*RawMetricSubscriptions{},
+		subsLock:                 &sync.RWMutex{},
+	}
+
+	// there is no forecast before the first scale loop
+	_, err := sh.GetScaledObjectMetrics(context.TODO(), scaledObjectName, scaledObjectNamespace, kedav1alpha1.PredictionMetricName)
+	assert.NotNil(t, err)
+
+	// the metric alternates between 10 and 40
+	values := []float64{10, 40, 10, 40, 10, 40}
+	for _, value := range values {
+		metricValue := scalers.GenerateMetricInMili(metricName, value)
+		mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		scaler.EXPECT().GetMetricSpecForScaling(gomock.Any()).Return(metricsSpecs).AnyTimes()
+		scaler.EXPECT().GetMetricsAndActivity(gomock.Any(), gomock.Any()).Return([]external_metrics.ExternalMetricValue{metricValue}, true, nil)
+		mockExecutor.EXPECT().RequestScale(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any())
+		sh.checkScalers(context.TODO(), &scaledObject, &sync.RWMutex{})
+	}
+
+	// the next value is expected to be 10, with a target of 10 it means 1 replica
+	metrics, err := sh.GetScaledObjectMetrics(context.TODO(), scaledObjectName, scaledObjectNamespace, kedav1alpha1.PredictionMetricName)
+	assert.Nil(t, err)
+	assert.Len(t, metrics.Items, 1)
+	assert.Equal(t, kedav1alpha1.PredictionMetricName, metrics.Items[0].MetricName)
+	assert.InDelta(t, float64(1), metrics.Items[0].Value.AsApproximateFloat64(), 0.01)
+
+	scaler.EXPECT().Close(gomock.Any())
+	scalerCache.Close(context.Background())
+}
+
 // TestGetScaledObjectMetrics_InParallel executes
 // a request to multiple scalers with a delay.
 // The sum off all the scalers is more than the timeout
@@ -434,7 +853,8 @@ func TestCheckScaledObjectScalersWithError(t *testing.T) {
 		subsLock:                 &sync.RWMutex{},
 	}
 
-	isActive, isError, _, activeTriggers, _ := sh.getScaledObjectState(context.TODO(), &scaledObject)
+	state, _ := sh.getScaledObjectState(context.TODO(), &scaledObject)
+	isActive, isError, activeTriggers := state.IsActive, state.IsError, state.ActiveTriggers
 	scalerCache.Close(context.Background())
 
 	assert.Equal(t, false, isActive)
@@ -557,7 +977,8 @@ func TestCheckScaledObjectScalersWithTriggerAuthError(t *testing.T) {
 		subsLock:                &sync.RWMutex{},
 	}
 
-	isActive, isError, _, activeTriggers, _ := sh.getScaledObjectState(context.TODO(), &scaledObject)
+	state, _ := sh.getScaledObjectState(context.TODO(), &scaledObject)
+	isActive, isError, activeTriggers := state.IsActive, state.IsError, state.ActiveTriggers
 	scalerCache.Close(context.Background())
 
 	assert.Equal(t, false, isActive)
@@ -640,7 +1061,8 @@ func TestCheckScaledObjectFindFirstActiveNotIgnoreOthers(t *testing.T) {
 		subsLock:                 &sync.RWMutex{},
 	}
 
-	isActive, isError, _, activeTriggers, _ := sh.getScaledObjectState(context.TODO(), &scaledObject)
+	state, _ := sh.getScaledObjectState(context.TODO(), &scaledObject)
+	isActive, isError, activeTriggers := state.IsActive, state.IsError, state.ActiveTriggers
 	scalerCache.Close(context.Background())
 
 	assert.Equal(t, true, isActive)
@@ -1044,6 +1466,60 @@ func TestScalingModifiersFormula(t *testing.T) {
 	assert.Equal(t, float64(7), metrics.Items[0].Value.AsApproximateFloat64())
 }
 
+func TestScaledJobScalingModifiersFormula(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	recorder := record.NewFakeRecorder(1)
+
+	scaledJob := createScaledJob(0, 100, "")
+	scaledJob.Spec.Triggers = []kedav1alpha1.ScaleTriggers{
+		{Name: triggerName1, Type: "fake_trig1"},
+		{Name: triggerName2, Type: "fake_trig2"},
+	}
+	scaledJob.Spec.ScalingModifiers = &kedav1alpha1.ScalingModifiers{
+		Target:  "2",
+		Formula: fmt.Sprintf("%s - %s*0.5", triggerName1, triggerName2),
+	}
+	compiledFormula, err := kedav1alpha1.ValidateAndCompileScaledJobScalingModifiers(scaledJob)
+	assert.Nil(t, err)
+
+	scalerConfig1 := scalersconfig.ScalerConfig{TriggerName: triggerName1, TriggerIndex: 0}
+	scalerConfig2 := scalersconfig.ScalerConfig{TriggerName: triggerName2, TriggerIndex: 1}
+	scalerCache := cache.ScalersCache{
+		Scalers: []cache.ScalerBuilder{{
+			Scaler:       createScaler(ctrl, int64(20), int64(1), true, metricName1),
+			ScalerConfig: scalerConfig1,
+		}, {
+			Scaler:       createScaler(ctrl, int64(10), int64(1), false, metricName2),
+			ScalerConfig: scalerConfig2,
+		}},
+		Recorder:        recorder,
+		CompiledFormula: compiledFormula,
+	}
+
+	caches := map[string]*cache.ScalersCache{}
+	caches[scaledJob.GenerateIdentifier()] = &scalerCache
+
+	sh := scaleHandler{
+		scaleLoopContexts:        &sync.Map{},
+		globalHTTPTimeout:        time.Duration(1000),
+		recorder:                 recorder,
+		scalerCaches:             caches,
+		scalerCachesLock:         &sync.RWMutex{},
+		scaledObjectsMetricCache: metricscache.NewMetricsCache(),
+		rawMetricsSubscriptions:  map[string]*RawMetricSubscriptions{},
+		metricToSubscriptions:    map[metricMeta][]*RawMetricSubscriptions{},
+		subsLock:                 &sync.RWMutex{},
+	}
+
+	// nosemgrep: context-todo
+	isActive, isError, queueLength, maxValue := sh.isScaledJobActive(context.TODO(), scaledJob)
+	assert.Equal(t, true, isActive)
+	assert.Equal(t, false, isError)
+	assert.Equal(t, int64(15), queueLength)
+	assert.Equal(t, int64(8), maxValue)
+	scalerCache.Close(context.Background())
+}
+
 // createMetricSpec creates MetricSpec for given metric name and target value.
 func createMetricSpec(averageValue int64, metricName string) v2.MetricSpec {
 	qty := resource.NewQuantity(averageValue, resource.DecimalSI)
@@ -1063,3 +1539,158 @@ func expectNoStatusPatch(ctrl *gomock.Controller) {
 	statusWriter := mock_client.NewMockStatusWriter(ctrl)
 	statusWriter.EXPECT().Patch(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 }
+
+func TestGetDryRunDesiredReplicas(t *testing.T) {
+	targets := map[string]metricTarget{
+		"s0-queue": {metricType: v2.AverageValueMetricType, value: 10},
+		"s1-lag":   {metricType: v2.ValueMetricType, value: 100},
+	}
+	tests := []struct {
+		name            string
+		minReplicas     *int32
+		idleReplicas    *int32
+		isActive        bool
+		currentReplicas int32
+		metrics         []external_metrics.ExternalMetricValue
+		expected        int32
+	}{
+		{
+			name:            "inactive scales to zero",
+			currentReplicas: 3,
+			expected:        0,
+		},
+		{
+			name:            "inactive scales to idle replicas",
+			idleReplicas:    ptr.To[int32](1),
+			currentReplicas: 3,
+			expected:        1,
+		},
+		{
+			name:            "inactive with min replicas scales in to the min",
+			minReplicas:     ptr.To[int32](2),
+			currentReplicas: 3,
+			metrics:         []external_metrics.ExternalMetricValue{scalers.GenerateMetricInMili("s0-queue", 0)},
+			expected:        2,
+		},
+		{
+			name:            "average value from zero",
+			isActive:        true,
+			currentReplicas: 0,
+			metrics:         []external_metrics.ExternalMetricValue{scalers.GenerateMetricInMili("s0-queue", 45)},
+			expected:        5,
+		},
+		{
+			name:            "highest proposal wins",
+			isActive:        true,
+			currentReplicas: 2,
+			metrics: []external_metrics.ExternalMetricValue{
+				scalers.GenerateMetricInMili("s0-queue", 30),
+				scalers.GenerateMetricInMili("s1-lag", 400),
+			},
+			expected: 8,
+		},
+		{
+			name:            "within tolerance keeps current replicas",
+			isActive:        true,
+			currentReplicas: 4,
+			metrics:         []external_metrics.ExternalMetricValue{scalers.GenerateMetricInMili("s0-queue", 42)},
+			expected:        4,
+		},
+		{
+			name:            "bounded by max replicas",
+			isActive:        true,
+			currentReplicas: 1,
+			metrics:         []external_metrics.ExternalMetricValue{scalers.GenerateMetricInMili("s0-queue", 1000)},
+			expected:        10,
+		},
+		{
+			name:            "unknown metrics keep current replicas",
+			isActive:        true,
+			currentReplicas: 3,
+			metrics:         []external_metrics.ExternalMetricValue{scalers.GenerateMetricInMili("s2-cpu", 1000)},
+			expected:        3,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			scaledObject := &kedav1alpha1.ScaledObject{
+				Spec: kedav1alpha1.ScaledObjectSpec{
+					MinReplicaCount:  test.minReplicas,
+					MaxReplicaCount:  ptr.To[int32](10),
+					IdleReplicaCount: test.idleReplicas,
+				},
+			}
+			assert.Equal(t, test.expected, getDryRunDesiredReplicas(scaledObject, test.isActive, test.currentReplicas, test.metrics, targets))
+		})
+	}
+}
+
+func TestBuildScalingDecision(t *testing.T) {
+	targets := map[string]metricTarget{
+		"s0-queue": {metricType: v2.AverageValueMetricType, value: 10},
+		"s1-lag":   {metricType: v2.ValueMetricType, value: 100},
+	}
+	state := scaledObjectState{
+		IsActive: true,
+		Triggers: []scalerState{
+			{
+				IsActive:    true,
+				TriggerName: "queue",
+				TriggerType: "rabbitmq",
+				MetricSpecs: []v2.MetricSpec{createMetricSpec(10, "s0-queue")},
+				Metrics:     []external_metrics.ExternalMetricValue{scalers.GenerateMetricInMili("s0-queue", 30)},
+			},
+			{
+				IsActive:    true,
+				TriggerName: "lag",
+				TriggerType: "kafka",
+				MetricSpecs: []v2.MetricSpec{createMetricSpec(100, "s1-lag")},
+				Metrics:     []external_metrics.ExternalMetricValue{scalers.GenerateMetricInMili("s1-lag", 400)},
+			},
+			{
+				TriggerName: "broken",
+				TriggerType: "prometheus",
+				Err:         errors.New("connection refused"),
+			},
+		},
+	}
+
+	decision := buildScalingDecision(&kedav1alpha1.ScaledObject{}, state, targets, 2)
+
+	assert.Len(t, decision.Triggers, 3)
+	assert.Equal(t, "s1-lag", decision.DominatingMetric)
+	assert.False(t, decision.Triggers[0].Dominating)
+	assert.Equal(t, "30", decision.Triggers[0].Value)
+	assert.Equal(t, "10", decision.Triggers[0].Target)
+	assert.True(t, decision.Triggers[1].Dominating)
+	assert.True(t, decision.Triggers[1].Active)
+	assert.Equal(t, "connection refused", decision.Triggers[2].Error)
+	assert.Empty(t, decision.FormulaResult)
+}
+
+func TestEmitFallbackTransition(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	sh := &scaleHandler{recorder: recorder, fallbackStates: &sync.Map{}}
+	scaledObject := &kedav1alpha1.ScaledObject{
+		ObjectMeta: metav1.ObjectMeta{Name: testNameGlobal, Namespace: testNamespaceGlobal},
+		Status:     kedav1alpha1.ScaledObjectStatus{Conditions: *kedav1alpha1.GetInitializedConditions()},
+	}
+	metrics := []external_metrics.ExternalMetricValue{scalers.GenerateMetricInMili("metric", 3)}
+
+	// the fallback condition seen by the first scale loop isn't a transition
+	scaledObject.Status.Conditions.SetFallbackCondition(metav1.ConditionFalse, "NoFallbackFound", "No fallbacks are active on this scaled object")
+	sh.emitFallbackTransition(scaledObject, metrics)
+	assert.Empty(t, recorder.Events)
+
+	scaledObject.Status.Conditions.SetFallbackCondition(metav1.ConditionTrue, "FallbackExists", "At least one trigger is falling back on this scaled object")
+	sh.emitFallbackTransition(scaledObject, metrics)
+	sh.emitFallbackTransition(scaledObject, metrics)
+	assert.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, "KEDAFallbackEntered")
+
+	scaledObject.Status.Conditions.SetFallbackCondition(metav1.ConditionFalse, "NoFallbackFound", "No fallbacks are active on this scaled object")
+	sh.emitFallbackTransition(scaledObject, metrics)
+	assert.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, "KEDAFallbackExited")
+}
@@ -48,6 +48,25 @@ type ScalerMetrics struct {
 	QueueLength float64
 	MaxValue    float64
 	IsActive    bool
+	// TriggerName is the name of the trigger the metrics come from, used by the scalingModifiers formula
+	TriggerName string
+	// IsFallback is true when the metrics are the fallback of a failing scaler
+	IsFallback bool
+}
+
+// GetFallbackScalerMetrics returns the ScalerMetrics requesting jobCount jobs, used instead of the metrics of a failing scaler
+func GetFallbackScalerMetrics(jobCount int64, metricSpecs []v2.MetricSpec, maxReplicaCount int64) ScalerMetrics {
+	maxValue := getMaxValue(float64(jobCount), maxReplicaCount)
+	queueLength := maxValue
+	if targetAverageValue := getTargetAverageValue(metricSpecs); targetAverageValue != 0 {
+		queueLength = maxValue * targetAverageValue
+	}
+	return ScalerMetrics{
+		QueueLength: queueLength,
+		MaxValue:    maxValue,
+		IsActive:    maxValue > 0,
+		IsFallback:  true,
+	}
 }
 
 // IsScaledJobActive returns whether the input ScaledJob is active and queueLength and maxValue for scale
@@ -46,6 +46,20 @@ func TestTargetAverageValue(t *testing.T) {
 }
 
 // createMetricSpec creates MetricSpec for given metric name and target value.
+func TestGetFallbackScalerMetrics(t *testing.T) {
+	specs := []v2.MetricSpec{createMetricSpec(5, "s0-messageCount")}
+
+	metrics := GetFallbackScalerMetrics(4, specs, 10)
+	assert.Equal(t, ScalerMetrics{QueueLength: 20, MaxValue: 4, IsActive: true, IsFallback: true}, metrics)
+
+	// the job count is capped by maxReplicaCount
+	metrics = GetFallbackScalerMetrics(40, specs, 10)
+	assert.Equal(t, ScalerMetrics{QueueLength: 50, MaxValue: 10, IsActive: true, IsFallback: true}, metrics)
+
+	metrics = GetFallbackScalerMetrics(0, specs, 10)
+	assert.False(t, metrics.IsActive)
+}
+
 func createMetricSpec(averageValue int64, metricName string) v2.MetricSpec {
 	qty := resource.NewQuantity(averageValue, resource.DecimalSI)
 	return v2.MetricSpec{
@@ -19,6 +19,7 @@ package scaling
 import (
 	"context"
 	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -54,22 +55,27 @@ func (h *scaleHandler) buildScalers(ctx context.Context, withTriggers *kedav1alp
 				}
 			}
 			config := &scalersconfig.ScalerConfig{
-				ScalableObjectName:      withTriggers.Name,
-				ScalableObjectNamespace: withTriggers.Namespace,
-				ScalableObjectType:      withTriggers.Kind,
-				TriggerName:             trigger.Name,
-				TriggerMetadata:         trigger.Metadata,
-				TriggerType:             trigger.Type,
-				TriggerUseCachedMetrics: trigger.UseCachedMetrics,
-				ResolvedEnv:             resolvedEnv,
-				AuthParams:              make(map[string]string),
-				GlobalHTTPTimeout:       h.globalHTTPTimeout,
-				TriggerIndex:            triggerIndex,
-				MetricType:              trigger.MetricType,
-				AsMetricSource:          asMetricSource,
-				ScaledObject:            withTriggers,
-				Recorder:                h.recorder,
-				TriggerUniqueKey:        fmt.Sprintf("%s-%s-%s-%d", withTriggers.Kind, withTriggers.Namespace, withTriggers.Name, triggerIndex),
+				ScalableObjectName:            withTriggers.Name,
+				ScalableObjectNamespace:       withTriggers.Namespace,
+				ScalableObjectType:            withTriggers.Kind,
+				TriggerName:                   trigger.Name,
+				TriggerMetadata:               trigger.Metadata,
+				TriggerType:                   trigger.Type,
+				TriggerUseCachedMetrics:       trigger.UseCachedMetrics,
+				TriggerMaxMetricAge:           secondsToDuration(trigger.MaxMetricAge),
+				TriggerPollingInterval:        secondsToDuration(trigger.PollingInterval),
+				TriggerAdaptivePolling:        trigger.AdaptivePolling,
+				TriggerActivationHysteresis:   trigger.ActivationHysteresis,
+				ScalableObjectPollingInterval: withTriggers.GetPollingInterval(),
+				ResolvedEnv:                   resolvedEnv,
+				AuthParams:                    make(map[string]string),
+				GlobalHTTPTimeout:             h.globalHTTPTimeout,
+				TriggerIndex:                  triggerIndex,
+				MetricType:                    trigger.MetricType,
+				AsMetricSource:                asMetricSource,
+				ScaledObject:                  withTriggers,
+				Recorder:                      h.recorder,
+				TriggerUniqueKey:              fmt.Sprintf("%s-%s-%s-%d", withTriggers.Kind, withTriggers.Namespace, withTriggers.Name, triggerIndex),
 			}
 
 			authParams, podIdentity, err := resolver.ResolveAuthRefAndPodIdentity(ctx, h.client, logger, trigger.AuthenticationRef, podTemplateSpec, withTriggers.Namespace, h.authClientSet)
@@ -279,3 +285,11 @@ func buildScaler(ctx context.Context, client client.Client, triggerType string,
 	}
 	// TRIGGERS-END
 }
+
+// secondsToDuration converts an optional number of seconds of the trigger spec to a duration, zero if not set
+func secondsToDuration(seconds *int32) time.Duration {
+	if seconds == nil {
+		return 0
+	}
+	return time.Duration(*seconds) * time.Second
+}
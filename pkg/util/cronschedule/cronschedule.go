@@ -0,0 +1,31 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cronschedule parses the cron expressions of the cron scaler and of the time windows of ScaledJobs,
+// it's imported by the API types so it must not depend on the other KEDA packages
+package cronschedule
+
+import (
+	"github.com/robfig/cron/v3"
+)
+
+// parser parses the standard 5 fields cron expressions: minute, hour, day of month, month and day of week
+var parser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+
+// Parse parses a standard 5 fields cron expression
+func Parse(spec string) (cron.Schedule, error) {
+	return parser.Parse(spec)
+}
@@ -0,0 +1,36 @@
+/*
+Copyright 2025 The KEDA Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cronschedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	schedule, err := Parse("30 9 * * 1-5")
+	assert.NoError(t, err)
+	// Saturday, the next occurrence is on Monday
+	now := time.Date(2025, 1, 4, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2025, 1, 6, 9, 30, 0, 0, time.UTC), schedule.Next(now))
+
+	// the seconds field isn't supported
+	_, err = Parse("0 30 9 * * 1-5")
+	assert.Error(t, err)
+}